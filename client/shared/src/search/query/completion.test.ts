@@ -45,6 +45,7 @@ describe('getCompletionItems()', () => {
                 )
             )?.suggestions.map(({ label }) => label)
         ).toStrictEqual([
+            'added',
             'after',
             'archived',
             'author',
@@ -62,9 +63,13 @@ describe('getCompletionItems()', () => {
             'fork',
             'lang',
             '-lang',
+            'merge',
             'message',
             '-message',
             'patterntype',
+            'removed',
+            'renamed',
+            '-renamed',
             'repo',
             '-repo',
             'repogroup',
@@ -105,6 +110,7 @@ describe('getCompletionItems()', () => {
                 )
             )?.suggestions.map(({ label }) => label)
         ).toStrictEqual([
+            'added',
             'after',
             'archived',
             'author',
@@ -122,9 +128,13 @@ describe('getCompletionItems()', () => {
             'fork',
             'lang',
             '-lang',
+            'merge',
             'message',
             '-message',
             'patterntype',
+            'removed',
+            'renamed',
+            '-renamed',
             'repo',
             '-repo',
             'repogroup',
@@ -147,6 +157,7 @@ describe('getCompletionItems()', () => {
                 ({ label }) => label
             )
         ).toStrictEqual([
+            'added',
             'after',
             'archived',
             'author',
@@ -164,9 +175,13 @@ describe('getCompletionItems()', () => {
             'fork',
             'lang',
             '-lang',
+            'merge',
             'message',
             '-message',
             'patterntype',
+            'removed',
+            'renamed',
+            '-renamed',
             'repo',
             '-repo',
             'repogroup',
@@ -197,6 +212,7 @@ describe('getCompletionItems()', () => {
                 )
             )?.suggestions.map(({ label }) => label)
         ).toStrictEqual([
+            'added',
             'after',
             'archived',
             'author',
@@ -214,9 +230,13 @@ describe('getCompletionItems()', () => {
             'fork',
             'lang',
             '-lang',
+            'merge',
             'message',
             '-message',
             'patterntype',
+            'removed',
+            'renamed',
+            '-renamed',
             'repo',
             '-repo',
             'repogroup',
@@ -238,6 +258,7 @@ describe('getCompletionItems()', () => {
                 ({ label }) => label
             )
         ).toStrictEqual([
+            'added',
             'after',
             'archived',
             'author',
@@ -255,9 +276,13 @@ describe('getCompletionItems()', () => {
             'fork',
             'lang',
             '-lang',
+            'merge',
             'message',
             '-message',
             'patterntype',
+            'removed',
+            'renamed',
+            '-renamed',
             'repo',
             '-repo',
             'repogroup',
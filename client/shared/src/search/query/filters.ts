@@ -8,6 +8,7 @@ import { selectorCompletion } from './selectFilter'
 import { Filter, Literal } from './token'
 
 export enum FilterType {
+    added = 'added',
     after = 'after',
     archived = 'archived',
     author = 'author',
@@ -20,8 +21,11 @@ export enum FilterType {
     file = 'file',
     fork = 'fork',
     lang = 'lang',
+    merge = 'merge',
     message = 'message',
     patterntype = 'patterntype',
+    removed = 'removed',
+    renamed = 'renamed',
     repo = 'repo',
     repogroup = 'repogroup',
     repohascommitafter = 'repohascommitafter',
@@ -83,6 +87,7 @@ export enum NegatedFilters {
     lang = '-lang',
     message = '-message',
     r = '-r',
+    renamed = '-renamed',
     repo = '-repo',
     repohasfile = '-repohasfile',
 }
@@ -97,6 +102,7 @@ export type NegatableFilter =
     | FilterType.committer
     | FilterType.author
     | FilterType.message
+    | FilterType.renamed
 
 export const isNegatableFilter = (filter: FilterType): filter is NegatableFilter =>
     Object.keys(NegatedFilters).includes(filter)
@@ -117,6 +123,7 @@ const negatedFilterToNegatableFilter: { [key: string]: NegatableFilter } = {
     '-lang': FilterType.lang,
     '-message': FilterType.message,
     '-r': FilterType.repo,
+    '-renamed': FilterType.renamed,
     '-repo': FilterType.repo,
     '-repohasfile': FilterType.repohasfile,
 }
@@ -176,6 +183,10 @@ const SOURCEGRAPH_DOT_COM_REPO_COMPLETION: Completion[] = [
 
 export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
     Record<Exclude<FilterType, NegatableFilter>, BaseFilterDefinition> = {
+    [FilterType.added]: {
+        description: 'Commits that add a number of lines, such as >500 or <=10',
+        singular: true,
+    },
     [FilterType.after]: {
         alias: 'since',
         description: 'Commits made after a certain date',
@@ -236,6 +247,12 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         negatable: true,
         description: negated => `${negated ? 'Exclude' : 'Include only'} results from the given language`,
     },
+    [FilterType.merge]: {
+        discreteValues: () => ['yes', 'no', 'only'].map(value => ({ label: value })),
+        description: 'Include merge commits.',
+        default: 'no',
+        singular: true,
+    },
     [FilterType.message]: {
         alias: 'm',
         negatable: true,
@@ -247,6 +264,15 @@ export const FILTERS: Record<NegatableFilter, NegatableFilterDefinition> &
         description: 'The pattern type (regexp, literal, structural) in use',
         singular: true,
     },
+    [FilterType.removed]: {
+        description: 'Commits that remove a number of lines, such as >500 or <=10',
+        singular: true,
+    },
+    [FilterType.renamed]: {
+        negatable: true,
+        description: negated =>
+            `${negated ? 'Exclude' : 'Include only'} commits that rename files matching the given search pattern.`,
+    },
     [FilterType.repo]: {
         alias: 'r',
         negatable: true,
@@ -44,6 +44,8 @@ import {
     CloseChangesetsVariables,
     PublishChangesetsResult,
     PublishChangesetsVariables,
+    RebaseChangesetsResult,
+    RebaseChangesetsVariables,
 } from '../../../graphql-operations'
 
 const changesetsStatsFragment = gql`
@@ -757,6 +759,20 @@ export async function publishChangesets(
     dataOrThrowErrors(result)
 }
 
+export async function rebaseChangesets(batchChange: Scalars['ID'], changesets: Scalars['ID'][]): Promise<void> {
+    const result = await requestGraphQL<RebaseChangesetsResult, RebaseChangesetsVariables>(
+        gql`
+            mutation RebaseChangesets($batchChange: ID!, $changesets: [ID!]!) {
+                rebaseChangesets(batchChange: $batchChange, changesets: $changesets) {
+                    id
+                }
+            }
+        `,
+        { batchChange, changesets }
+    ).toPromise()
+    dataOrThrowErrors(result)
+}
+
 export const BULK_OPERATIONS = gql`
     query BatchChangeBulkOperations($batchChange: ID!, $first: Int, $after: String) {
         node(id: $batchChange) {
@@ -51,6 +51,11 @@ const OPERATION_TITLES: Record<BulkOperationType, JSX.Element> = {
             <UploadIcon className="icon-inline text-muted" /> Publish changesets
         </>
     ),
+    REBASE: (
+        <>
+            <SourceBranchIcon className="icon-inline text-muted" /> Rebase changesets
+        </>
+    ),
 }
 
 export interface BulkOperationNodeProps {
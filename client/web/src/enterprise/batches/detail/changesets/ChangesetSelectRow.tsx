@@ -16,6 +16,7 @@ import { CreateCommentModal } from './CreateCommentModal'
 import { DetachChangesetsModal } from './DetachChangesetsModal'
 import { MergeChangesetsModal } from './MergeChangesetsModal'
 import { PublishChangesetsModal } from './PublishChangesetsModal'
+import { RebaseChangesetsModal } from './RebaseChangesetsModal'
 import { ReenqueueChangesetsModal } from './ReenqueueChangesetsModal'
 
 /**
@@ -135,6 +136,22 @@ const AVAILABLE_ACTIONS: ChangesetListAction[] = [
             />
         ),
     },
+    {
+        type: 'rebase',
+        buttonLabel: 'Rebase changesets',
+        dropdownTitle: 'Rebase changesets',
+        dropdownDescription:
+            'Re-execute the batch spec for all selected changesets against the latest revision of their base branches and force-push the results. Only supported for batch changes executed server-side.',
+        isAvailable: ({ state }) => state === ChangesetState.OPEN || state === ChangesetState.DRAFT,
+        onTrigger: (batchChangeID, changesetIDs, onDone, onCancel) => (
+            <RebaseChangesetsModal
+                batchChangeID={batchChangeID}
+                changesetIDs={changesetIDs}
+                afterCreate={onDone}
+                onCancel={onCancel}
+            />
+        ),
+    },
 ]
 
 export interface ChangesetSelectRowProps {
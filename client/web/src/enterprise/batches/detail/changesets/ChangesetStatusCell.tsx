@@ -6,6 +6,7 @@ import DeleteIcon from 'mdi-react/DeleteIcon'
 import SourceBranchIcon from 'mdi-react/SourceBranchIcon'
 import SourceMergeIcon from 'mdi-react/SourceMergeIcon'
 import SourcePullIcon from 'mdi-react/SourcePullIcon'
+import TimerSandEmptyIcon from 'mdi-react/TimerSandEmptyIcon'
 import TimerSandIcon from 'mdi-react/TimerSandIcon'
 import React from 'react'
 
@@ -31,6 +32,8 @@ export const ChangesetStatusCell: React.FunctionComponent<ChangesetStatusCellPro
             return <ChangesetStatusRetrying className={className} />
         case ChangesetState.SCHEDULED:
             return <ChangesetStatusScheduled className={className} id={id} />
+        case ChangesetState.WAITING:
+            return <ChangesetStatusWaiting className={className} />
         case ChangesetState.PROCESSING:
             return <ChangesetStatusProcessing className={className} />
         case ChangesetState.UNPUBLISHED:
@@ -128,6 +131,19 @@ export const ChangesetStatusRetrying: React.FunctionComponent<ChangesetStatusIco
     </div>
 )
 
+export const ChangesetStatusWaiting: React.FunctionComponent<ChangesetStatusIconProps> = ({
+    label = <span>Waiting</span>,
+    className,
+}) => (
+    <div
+        className={classNames(iconClassNames, className)}
+        data-tooltip="This changeset will be published once the changesets of the previous stages of the batch spec are merged."
+    >
+        <TimerSandEmptyIcon />
+        {label}
+    </div>
+)
+
 export const ChangesetStatusProcessing: React.FunctionComponent<ChangesetStatusIconProps> = ({
     label = <span>Processing</span>,
     className,
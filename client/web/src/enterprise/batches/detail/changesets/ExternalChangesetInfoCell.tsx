@@ -66,6 +66,7 @@ export const ExternalChangesetInfoCell: React.FunctionComponent<ExternalChangese
                     ChangesetState.RETRYING,
                     ChangesetState.UNPUBLISHED,
                     ChangesetState.SCHEDULED,
+                    ChangesetState.WAITING,
                 ].includes(node.state) && (
                     <ChangesetLastSynced changeset={node} viewerCanAdminister={viewerCanAdminister} />
                 )}
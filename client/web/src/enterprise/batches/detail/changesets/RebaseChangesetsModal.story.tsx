@@ -0,0 +1,32 @@
+import { action } from '@storybook/addon-actions'
+import { storiesOf } from '@storybook/react'
+import { noop } from 'lodash'
+import React from 'react'
+
+import { WebStory } from '../../../../components/WebStory'
+
+import { RebaseChangesetsModal } from './RebaseChangesetsModal'
+
+const { add } = storiesOf('web/batches/details/RebaseChangesetsModal', module).addDecorator(story => (
+    <div className="p-3 container">{story()}</div>
+))
+
+const rebaseChangesets = () => {
+    action('RebaseChangesets')
+    return Promise.resolve()
+}
+
+add('Confirmation', () => (
+    <WebStory>
+        {props => (
+            <RebaseChangesetsModal
+                {...props}
+                afterCreate={noop}
+                batchChangeID="test-123"
+                changesetIDs={['test-123', 'test-234']}
+                onCancel={noop}
+                rebaseChangesets={rebaseChangesets}
+            />
+        )}
+    </WebStory>
+))
@@ -0,0 +1,70 @@
+import Dialog from '@reach/dialog'
+import React, { useCallback, useState } from 'react'
+
+import { LoadingSpinner } from '@sourcegraph/react-loading-spinner'
+import { asError, isErrorLike } from '@sourcegraph/shared/src/util/errors'
+
+import { ErrorAlert } from '../../../../components/alerts'
+import { Scalars } from '../../../../graphql-operations'
+import { rebaseChangesets as _rebaseChangesets } from '../backend'
+
+export interface RebaseChangesetsModalProps {
+    onCancel: () => void
+    afterCreate: () => void
+    batchChangeID: Scalars['ID']
+    changesetIDs: Scalars['ID'][]
+
+    /** For testing only. */
+    rebaseChangesets?: typeof _rebaseChangesets
+}
+
+export const RebaseChangesetsModal: React.FunctionComponent<RebaseChangesetsModalProps> = ({
+    onCancel,
+    afterCreate,
+    batchChangeID,
+    changesetIDs,
+    rebaseChangesets = _rebaseChangesets,
+}) => {
+    const [isLoading, setIsLoading] = useState<boolean | Error>(false)
+
+    const onSubmit = useCallback<React.FormEventHandler>(async () => {
+        setIsLoading(true)
+        try {
+            await rebaseChangesets(batchChangeID, changesetIDs)
+            afterCreate()
+        } catch (error) {
+            setIsLoading(asError(error))
+        }
+    }, [changesetIDs, rebaseChangesets, batchChangeID, afterCreate])
+
+    return (
+        <Dialog
+            className="modal-body modal-body--top-third p-4 rounded border"
+            onDismiss={onCancel}
+            aria-labelledby={MODAL_LABEL_ID}
+        >
+            <h3 id={MODAL_LABEL_ID}>Rebase changesets</h3>
+            <p className="mb-4">
+                Are you sure you want to rebase all the selected changesets? Their workspaces will be re-executed
+                against the latest revision of the base branch and the results force-pushed to the code hosts.
+            </p>
+            {isErrorLike(isLoading) && <ErrorAlert error={isLoading} />}
+            <div className="d-flex justify-content-end">
+                <button
+                    type="button"
+                    disabled={isLoading === true}
+                    className="btn btn-outline-secondary mr-2"
+                    onClick={onCancel}
+                >
+                    Cancel
+                </button>
+                <button type="button" onClick={onSubmit} disabled={isLoading === true} className="btn btn-primary">
+                    {isLoading === true && <LoadingSpinner className="icon-inline" />}
+                    Rebase
+                </button>
+            </div>
+        </Dialog>
+    )
+}
+
+const MODAL_LABEL_ID = 'rebase-changesets-modal-title'
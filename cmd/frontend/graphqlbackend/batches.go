@@ -160,6 +160,7 @@ type CreateBatchChangesCredentialArgs struct {
 	ExternalServiceKind string
 	ExternalServiceURL  string
 	User                *graphql.ID
+	Username            *string
 	Credential          string
 }
 
@@ -218,6 +219,10 @@ type PublishChangesetsArgs struct {
 	Draft bool
 }
 
+type RebaseChangesetsArgs struct {
+	BulkOperationBaseArgs
+}
+
 type ResolveWorkspacesForBatchSpecArgs struct {
 	BatchSpec        string
 	AllowIgnored     bool
@@ -268,6 +273,7 @@ type BatchChangesResolver interface {
 	MergeChangesets(ctx context.Context, args *MergeChangesetsArgs) (BulkOperationResolver, error)
 	CloseChangesets(ctx context.Context, args *CloseChangesetsArgs) (BulkOperationResolver, error)
 	PublishChangesets(ctx context.Context, args *PublishChangesetsArgs) (BulkOperationResolver, error)
+	RebaseChangesets(ctx context.Context, args *RebaseChangesetsArgs) (BulkOperationResolver, error)
 
 	// Queries
 	BatchChange(ctx context.Context, args *BatchChangeArgs) (BatchChangeResolver, error)
@@ -588,6 +594,7 @@ type BatchChangeResolver interface {
 	ID() graphql.ID
 	Name() string
 	Description() *string
+	AutoRebase() bool
 	InitialApplier(ctx context.Context) (*UserResolver, error)
 	LastApplier(ctx context.Context) (*UserResolver, error)
 	LastAppliedAt() DateTime
@@ -637,6 +644,7 @@ type ChangesetsStatsResolver interface {
 	Retrying() int32
 	Failed() int32
 	Scheduled() int32
+	Waiting() int32
 	Processing() int32
 	Deleted() int32
 	Archived() int32
@@ -736,6 +744,7 @@ type ChangesetCountsResolver interface {
 	OpenApproved() int32
 	OpenChangesRequested() int32
 	OpenPending() int32
+	Waiting() int32
 }
 
 type BatchSpecWorkspaceResolutionResolver interface {
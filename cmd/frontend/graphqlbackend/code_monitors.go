@@ -20,6 +20,8 @@ type CodeMonitorsResolver interface {
 	UpdateCodeMonitor(ctx context.Context, args *UpdateCodeMonitorArgs) (MonitorResolver, error)
 	ResetTriggerQueryTimestamps(ctx context.Context, args *ResetTriggerQueryTimestampsArgs) (*EmptyResponse, error)
 	TriggerTestEmailAction(ctx context.Context, args *TriggerTestEmailActionArgs) (*EmptyResponse, error)
+	TriggerTestTeamsWebhookAction(ctx context.Context, args *TriggerTestTeamsWebhookActionArgs) (*EmptyResponse, error)
+	TriggerTestTemplatedWebhookAction(ctx context.Context, args *TriggerTestTemplatedWebhookActionArgs) (*EmptyResponse, error)
 
 	NodeResolvers() map[string]NodeByIDFunc
 }
@@ -48,6 +50,7 @@ type MonitorTrigger interface {
 type MonitorQueryResolver interface {
 	ID() graphql.ID
 	Query() string
+	Type() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorTriggerEventConnectionResolver, error)
 }
 
@@ -73,6 +76,8 @@ type MonitorActionConnectionResolver interface {
 
 type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
+	ToMonitorTeamsWebhook() (MonitorTeamsWebhookResolver, bool)
+	ToMonitorTemplatedWebhook() (MonitorTemplatedWebhookResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -84,6 +89,22 @@ type MonitorEmailResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorTeamsWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	URL() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
+type MonitorTemplatedWebhookResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	URL() string
+	ContentType() string
+	PayloadTemplate() string
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -135,10 +156,13 @@ type CreateCodeMonitorArgs struct {
 
 type CreateTriggerArgs struct {
 	Query string
+	Type  string
 }
 
 type CreateActionArgs struct {
-	Email *CreateActionEmailArgs
+	Email            *CreateActionEmailArgs
+	TeamsWebhook     *CreateActionTeamsWebhookArgs
+	TemplatedWebhook *CreateActionTemplatedWebhookArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -148,6 +172,18 @@ type CreateActionEmailArgs struct {
 	Header     string
 }
 
+type CreateActionTeamsWebhookArgs struct {
+	Enabled bool
+	URL     string
+}
+
+type CreateActionTemplatedWebhookArgs struct {
+	Enabled         bool
+	URL             string
+	ContentType     *string
+	PayloadTemplate string
+}
+
 type ToggleCodeMonitorArgs struct {
 	Id      graphql.ID
 	Enabled bool
@@ -167,6 +203,18 @@ type TriggerTestEmailActionArgs struct {
 	Email       *CreateActionEmailArgs
 }
 
+type TriggerTestTeamsWebhookActionArgs struct {
+	Namespace    graphql.ID
+	Description  string
+	TeamsWebhook *CreateActionTeamsWebhookArgs
+}
+
+type TriggerTestTemplatedWebhookActionArgs struct {
+	Namespace        graphql.ID
+	Description      string
+	TemplatedWebhook *CreateActionTemplatedWebhookArgs
+}
+
 type CreateMonitorArgs struct {
 	Namespace   graphql.ID
 	Description string
@@ -178,8 +226,20 @@ type EditActionEmailArgs struct {
 	Update *CreateActionEmailArgs
 }
 
+type EditActionTeamsWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionTeamsWebhookArgs
+}
+
+type EditActionTemplatedWebhookArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionTemplatedWebhookArgs
+}
+
 type EditActionArgs struct {
-	Email *EditActionEmailArgs
+	Email            *EditActionEmailArgs
+	TeamsWebhook     *EditActionTeamsWebhookArgs
+	TemplatedWebhook *EditActionTemplatedWebhookArgs
 }
 
 type EditTriggerArgs struct {
@@ -213,6 +213,14 @@ func toResultResolverList(ctx context.Context, cmd compute.Command, matches []re
 		if err != nil {
 			return nil, err
 		}
+		if computeResult == nil {
+			// The command did not apply to this match.
+			continue
+		}
+		if t, ok := computeResult.(*compute.Text); ok && t.Kind == "diff" && t.Value == "" {
+			// A rewrite that did not change the file.
+			continue
+		}
 		repoResolver := getRepoResolver(m.RepoName(), "")
 		path, commit := pathAndCommitFromResult(m)
 		result := toComputeResultResolver(computeResult, repoResolver, path, commit)
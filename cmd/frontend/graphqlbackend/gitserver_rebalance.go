@@ -0,0 +1,78 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+)
+
+// GitserverRebalance resolves the progress of the job that moves repositories between gitservers.
+func (r *schemaResolver) GitserverRebalance(ctx context.Context) (*gitserverRebalanceResolver, error) {
+	// 🚨 SECURITY: Only site admins may view where repositories are stored.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	status, err := r.repoupdaterClient.GitserverRebalanceStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gitserverRebalanceResolver{status}, nil
+}
+
+// RebalanceGitservers starts moving repositories between gitservers.
+func (r *schemaResolver) RebalanceGitservers(ctx context.Context) (*gitserverRebalanceResolver, error) {
+	// 🚨 SECURITY: Moving repositories between gitservers is expensive, so only admins may do it.
+	if err := backend.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	status, err := r.repoupdaterClient.GitserverRebalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gitserverRebalanceResolver{status}, nil
+}
+
+type gitserverRebalanceResolver struct {
+	s *protocol.GitserverRebalanceStatus
+}
+
+func (r *gitserverRebalanceResolver) Running() bool { return r.s.Running }
+
+func (r *gitserverRebalanceResolver) StartedAt() *DateTime {
+	if r.s.StartedAt.IsZero() {
+		return nil
+	}
+	return &DateTime{Time: r.s.StartedAt}
+}
+
+func (r *gitserverRebalanceResolver) FinishedAt() *DateTime {
+	if r.s.FinishedAt.IsZero() {
+		return nil
+	}
+	return &DateTime{Time: r.s.FinishedAt}
+}
+
+func (r *gitserverRebalanceResolver) Total() int32  { return int32(r.s.Total) }
+func (r *gitserverRebalanceResolver) Moved() int32  { return int32(r.s.Moved) }
+func (r *gitserverRebalanceResolver) Failed() int32 { return int32(r.s.Failed) }
+
+func (r *gitserverRebalanceResolver) Failures() []*gitserverRebalanceFailureResolver {
+	resolvers := make([]*gitserverRebalanceFailureResolver, 0, len(r.s.Failures))
+	for i := range r.s.Failures {
+		resolvers = append(resolvers, &gitserverRebalanceFailureResolver{r.s.Failures[i]})
+	}
+	return resolvers
+}
+
+type gitserverRebalanceFailureResolver struct {
+	f protocol.GitserverRebalanceFailure
+}
+
+func (r *gitserverRebalanceFailureResolver) RepositoryName() string { return string(r.f.Repo) }
+func (r *gitserverRebalanceFailureResolver) From() string           { return r.f.From }
+func (r *gitserverRebalanceFailureResolver) To() string             { return r.f.To }
+func (r *gitserverRebalanceFailureResolver) Error() string          { return r.f.Error }
+func (r *gitserverRebalanceFailureResolver) Time() DateTime         { return DateTime{r.f.Time} }
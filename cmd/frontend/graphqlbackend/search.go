@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	searchrepos "github.com/sourcegraph/sourcegraph/internal/search/repos"
 	"github.com/sourcegraph/sourcegraph/internal/search/run"
+	"github.com/sourcegraph/sourcegraph/internal/search/searchcontexts"
 	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
 	"github.com/sourcegraph/sourcegraph/internal/search/unindexed"
 	zoektutil "github.com/sourcegraph/sourcegraph/internal/search/zoekt"
@@ -42,6 +43,10 @@ type SearchArgs struct {
 	// to make it visible in the browser.
 	Stream streaming.Sender
 
+	// DefaultLimit if non-zero is the maximum number of results to search for
+	// if the query does not specify count:.
+	DefaultLimit int
+
 	// For tests
 	Settings *schema.Settings
 }
@@ -83,7 +88,18 @@ func NewSearchImplementer(ctx context.Context, db database.DB, args *SearchArgs)
 	}
 
 	var plan query.Plan
-	plan, err = query.Pipeline(query.Init(args.Query, searchType))
+	plan, err = query.Pipeline(
+		query.Init(args.Query, searchType),
+		query.SubstituteSearchContexts(func(contextValue string) (string, error) {
+			sc, err := searchcontexts.ResolveSearchContextSpec(ctx, db, contextValue)
+			if err != nil {
+				// Leave the context: parameter untouched, resolving repositories
+				// reports an unknown search context.
+				return "", nil
+			}
+			return sc.Query, nil
+		}),
+	)
 	if err != nil {
 		return alertForQuery(args.Query, err).wrapSearchImplementer(db), nil
 	}
@@ -97,6 +113,9 @@ func NewSearchImplementer(ctx context.Context, db database.DB, args *SearchArgs)
 		// Set a lower max result count until structural search supports true streaming.
 		defaultLimit = defaultMaxSearchResults
 	}
+	if args.DefaultLimit != 0 {
+		defaultLimit = args.DefaultLimit
+	}
 
 	return &searchResolver{
 		db: db,
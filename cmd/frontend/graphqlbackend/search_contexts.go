@@ -43,6 +43,7 @@ type SearchContextResolver interface {
 	Namespace(ctx context.Context) (*NamespaceResolver, error)
 	ViewerCanManage(ctx context.Context) bool
 	Repositories(ctx context.Context) ([]SearchContextRepositoryRevisionsResolver, error)
+	Query() string
 }
 
 type SearchContextConnectionResolver interface {
@@ -61,12 +62,14 @@ type SearchContextInputArgs struct {
 	Description string
 	Public      bool
 	Namespace   *graphql.ID
+	Query       *string
 }
 
 type SearchContextEditInputArgs struct {
 	Name        string
 	Description string
 	Public      bool
+	Query       *string
 }
 
 type SearchContextRepositoryRevisionsInputArgs struct {
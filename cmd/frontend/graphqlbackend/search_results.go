@@ -784,6 +784,7 @@ func (r *searchResolver) toSearchInputs(q query.Q) (*search.TextParameters, []ru
 				RepoOpts:      repoOptions,
 				Diff:          diff,
 				HasTimeFilter: commit.HasTimeFilter(args.Query),
+				IncludeMerges: commit.IncludeMerges(args.Query),
 				Limit:         int(args.PatternInfo.FileMatchLimit),
 				Db:            r.db,
 			})
@@ -1242,6 +1243,15 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 			defer func() { r.stream = orig }()
 
 			r.invalidateRepoCache = true
+			if ep, ok := pred.(query.ExpressionPredicate); ok {
+				expression, err := ep.PlanExpression(q)
+				if err != nil {
+					return nil, err
+				}
+				if expression != nil {
+					return r.evaluatePredicateExpression(ctx, expression)
+				}
+			}
 			plan, err := pred.Plan(q)
 			if err != nil {
 				return nil, err
@@ -1282,19 +1292,75 @@ func (r *searchResolver) resultsRecursive(ctx context.Context, plan query.Plan)
 	return sr, err
 }
 
+// evaluatePredicateExpression evaluates every sub-search of a predicate
+// expression and combines their result sets: operands of an and-expression
+// are intersected, operands of an or-expression are united, and negated
+// operands are subtracted from the result.
+func (r *searchResolver) evaluatePredicateExpression(ctx context.Context, e *query.PredicateExpression) (*SearchResults, error) {
+	if e.Plan != nil {
+		sr, err := r.resultsRecursive(ctx, e.Plan)
+		if sr == nil {
+			sr = &SearchResults{}
+		}
+		return sr, err
+	}
+
+	var (
+		sr      *SearchResults
+		negated []*SearchResults
+	)
+	for _, operand := range e.Operands {
+		operandResult, err := r.evaluatePredicateExpression(ctx, operand)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case operand.Negated:
+			negated = append(negated, operandResult)
+		case sr == nil:
+			sr = operandResult
+		case e.Kind == query.Or:
+			sr = union(sr, operandResult)
+		default:
+			sr = intersect(sr, operandResult)
+		}
+	}
+
+	if sr == nil {
+		return nil, errors.New("predicate expression must contain at least one term that is not negated")
+	}
+	for _, n := range negated {
+		sr.Matches = result.Subtract(sr.Matches, n.Matches)
+		sr.Stats.Update(&n.Stats)
+	}
+	return sr, nil
+}
+
 // searchResultsToRepoNodes converts a set of search results into repository nodes
-// such that they can be used to replace a repository predicate
+// such that they can be used to replace a repository predicate. Symbol results
+// (file matches) are reduced to the set of repositories they belong to.
 func searchResultsToRepoNodes(matches []result.Match) ([]query.Node, error) {
 	nodes := make([]query.Node, 0, len(matches))
+	seen := make(map[api.RepoName]struct{}, len(matches))
 	for _, match := range matches {
-		repoMatch, ok := match.(*result.RepoMatch)
-		if !ok {
-			return nil, errors.Errorf("expected type %T, but got %T", &result.RepoMatch{}, match)
+		var name api.RepoName
+		switch m := match.(type) {
+		case *result.RepoMatch:
+			name = m.Name
+		case *result.FileMatch:
+			name = m.Repo.Name
+		default:
+			return nil, errors.Errorf("expected type %T or %T, but got %T", &result.RepoMatch{}, &result.FileMatch{}, match)
+		}
+
+		if _, ok := seen[name]; ok {
+			continue
 		}
+		seen[name] = struct{}{}
 
 		nodes = append(nodes, query.Parameter{
 			Field: query.FieldRepo,
-			Value: "^" + regexp.QuoteMeta(string(repoMatch.Name)) + "$",
+			Value: "^" + regexp.QuoteMeta(string(name)) + "$",
 		})
 	}
 
@@ -1305,12 +1371,20 @@ func searchResultsToRepoNodes(matches []result.Match) ([]query.Node, error) {
 // can replace a file predicate
 func searchResultsToFileNodes(matches []result.Match) ([]query.Node, error) {
 	nodes := make([]query.Node, 0, len(matches))
+	seen := make(map[result.Key]struct{}, len(matches))
 	for _, match := range matches {
 		fileMatch, ok := match.(*result.FileMatch)
 		if !ok {
 			return nil, errors.Errorf("expected type %T, but got %T", &result.FileMatch{}, match)
 		}
 
+		// Avoid emitting duplicate nodes when a file is matched more than once.
+		key := result.Key{Repo: fileMatch.Repo.Name, Path: fileMatch.Path}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
 		// We create AND nodes to match both the repo and the file at the same time so
 		// we don't get files of the same name from different repositories.
 		nodes = append(nodes, query.Operator{
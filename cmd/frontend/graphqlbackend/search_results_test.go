@@ -369,6 +369,54 @@ func TestLonger(t *testing.T) {
 	}
 }
 
+func TestSearchResultsToPredicateNodes(t *testing.T) {
+	symbolMatch := func(repo api.RepoName, path string, symbols ...string) *result.FileMatch {
+		fm := &result.FileMatch{File: result.File{Repo: types.MinimalRepo{Name: repo}, Path: path}}
+		for _, name := range symbols {
+			fm.Symbols = append(fm.Symbols, &result.SymbolMatch{File: &fm.File, Symbol: result.Symbol{Name: name}})
+		}
+		return fm
+	}
+
+	matches := []result.Match{
+		symbolMatch("github.com/a/a", "client.go", "NewClient", "NewClientWithOpts"),
+		symbolMatch("github.com/a/a", "client.go", "NewClient"),
+		symbolMatch("github.com/a/a", "server.go", "NewClient"),
+		symbolMatch("github.com/b/b", "client.go", "NewClient"),
+		&result.RepoMatch{Name: "github.com/c/c"},
+	}
+
+	t.Run("repo nodes", func(t *testing.T) {
+		nodes, err := searchResultsToRepoNodes(matches)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := query.Q(nodes).String()
+		want := `"repo:^github\\.com/a/a$" "repo:^github\\.com/b/b$" "repo:^github\\.com/c/c$"`
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("file nodes", func(t *testing.T) {
+		nodes, err := searchResultsToFileNodes(matches[:4])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := query.Q(nodes).String()
+		want := `(and "repo:^github\\.com/a/a$" "file:^client\\.go$") (and "repo:^github\\.com/a/a$" "file:^server\\.go$") (and "repo:^github\\.com/b/b$" "file:^client\\.go$")`
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("unsupported match", func(t *testing.T) {
+		if _, err := searchResultsToRepoNodes([]result.Match{&result.CommitMatch{}}); err == nil {
+			t.Fatal("expected error but got none")
+		}
+	})
+}
+
 func TestSearchResultsHydration(t *testing.T) {
 	id := 42
 	repoName := "reponame-foobar"
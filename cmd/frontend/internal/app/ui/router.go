@@ -79,6 +79,7 @@ const (
 	routeDevToolTime             = "devtooltime"
 
 	routeSearchStream   = "search.stream"
+	routeSearchExport   = "search.export"
 	routeSearchConsole  = "search.console"
 	routeSearchNotebook = "search.notebook"
 
@@ -135,6 +136,7 @@ func newRouter() *mux.Router {
 	r.Path("/search").Methods("GET").Name(routeSearch)
 	r.Path("/search/badge").Methods("GET").Name(routeSearchBadge)
 	r.Path("/search/stream").Methods("GET").Name(routeSearchStream)
+	r.Path("/search/export").Methods("GET").Name(routeSearchExport)
 	r.Path("/search/console").Methods("GET").Name(routeSearchConsole)
 	r.Path("/search/notebook").Methods("GET").Name(routeSearchNotebook)
 	r.Path("/sign-in").Methods("GET").Name(uirouter.RouteSignIn)
@@ -296,6 +298,9 @@ func initRouter(db database.DB, router *mux.Router, codeIntelResolver graphqlbac
 	// streaming search
 	router.Get(routeSearchStream).Handler(search.StreamHandler(db))
 
+	// search export
+	router.Get(routeSearchExport).Handler(search.ExportHandler(db))
+
 	// search badge
 	router.Get(routeSearchBadge).Handler(searchBadgeHandler())
 
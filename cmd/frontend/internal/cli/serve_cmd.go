@@ -309,6 +309,7 @@ func makeExternalAPI(db database.DB, schema *graphql.Schema, enterprise enterpri
 		enterprise.GitHubWebhook,
 		enterprise.GitLabWebhook,
 		enterprise.BitbucketServerWebhook,
+		enterprise.BitbucketCloudWebhook,
 		enterprise.NewCodeIntelUploadHandler,
 		enterprise.NewExecutorProxyHandler,
 		rateLimiter,
@@ -0,0 +1,246 @@
+package search
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	searchshared "github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// ExportHandler is an http handler which runs a search and streams back
+// every match as a CSV or JSON Lines file. Unlike StreamHandler it does not
+// apply a display limit, but it writes at most search.limits.maxExportResults
+// results.
+func ExportHandler(db database.DB) http.Handler {
+	return &exportHandler{
+		streamHandler: streamHandler{
+			db:                db,
+			newSearchResolver: defaultNewSearchResolver,
+		},
+		maxResults: func() int {
+			return searchshared.SearchLimits(conf.Get()).MaxExportResults
+		},
+	}
+}
+
+type exportHandler struct {
+	streamHandler
+	maxResults func() int
+}
+
+const (
+	exportFormatCSV   = "csv"
+	exportFormatJSONL = "jsonl"
+
+	// exportTrailerProgress is the HTTP trailer containing the final
+	// progress event as JSON, in the same format as the stream API.
+	exportTrailerProgress = "X-Sourcegraph-Progress"
+
+	// exportTrailerError is the HTTP trailer containing the error message if
+	// the search failed after we started writing the file.
+	exportTrailerError = "X-Sourcegraph-Error"
+)
+
+// csvHeader is the header row of CSV exports.
+var csvHeader = []string{"type", "repository", "commit", "path", "line", "text"}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	args, err := parseURLQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	format, err := parseExportFormat(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tr, ctx := trace.New(ctx, "search.ServeExport", args.Query,
+		trace.Tag{Key: "version", Value: args.Version},
+		trace.Tag{Key: "pattern_type", Value: args.PatternType},
+		trace.Tag{Key: "format", Value: format},
+	)
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	maxResults := h.maxResults()
+	events, inputs, results := h.startSearch(ctx, args, maxResults)
+
+	// The query's count: decides how many results we search for, but we
+	// never write more than maxResults.
+	limit := inputs.MaxResults()
+	if limit > maxResults {
+		limit = maxResults
+	}
+
+	progress := progressAggregator{
+		Start:        time.Now(),
+		Limit:        limit,
+		Trace:        trace.URL(trace.ID(ctx), conf.ExternalURL()),
+		DisplayLimit: limit,
+		RepoNamer:    repoNamer(ctx, h.db),
+	}
+
+	w.Header().Set("Trailer", exportTrailerProgress+", "+exportTrailerError)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", "sourcegraph-search."+format))
+	if format == exportFormatCSV {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+	w.WriteHeader(http.StatusOK)
+
+	bw := bufio.NewWriter(w)
+	var write func(match result.Match, repoMetadata map[api.RepoID]*types.SearchedRepo) error
+	if format == exportFormatCSV {
+		cw := csv.NewWriter(bw)
+		if err = cw.Write(csvHeader); err != nil {
+			return
+		}
+		write = func(match result.Match, _ map[api.RepoID]*types.SearchedRepo) error {
+			if err := cw.WriteAll(toCSVRecords(match)); err != nil {
+				return err
+			}
+			cw.Flush()
+			return cw.Error()
+		}
+	} else {
+		enc := json.NewEncoder(bw)
+		write = func(match result.Match, repoMetadata map[api.RepoID]*types.SearchedRepo) error {
+			return enc.Encode(fromMatch(match, repoMetadata))
+		}
+	}
+
+	remaining := limit
+	truncated := false
+	for event := range events {
+		if err != nil {
+			// Drain the remaining events so that the search can finish.
+			continue
+		}
+
+		progress.Update(event)
+
+		// Truncate the event to the export limit before fetching repo
+		// metadata.
+		for i, match := range event.Results {
+			if remaining <= 0 {
+				event.Results = event.Results[:i]
+				truncated = true
+				cancel()
+				break
+			}
+			remaining = match.Limit(remaining)
+		}
+
+		var repoMetadata map[api.RepoID]*types.SearchedRepo
+		repoMetadata, err = getEventRepoMetadata(ctx, h.db, event)
+		if err != nil {
+			log15.Error("failed to get repo metadata", "error", err)
+			continue
+		}
+
+		for _, match := range event.Results {
+			repo := match.RepoName()
+
+			// Don't write matches which we cannot map to a repo the actor has
+			// access to, like StreamHandler.
+			if md, ok := repoMetadata[repo.ID]; !ok || md.Name != repo.Name {
+				continue
+			}
+
+			if err = write(match, repoMetadata); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = bw.Flush()
+		}
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+	}
+
+	if _, searchErr := results(); err == nil && searchErr != nil && !truncated {
+		// Errors caused by cancelling the search once we reached the limit
+		// are expected.
+		err = searchErr
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+
+	final, _ := json.Marshal(progress.Final())
+	w.Header().Set(exportTrailerProgress, string(final))
+	if err != nil {
+		w.Header().Set(exportTrailerError, strings.ReplaceAll(err.Error(), "\n", " "))
+	}
+}
+
+func parseExportFormat(q url.Values) (string, error) {
+	switch format := strings.ToLower(q.Get("format")); format {
+	case "":
+		return exportFormatJSONL, nil
+	case exportFormatCSV, exportFormatJSONL:
+		return format, nil
+	default:
+		return "", errors.Errorf("format must be %q or %q, got %q", exportFormatCSV, exportFormatJSONL, format)
+	}
+}
+
+// toCSVRecords returns the CSV rows for match, one for every line or symbol
+// of a file match. Line numbers are 1-based.
+func toCSVRecords(match result.Match) [][]string {
+	repo := string(match.RepoName().Name)
+	switch m := match.(type) {
+	case *result.FileMatch:
+		commit := string(m.CommitID)
+		if len(m.Symbols) > 0 {
+			records := make([][]string, 0, len(m.Symbols))
+			for _, s := range m.Symbols {
+				records = append(records, []string{"symbol", repo, commit, m.Path, strconv.Itoa(s.Symbol.Line), s.Symbol.Name})
+			}
+			return records
+		}
+		if len(m.LineMatches) > 0 {
+			records := make([][]string, 0, len(m.LineMatches))
+			for _, l := range m.LineMatches {
+				records = append(records, []string{"content", repo, commit, m.Path, strconv.Itoa(int(l.LineNumber) + 1), l.Preview})
+			}
+			return records
+		}
+		return [][]string{{"path", repo, commit, m.Path, "", ""}}
+	case *result.RepoMatch:
+		return [][]string{{"repo", repo, m.Rev, "", "", ""}}
+	case *result.CommitMatch:
+		if m.DiffPreview != nil {
+			return [][]string{{"diff", repo, string(m.Commit.ID), "", "", m.DiffPreview.Value}}
+		}
+		return [][]string{{"commit", repo, string(m.Commit.ID), "", "", string(m.Commit.Message)}}
+	default:
+		panic(fmt.Sprintf("unknown match type %T", m))
+	}
+}
@@ -0,0 +1,176 @@
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	api2 "github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/run"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming/api"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestExport(t *testing.T) {
+	// Only repos with odd IDs are visible to the actor.
+	database.Mocks.Repos.Metadata = func(ctx context.Context, ids ...api2.RepoID) (_ []*types.SearchedRepo, err error) {
+		res := make([]*types.SearchedRepo, 0, len(ids))
+		for _, id := range ids {
+			if id%2 == 0 {
+				continue
+			}
+			res = append(res, &types.SearchedRepo{
+				ID:   id,
+				Name: api2.RepoName(fmt.Sprintf("repo%d", id)),
+			})
+		}
+		return res, nil
+	}
+	t.Cleanup(func() { database.Mocks.Repos.Metadata = nil })
+
+	fileMatch := &result.FileMatch{
+		File: result.File{
+			Repo:     types.MinimalRepo{ID: 1, Name: "repo1"},
+			CommitID: "deadbeef",
+			Path:     "main.go",
+		},
+		LineMatches: []*result.LineMatch{{
+			Preview:          `fmt.Println("a, b")`,
+			LineNumber:       4,
+			OffsetAndLengths: [][2]int32{{0, 3}},
+		}},
+	}
+
+	cases := []struct {
+		name           string
+		query          string
+		format         string
+		maxResults     int
+		wantStatus     int
+		wantBody       string
+		wantLimitHit   bool
+		wantMatchCount int
+	}{{
+		name:           "csv",
+		query:          "foo",
+		format:         "csv",
+		maxResults:     100,
+		wantStatus:     http.StatusOK,
+		wantBody:       "type,repository,commit,path,line,text\ncontent,repo1,deadbeef,main.go,5,\"fmt.Println(\"\"a, b\"\")\"\nrepo,repo3,,,,\n",
+		wantMatchCount: 3,
+	}, {
+		name:           "jsonl",
+		query:          "foo",
+		format:         "jsonl",
+		maxResults:     100,
+		wantStatus:     http.StatusOK,
+		wantBody:       `{"type":"content","path":"main.go","repositoryID":1,"repository":"repo1","commit":"deadbeef","hunks":null,"lineMatches":[{"line":"fmt.Println(\"a, b\")","lineNumber":4,"offsetAndLengths":[[0,3]]}]}` + "\n" + `{"type":"repo","repositoryID":3,"repository":"repo3"}` + "\n",
+		wantMatchCount: 3,
+	}, {
+		name:           "capped by maxExportResults",
+		query:          "foo count:1000",
+		format:         "csv",
+		maxResults:     1,
+		wantStatus:     http.StatusOK,
+		wantBody:       "type,repository,commit,path,line,text\ncontent,repo1,deadbeef,main.go,5,\"fmt.Println(\"\"a, b\"\")\"\n",
+		wantLimitHit:   true,
+		wantMatchCount: 1,
+	}, {
+		name:       "invalid format",
+		query:      "foo",
+		format:     "xml",
+		maxResults: 100,
+		wantStatus: http.StatusBadRequest,
+		wantBody:   "format must be \"csv\" or \"jsonl\", got \"xml\"\n",
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mock := &mockSearchResolver{
+				done: make(chan struct{}),
+			}
+
+			ts := httptest.NewServer(&exportHandler{
+				streamHandler: streamHandler{
+					flushTickerInternal: 1 * time.Millisecond,
+					pingTickerInterval:  1 * time.Millisecond,
+					newSearchResolver: func(_ context.Context, _ database.DB, args *graphqlbackend.SearchArgs) (searchResolver, error) {
+						if args.DefaultLimit != c.maxResults {
+							t.Errorf("got default limit %d, want %d", args.DefaultLimit, c.maxResults)
+						}
+						q, err := query.Parse(c.query, query.Literal)
+						if err != nil {
+							t.Fatal(err)
+						}
+						mock.inputs = &run.SearchInputs{
+							Query:        q,
+							DefaultLimit: args.DefaultLimit,
+						}
+						go func() {
+							args.Stream.Send(streaming.SearchEvent{
+								Results: []result.Match{fileMatch, mkRepoMatch(2), mkRepoMatch(3)},
+							})
+							mock.Close()
+						}()
+						return mock, nil
+					},
+				},
+				maxResults: func() int { return c.maxResults },
+			})
+			defer ts.Close()
+
+			resp, err := http.Get(ts.URL + "?" + url.Values{"q": {c.query}, "format": {c.format}}.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.StatusCode != c.wantStatus {
+				t.Fatalf("got status %d, want %d", resp.StatusCode, c.wantStatus)
+			}
+			if d := cmp.Diff(c.wantBody, string(body)); d != "" {
+				t.Fatalf("mismatch (-want +got):\n%s", d)
+			}
+			if c.wantStatus != http.StatusOK {
+				return
+			}
+
+			if e := resp.Trailer.Get(exportTrailerError); e != "" {
+				t.Fatalf("unexpected error trailer: %s", e)
+			}
+			var progress api.Progress
+			if err := json.Unmarshal([]byte(resp.Trailer.Get(exportTrailerProgress)), &progress); err != nil {
+				t.Fatal(err)
+			}
+			if progress.MatchCount != c.wantMatchCount {
+				t.Fatalf("got match count %d, want %d", progress.MatchCount, c.wantMatchCount)
+			}
+			limitHit := false
+			for _, s := range progress.Skipped {
+				if s.Reason == api.ShardMatchLimit {
+					limitHit = true
+				}
+			}
+			if limitHit != c.wantLimitHit {
+				t.Fatalf("got limit hit %t, want %t", limitHit, c.wantLimitHit)
+			}
+		})
+	}
+}
@@ -84,7 +84,7 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Log events to trace
 	eventWriter.StatHook = eventStreamOTHook(tr.LogFields)
 
-	events, inputs, results := h.startSearch(ctx, args)
+	events, inputs, results := h.startSearch(ctx, args, 0)
 	events = batchEvents(events, 50*time.Millisecond)
 
 	// Display is the number of results we send down. If display is < 0 we
@@ -114,6 +114,29 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		_ = eventWriter.Event("progress", progress.Current())
 	}
 
+	// aggregator is only set if the client asked for an aggregation.
+	var aggregator *streaming.SearchAggregator
+	if args.Aggregate != "" {
+		// parseURLQuery already validated the mode and pattern.
+		aggregator, _ = streaming.NewSearchAggregator(args.Aggregate, args.AggregatePattern)
+		aggregator.Limit = inputs.MaxResults()
+	}
+	sendAggregate := func() error {
+		groups := aggregator.Compute()
+		buf := make([]streamhttp.EventAggregateGroup, 0, len(groups))
+		for _, g := range groups {
+			buf = append(buf, streamhttp.EventAggregateGroup{
+				Label: g.Label,
+				Count: g.Count,
+			})
+		}
+		return eventWriter.Event("aggregate", streamhttp.EventAggregate{
+			Mode:        string(aggregator.Mode),
+			Groups:      buf,
+			Approximate: aggregator.Approximate(),
+		})
+	}
+
 	// Store marshalled matches and flush periodically or when we go over
 	// 32kb. 32kb chosen to be smaller than bufio.MaxTokenSize. Note: we can
 	// still write more than that.
@@ -129,6 +152,10 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if progress.Dirty {
 			sendProgress()
 		}
+
+		if aggregator != nil && aggregator.Dirty() {
+			_ = sendAggregate()
+		}
 	}
 	flushTicker := time.NewTicker(h.flushTickerInternal)
 	defer flushTicker.Stop()
@@ -142,6 +169,10 @@ func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	handleEvent := func(event streaming.SearchEvent) {
 		progress.Update(event)
 		filters.Update(event)
+		if aggregator != nil {
+			// Aggregate over all matches, not just the ones we display.
+			aggregator.Update(event)
+		}
 
 		// Truncate the event to the match limit before fetching repo metadata
 		for i, match := range event.Results {
@@ -224,6 +255,14 @@ LOOP:
 		}
 	}
 
+	// Send the final aggregation once, even if nothing matched.
+	if aggregator != nil {
+		if err := sendAggregate(); err != nil {
+			// EOF
+			return
+		}
+	}
+
 	resultsResolver, err := results()
 	if err != nil {
 		_ = eventWriter.Event("error", streamhttp.EventError{Message: err.Error()})
@@ -282,7 +321,7 @@ LOOP:
 // startSearch will start a search. It returns the events channel which
 // streams out search events. Once events is closed you can call results which
 // will return the results resolver and error.
-func (h *streamHandler) startSearch(ctx context.Context, a *args) (events <-chan streaming.SearchEvent, inputs run.SearchInputs, results func() (*graphqlbackend.SearchResultsResolver, error)) {
+func (h *streamHandler) startSearch(ctx context.Context, a *args, defaultLimit int) (events <-chan streaming.SearchEvent, inputs run.SearchInputs, results func() (*graphqlbackend.SearchResultsResolver, error)) {
 	eventsC := make(chan streaming.SearchEvent)
 
 	search, err := h.newSearchResolver(ctx, h.db, &graphqlbackend.SearchArgs{
@@ -290,6 +329,8 @@ func (h *streamHandler) startSearch(ctx context.Context, a *args) (events <-chan
 		Version:     a.Version,
 		PatternType: strPtr(a.PatternType),
 
+		DefaultLimit: defaultLimit,
+
 		Stream: streaming.StreamFunc(func(event streaming.SearchEvent) {
 			eventsC <- event
 		}),
@@ -341,6 +382,11 @@ type args struct {
 	DecorationLimit        int    // The initial number of files to decorate in the result set.
 	DecorationKind         string // The kind of decoration to apply (HTML highlighting, plaintext, etc.)
 	DecorationContextLines int    // The number of lines of context to include around lines with matches.
+
+	// Optional aggregation parameters. If Aggregate is set, matches are
+	// grouped and counted by it and sent as aggregate events.
+	Aggregate        streaming.AggregationMode
+	AggregatePattern string // The regular expression for capture_group aggregations.
 }
 
 func parseURLQuery(q url.Values) (*args, error) {
@@ -379,6 +425,16 @@ func parseURLQuery(q url.Values) (*args, error) {
 		return nil, errors.Errorf("decorationContextLines must be an integer, got %q: %w", decorationContextLines, err)
 	}
 
+	if aggregate := get("aggregate", ""); aggregate != "" {
+		if a.Aggregate, err = streaming.ParseAggregationMode(aggregate); err != nil {
+			return nil, err
+		}
+		a.AggregatePattern = get("aggregate_pattern", "")
+		if _, err := streaming.NewSearchAggregator(a.Aggregate, a.AggregatePattern); err != nil {
+			return nil, err
+		}
+	}
+
 	return &a, nil
 }
 
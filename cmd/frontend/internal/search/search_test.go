@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
@@ -209,6 +210,93 @@ func TestDisplayLimit(t *testing.T) {
 	}
 }
 
+func TestAggregate(t *testing.T) {
+	mock := &mockSearchResolver{
+		done: make(chan struct{}),
+	}
+
+	database.Mocks.Repos.Metadata = func(ctx context.Context, ids ...api2.RepoID) (_ []*types.SearchedRepo, err error) {
+		res := make([]*types.SearchedRepo, 0, len(ids))
+		for _, id := range ids {
+			res = append(res, &types.SearchedRepo{
+				ID: id,
+			})
+		}
+		return res, nil
+	}
+
+	ts := httptest.NewServer(&streamHandler{
+		flushTickerInternal: 1 * time.Millisecond,
+		pingTickerInterval:  1 * time.Millisecond,
+		newSearchResolver: func(_ context.Context, _ database.DB, args *graphqlbackend.SearchArgs) (searchResolver, error) {
+			mock.c = args.Stream
+			q, err := query.Parse("foo count:2", query.Literal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			mock.inputs = &run.SearchInputs{
+				Query: q,
+			}
+			return mock, nil
+		}})
+	defer ts.Close()
+
+	t.Run("invalid mode", func(t *testing.T) {
+		res, err := http.Get(ts.URL + "?q=foo&aggregate=bogus")
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", res.StatusCode)
+		}
+	})
+
+	req, _ := streamhttp.NewRequest(ts.URL, "foo count:2")
+	q := req.URL.Query()
+	q.Add("aggregate", "repo")
+	req.URL.RawQuery = q.Encode()
+
+	var last *streamhttp.EventAggregate
+	decoder := streamhttp.FrontendStreamDecoder{
+		OnAggregate: func(aggregate *streamhttp.EventAggregate) {
+			last = aggregate
+		},
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	g := errgroup.Group{}
+	g.Go(func() error {
+		return decoder.ReadAll(resp.Body)
+	})
+
+	// Send 3 repository matches, one more than the limit.
+	mock.c.Send(streaming.SearchEvent{
+		Results: []result.Match{mkRepoMatch(1), mkRepoMatch(2), mkRepoMatch(3)},
+	})
+	mock.Close()
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &streamhttp.EventAggregate{
+		Mode: "repo",
+		Groups: []streamhttp.EventAggregateGroup{
+			{Label: "repo1", Count: 1},
+			{Label: "repo2", Count: 1},
+		},
+		Approximate: true,
+	}
+	if d := cmp.Diff(want, last); d != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", d)
+	}
+}
+
 func mkRepoMatch(id int) *result.RepoMatch {
 	return &result.RepoMatch{
 		ID:   api2.RepoID(id),
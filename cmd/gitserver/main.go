@@ -34,6 +34,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
 	"github.com/sourcegraph/sourcegraph/internal/hostname"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/jsonc"
 	"github.com/sourcegraph/sourcegraph/internal/logging"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
@@ -53,6 +54,9 @@ var (
 	syncRepoStateInterval        = env.MustGetDuration("SRC_REPOS_SYNC_STATE_INTERVAL", 10*time.Minute, "Interval between state syncs")
 	syncRepoStateBatchSize       = env.MustGetInt("SRC_REPOS_SYNC_STATE_BATCH_SIZE", 500, "Number of upserts to perform per batch")
 	syncRepoStateUpsertPerSecond = env.MustGetInt("SRC_REPOS_SYNC_STATE_UPSERT_PER_SEC", 500, "The number of upserted rows allowed per second across all gitserver instances")
+	reconcileReplicasInterval    = env.MustGetDuration("SRC_REPOS_RECONCILE_REPLICAS_INTERVAL", 1*time.Minute, "Interval between replica reconciliation runs")
+	reconcileReplicasBatchSize   = env.MustGetInt("SRC_REPOS_RECONCILE_REPLICAS_BATCH_SIZE", 100, "Maximum number of replicas to clone or fetch per replica reconciliation run")
+	backupDir                    = env.Get("SRC_REPOS_BACKUP_DIR", "", "Dir containing repository backups. If set, repos are restored from their backup before being cloned from the code host.")
 )
 
 func main() {
@@ -78,6 +82,13 @@ func main() {
 		log.Fatalf("failed to create SRC_REPOS_DIR: %s", err)
 	}
 
+	// "gitserver backup [repo...]" backs up repositories instead of serving
+	// requests.
+	if len(os.Args) >= 2 && os.Args[1] == "backup" {
+		runBackup(ctx, os.Args[2:])
+		return
+	}
+
 	wantPctFree2, err := getPercent(wantPctFree)
 	if err != nil {
 		log.Fatalf("SRC_REPOS_DESIRED_PERCENT_FREE is out of range: %v", err)
@@ -129,6 +140,9 @@ func main() {
 		DB:         db,
 		CloneQueue: server.NewCloneQueue(list.New()),
 	}
+	if backupDir != "" {
+		gitserver.Backups = &server.DirBackupStore{Dir: backupDir}
+	}
 	gitserver.RegisterMetrics()
 
 	if tmpDir, err := gitserver.SetupAndClearTmp(); err != nil {
@@ -151,6 +165,7 @@ func main() {
 	go debugserver.NewServerRoutine(ready).Start()
 	go gitserver.Janitor(janitorInterval)
 	go gitserver.SyncRepoState(syncRepoStateInterval, syncRepoStateBatchSize, syncRepoStateUpsertPerSecond)
+	go gitserver.ReconcileReplicas(reconcileReplicasInterval, reconcileReplicasBatchSize)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -202,6 +217,36 @@ func main() {
 	gitserver.Stop()
 }
 
+// runBackup backs up repos, or all repositories in SRC_REPOS_DIR if repos is
+// empty, to SRC_REPOS_BACKUP_DIR. It exits with a non-zero status if any
+// repository could not be backed up.
+func runBackup(ctx context.Context, repos []string) {
+	if backupDir == "" {
+		log.Fatal("git-server: SRC_REPOS_BACKUP_DIR is required to back up repositories")
+	}
+
+	s := server.Server{
+		ReposDir: reposDir,
+		Backups:  &server.DirBackupStore{Dir: backupDir},
+	}
+	names := make([]api.RepoName, 0, len(repos))
+	for _, repo := range repos {
+		names = append(names, api.RepoName(repo))
+	}
+
+	resp, err := s.Backup(ctx, names)
+	if err != nil {
+		log.Fatalf("failed to back up repositories: %s", err)
+	}
+	for repo, err := range resp.Errors {
+		log15.Error("git-server: failed to back up repository", "repo", repo, "error", err)
+	}
+	log15.Info("git-server: backed up repositories", "dir", backupDir, "count", len(resp.BackedUp), "failed", len(resp.Errors))
+	if len(resp.Errors) > 0 {
+		os.Exit(1)
+	}
+}
+
 func configureFusionClient(conn schema.PerforceConnection) server.FusionConfig {
 	// Set up default settings first
 	fc := server.FusionConfig{
@@ -317,7 +362,27 @@ func getVCSSyncer(ctx context.Context, externalServiceStore database.ExternalSer
 		if err := extractOptions(&c); err != nil {
 			return nil, err
 		}
-		return &server.JVMPackagesSyncer{Config: &c, DBStore: codeintelDB}, nil
+		return server.NewJVMPackagesSyncer(&c, codeintelDB), nil
+	case extsvc.TypeNpmPackages:
+		var c schema.NpmPackagesConnection
+		if err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		return server.NewNpmPackagesSyncer(&c, codeintelDB, httpcli.ExternalDoer), nil
+	case extsvc.TypeGoModules:
+		var c schema.GoModulesConnection
+		if err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		return server.NewGoModulesSyncer(&c, codeintelDB, httpcli.ExternalDoer), nil
+	case extsvc.TypeMercurial:
+		return &server.MercurialSyncer{}, nil
+	case extsvc.TypeSubversion:
+		var c schema.SubversionConnection
+		if err := extractOptions(&c); err != nil {
+			return nil, err
+		}
+		return &server.SubversionSyncer{Layout: c.Layout}, nil
 	}
 	return &server.GitRepoSyncer{}, nil
 }
@@ -0,0 +1,383 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+)
+
+// BackupStore stores the backups of repositories. A backup of a repository
+// consists of a git bundle with all of its refs and a small JSON document with
+// its HEAD and the sourcegraph.* git config entries, such as sourcegraph.type
+// and sourcegraph.recloneTimestamp.
+type BackupStore interface {
+	// Put stores the content of r under key, replacing any previous content.
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Get returns the content stored under key. The returned error wraps
+	// fs.ErrNotExist if nothing is stored under key.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// DirBackupStore is a BackupStore that stores backups as files in a local
+// directory, such as a mounted volume. Keys are paths relative to Dir.
+type DirBackupStore struct {
+	Dir string
+}
+
+var _ BackupStore = &DirBackupStore{}
+
+func (d *DirBackupStore) path(key string) string {
+	// Cleaning the key as an absolute path ensures it can't escape Dir.
+	return filepath.Join(d.Dir, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+func (d *DirBackupStore) Put(ctx context.Context, key string, r io.Reader) error {
+	p := d.path(key)
+	if err := os.MkdirAll(filepath.Dir(p), os.ModePerm); err != nil {
+		return err
+	}
+
+	// We write to a temporary file first, so that an interrupted backup
+	// doesn't replace the previous one.
+	f, err := os.CreateTemp(filepath.Dir(p), ".tmp-backup-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
+
+func (d *DirBackupStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(d.path(key))
+}
+
+// backupBundleKey returns the key of the git bundle of repo.
+func backupBundleKey(repo api.RepoName) string {
+	return string(protocol.NormalizeRepo(repo)) + ".bundle"
+}
+
+// backupMetadataKey returns the key of the metadata of the backup of repo. It
+// is stored after the bundle, so a backup is complete once it exists.
+func backupMetadataKey(repo api.RepoName) string {
+	return string(protocol.NormalizeRepo(repo)) + ".json"
+}
+
+// repoBackupMetadata is the state of a repository which is not part of its
+// git bundle.
+type repoBackupMetadata struct {
+	// Head is the ref HEAD points to. It is empty if HEAD is detached.
+	Head string
+
+	// HeadCommit is the commit a detached HEAD points to.
+	HeadCommit string `json:",omitempty"`
+
+	// Config are the sourcegraph.* git config entries of the repository.
+	Config map[string]string
+}
+
+var repoBackupCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_repo_backup_total",
+	Help: "Incremented each time a repository is backed up or restored, labelled by operation and success",
+}, []string{"op", "success"})
+
+func (s *Server) handleRepoBackup(w http.ResponseWriter, r *http.Request) {
+	if s.Backups == nil {
+		http.Error(w, "repository backups are not configured", http.StatusNotImplemented)
+		return
+	}
+
+	var req protocol.RepoBackupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.Backup(r.Context(), req.Repos)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// Backup backs up repos to s.Backups, or all repositories cloned on this
+// gitserver if repos is empty. Repositories that fail to back up are reported
+// in the response, the returned error is only set if the repositories could
+// not be listed.
+func (s *Server) Backup(ctx context.Context, repos []api.RepoName) (*protocol.RepoBackupResponse, error) {
+	if len(repos) == 0 {
+		var err error
+		if repos, err = s.clonedRepos(); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &protocol.RepoBackupResponse{
+		Errors: map[api.RepoName]string{},
+	}
+	for _, repo := range repos {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		err := s.backupRepo(ctx, repo)
+		repoBackupCounter.WithLabelValues("backup", strconv.FormatBool(err == nil)).Inc()
+		if err != nil {
+			log15.Warn("backing up repository failed", "repo", repo, "error", err)
+			resp.Errors[repo] = err.Error()
+			continue
+		}
+		resp.BackedUp = append(resp.BackedUp, repo)
+	}
+	return resp, nil
+}
+
+// clonedRepos returns the names of all repositories cloned on this gitserver.
+func (s *Server) clonedRepos() ([]api.RepoName, error) {
+	var repos []api.RepoName
+	err := bestEffortWalk(s.ReposDir, func(dir string, fi fs.FileInfo) error {
+		if s.ignorePath(dir) {
+			if fi.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		// Look for $GIT_DIR
+		if !fi.IsDir() || fi.Name() != ".git" {
+			return nil
+		}
+
+		repos = append(repos, s.name(GitDir(dir)))
+		return filepath.SkipDir
+	})
+	return repos, err
+}
+
+// restorableSyncer returns whether repositories of the syncer can be restored
+// from a backup. Copies from other gitservers are cheap, and the bundle of a
+// backup doesn't contain the state that git-svn, git-remote-hg and git-p4
+// keep inside the Git directory to fetch incrementally.
+func restorableSyncer(syncer VCSSyncer) bool {
+	switch syncer.(type) {
+	case *peerSyncer, *SubversionSyncer, *MercurialSyncer, *PerforceDepotSyncer:
+		return false
+	default:
+		return true
+	}
+}
+
+// backupRepo stores a git bundle with all refs of repo and its metadata in
+// s.Backups.
+func (s *Server) backupRepo(ctx context.Context, repo api.RepoName) error {
+	dir := s.dir(repo)
+	if !repoCloned(dir) {
+		return errors.Errorf("repository %s is not cloned", repo)
+	}
+
+	tmpDir, err := s.tempDir("backup-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+	bundlePath := filepath.Join(tmpDir, "repo.bundle")
+
+	cmd := exec.CommandContext(ctx, "git", "bundle", "create", bundlePath, "--all")
+	dir.Set(cmd)
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return errors.Wrapf(err, "git bundle create failed with output %q", string(output))
+	}
+
+	meta, err := readBackupMetadata(ctx, dir)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(bundlePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := s.Backups.Put(ctx, backupBundleKey(repo), f); err != nil {
+		return errors.Wrap(err, "store bundle")
+	}
+	if err := s.Backups.Put(ctx, backupMetadataKey(repo), bytes.NewReader(b)); err != nil {
+		return errors.Wrap(err, "store metadata")
+	}
+	return nil
+}
+
+// readBackupMetadata returns the HEAD and sourcegraph.* git config entries of
+// the repository in dir.
+func readBackupMetadata(ctx context.Context, dir GitDir) (*repoBackupMetadata, error) {
+	meta := &repoBackupMetadata{
+		Config: map[string]string{},
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "symbolic-ref", "-q", "HEAD")
+	dir.Set(cmd)
+	head, err := cmd.Output()
+	if err != nil {
+		// Exit code 1 means HEAD is detached.
+		var e *exec.ExitError
+		if !errors.As(err, &e) || e.ExitCode() != 1 {
+			return nil, errors.Wrap(wrapCmdError(cmd, err), "failed to read HEAD")
+		}
+
+		cmd = exec.CommandContext(ctx, "git", "rev-parse", "--verify", "HEAD")
+		dir.Set(cmd)
+		if head, err = cmd.Output(); err != nil {
+			return nil, errors.Wrap(wrapCmdError(cmd, err), "failed to resolve detached HEAD")
+		}
+		meta.HeadCommit = strings.TrimSpace(string(head))
+	} else {
+		meta.Head = strings.TrimSpace(string(head))
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "config", "--get-regexp", `^sourcegraph\.`)
+	dir.Set(cmd)
+	out, err := cmd.Output()
+	if err != nil {
+		// Exit code 1 means no key is set.
+		var e *exec.ExitError
+		if errors.As(err, &e) && e.ExitCode() == 1 {
+			return meta, nil
+		}
+		return nil, errors.Wrap(wrapCmdError(cmd, err), "failed to read git config")
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(string(out)))
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), " ", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		meta.Config[kv[0]] = kv[1]
+	}
+	return meta, nil
+}
+
+// restoreRepo restores the backup of repo into tmp, a new GitDir. It returns
+// false if there is no backup of repo.
+func (s *Server) restoreRepo(ctx context.Context, repo api.RepoName, tmp GitDir) (restored bool, err error) {
+	defer func() {
+		if restored || err != nil {
+			repoBackupCounter.WithLabelValues("restore", strconv.FormatBool(err == nil)).Inc()
+		}
+	}()
+
+	rc, err := s.Backups.Get(ctx, backupMetadataKey(repo))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "get metadata")
+	}
+	var meta repoBackupMetadata
+	err = json.NewDecoder(rc).Decode(&meta)
+	rc.Close()
+	if err != nil {
+		return false, errors.Wrap(err, "decode metadata")
+	}
+
+	// git can only fetch from a bundle stored in a file. The parent of tmp is
+	// a temporary directory owned by the caller.
+	bundlePath := filepath.Join(filepath.Dir(string(tmp)), "repo.bundle")
+	if err := s.downloadBackup(ctx, backupBundleKey(repo), bundlePath); err != nil {
+		return false, errors.Wrap(err, "get bundle")
+	}
+	defer os.Remove(bundlePath)
+
+	if err := os.MkdirAll(string(tmp), os.ModePerm); err != nil {
+		return false, errors.Wrap(err, "restore failed to create tmp dir")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "init", "--bare", ".")
+	tmp.Set(cmd)
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return false, errors.Wrapf(err, "git init failed with output %q", string(output))
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "fetch", "--progress", bundlePath, "+refs/*:refs/*")
+	tmp.Set(cmd)
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return false, errors.Wrapf(err, "git fetch from bundle failed with output %q", string(output))
+	}
+
+	if meta.Head != "" {
+		cmd = exec.CommandContext(ctx, "git", "symbolic-ref", "HEAD", meta.Head)
+		tmp.Set(cmd)
+		if output, err := runWith(ctx, cmd, false, nil); err != nil {
+			return false, errors.Wrapf(err, "git symbolic-ref failed with output %q", string(output))
+		}
+	} else if meta.HeadCommit != "" {
+		cmd = exec.CommandContext(ctx, "git", "update-ref", "--no-deref", "HEAD", meta.HeadCommit)
+		tmp.Set(cmd)
+		if output, err := runWith(ctx, cmd, false, nil); err != nil {
+			return false, errors.Wrapf(err, "git update-ref HEAD failed with output %q", string(output))
+		}
+	}
+
+	for key, value := range meta.Config {
+		if err := gitConfigSet(tmp, key, value); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// downloadBackup writes the content stored under key in s.Backups to the file
+// at dst.
+func (s *Server) downloadBackup(ctx context.Context, key, dst string) error {
+	rc, err := s.Backups.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, rc); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestBackupAndRestore(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	remote := t.TempDir()
+	repoName := api.RepoName("example.com/foo/bar")
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	makeSingleCommitRepo(cmd)
+	cmd("git", "checkout", "-q", "-b", "main")
+	cmd("git", "commit", "--allow-empty", "-q", "-m", "main")
+
+	backups := &DirBackupStore{Dir: t.TempDir()}
+
+	s := makeTestServer(ctx, t.TempDir(), remote, nil)
+	s.Backups = backups
+	if _, err := s.cloneRepo(ctx, repoName, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+	dir := string(s.dir(repoName))
+	runCmd(t, dir, "git", "symbolic-ref", "HEAD", "refs/heads/main")
+	runCmd(t, dir, "git", "config", "sourcegraph.recloneTimestamp", "1600000000")
+
+	resp, err := s.Backup(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.BackedUp) != 1 || resp.BackedUp[0] != repoName || len(resp.Errors) != 0 {
+		t.Fatalf("unexpected backup response: %+v", resp)
+	}
+
+	// The code host moved on since the backup, a restored repository is
+	// fetched to catch up.
+	cmd("git", "commit", "--allow-empty", "-q", "-m", "after backup")
+	wantCommit := cmd("git", "rev-parse", "HEAD")
+
+	restored := makeTestServer(ctx, t.TempDir(), remote, nil)
+	restored.Backups = backups
+	if _, err := restored.cloneRepo(ctx, repoName, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir = string(restored.dir(repoName))
+	if got := runCmd(t, dir, "git", "rev-parse", "refs/heads/main"); got != wantCommit {
+		t.Errorf("main: got %q, want %q", got, wantCommit)
+	}
+	// The reclone timestamp is only set if the repository was restored.
+	for key, want := range map[string]string{
+		"sourcegraph.type":             "git",
+		"sourcegraph.recloneTimestamp": "1600000000",
+	} {
+		if got := strings.TrimSpace(runCmd(t, dir, "git", "config", key)); got != want {
+			t.Errorf("%s: got %q, want %q", key, got, want)
+		}
+	}
+	if got := strings.TrimSpace(runCmd(t, dir, "git", "symbolic-ref", "HEAD")); got != "refs/heads/main" {
+		t.Errorf("HEAD: got %q, want %q", got, "refs/heads/main")
+	}
+
+	// Without a backup, the repository is cloned from the code host.
+	other := api.RepoName("example.com/foo/baz")
+	if _, err := restored.cloneRepo(ctx, other, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := runCmd(t, string(restored.dir(other)), "git", "rev-parse", "refs/heads/main"), cmd("git", "rev-parse", "HEAD"); got != want {
+		t.Errorf("main of cloned repo: got %q, want %q", got, want)
+	}
+}
+
+func TestBackupAndRestore_DetachedHEAD(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	remote := t.TempDir()
+	repoName := api.RepoName("example.com/foo/bar")
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	wantCommit := makeSingleCommitRepo(cmd)
+
+	backups := &DirBackupStore{Dir: t.TempDir()}
+
+	s := makeTestServer(ctx, t.TempDir(), remote, nil)
+	s.Backups = backups
+	if _, err := s.cloneRepo(ctx, repoName, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+	dir := string(s.dir(repoName))
+	runCmd(t, dir, "git", "update-ref", "--no-deref", "HEAD", strings.TrimSpace(wantCommit))
+
+	resp, err := s.Backup(ctx, []api.RepoName{repoName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.BackedUp) != 1 || len(resp.Errors) != 0 {
+		t.Fatalf("unexpected backup response: %+v", resp)
+	}
+
+	restored := makeTestServer(ctx, t.TempDir(), remote, nil)
+	restored.Backups = backups
+	if _, err := restored.cloneRepo(ctx, repoName, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir = string(restored.dir(repoName))
+	if got := runCmd(t, dir, "git", "rev-parse", "HEAD"); got != wantCommit {
+		t.Errorf("HEAD: got %q, want %q", got, wantCommit)
+	}
+	if got := strings.TrimSpace(runCmd(t, dir, "git", "rev-parse", "--symbolic-full-name", "HEAD")); got != "HEAD" {
+		t.Errorf("HEAD is not detached, it points to %q", got)
+	}
+}
+
+func TestRestorableSyncer(t *testing.T) {
+	for _, tc := range []struct {
+		syncer VCSSyncer
+		want   bool
+	}{
+		{syncer: &GitRepoSyncer{}, want: true},
+		{syncer: &peerSyncer{}, want: false},
+		{syncer: &SubversionSyncer{}, want: false},
+		{syncer: &MercurialSyncer{}, want: false},
+		{syncer: &PerforceDepotSyncer{}, want: false},
+	} {
+		if got := restorableSyncer(tc.syncer); got != tc.want {
+			t.Errorf("%s: got %t, want %t", tc.syncer.Type(), got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// peerSyncer is a syncer for repositories that are copied from another
+// gitserver, which serves them over the smart HTTP protocol at /git/. All refs
+// are mirrored, so that the copy is identical to the repository on the other
+// gitserver. Type returns the type of the syncer of the repository, so that the
+// copy records the same sourcegraph.type as the original.
+type peerSyncer struct {
+	GitRepoSyncer
+	typ string
+}
+
+var _ VCSSyncer = &peerSyncer{}
+
+func (s *peerSyncer) Type() string {
+	return s.typ
+}
+
+// CloneCommand returns the command to be executed for copying a repository
+// from another gitserver.
+func (s *peerSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (*exec.Cmd, error) {
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "init", "--bare", ".")
+	cmd.Dir = tmpPath
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "clone setup failed")
+	}
+
+	cmd = s.fetchCommand(ctx, remoteURL)
+	cmd.Dir = tmpPath
+	return cmd, nil
+}
+
+func (s *peerSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
+	return exec.CommandContext(ctx, "git", "fetch", "--progress", "--prune", remoteURL.String(), "+refs/*:refs/*")
+}
+
+// Fetch fetches updates of a repository from another gitserver.
+func (s *peerSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	cmd := s.fetchCommand(ctx, remoteURL)
+	dir.Set(cmd)
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return errors.Wrapf(err, "failed to update from gitserver with output %q", string(output))
+	}
+	return nil
+}
+
+// peerSource returns the syncer and remote URL to copy repo from the gitserver
+// at addr. syncer is the syncer of the repository.
+func peerSource(syncer VCSSyncer, addr string, repo api.RepoName) (VCSSyncer, *vcs.URL, error) {
+	remoteURL, err := vcs.ParseURL("http://" + addr + "/git/" + string(repo))
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "parse URL of %s on gitserver %s", repo, addr)
+	}
+	return &peerSyncer{typ: syncer.Type()}, remoteURL, nil
+}
+
+// replicaOf returns the address of the primary gitserver of repo if this
+// gitserver stores a replica of repo. Replicas are cloned and fetched from the
+// primary instead of the code host.
+func (s *Server) replicaOf(repo api.RepoName) (primary string, ok bool) {
+	n := conf.GitReplicationFactor()
+	if n <= 1 {
+		return "", false
+	}
+	addrs := conf.Get().ServiceConnections().GitServers
+	if len(addrs) == 0 {
+		return "", false
+	}
+
+	replicas := gitserver.ReplicaAddrsForRepo(repo, addrs, n)
+	for _, addr := range replicas[1:] {
+		if s.hostnameMatch(addr) {
+			return replicas[0], true
+		}
+	}
+	return "", false
+}
+
+// recordsRepoState reports whether this gitserver records the state of repo in
+// the database, which only the primary gitserver of repo does. Replicas, and
+// gitservers that still store a repo that was moved to another gitserver, must
+// not overwrite the state recorded by the primary. If this gitserver is not in
+// the list of gitservers, we can't tell and record the state.
+func (s *Server) recordsRepoState(repo api.RepoName) bool {
+	addrs := conf.Get().ServiceConnections().GitServers
+	for _, addr := range addrs {
+		if s.hostnameMatch(addr) {
+			return s.hostnameMatch(gitserver.AddrForRepo(repo, addrs))
+		}
+	}
+	return true
+}
+
+type replicaAction string
+
+const (
+	replicaUpToDate replicaAction = "up_to_date"
+	replicaClone    replicaAction = "clone"
+	replicaFetch    replicaAction = "fetch"
+)
+
+// reconcileReplicaAction returns what needs to be done to bring a replica up
+// to date with primary, the state of the repository on its primary gitserver.
+// cloned and lastChanged describe the replica.
+func reconcileReplicaAction(primary *types.GitserverRepo, cloned bool, lastChanged time.Time) replicaAction {
+	if primary == nil || primary.CloneStatus != types.CloneStatusCloned {
+		// There is nothing to copy yet.
+		return replicaUpToDate
+	}
+	if !cloned {
+		return replicaClone
+	}
+	if primary.LastChanged.After(lastChanged) {
+		return replicaFetch
+	}
+	return replicaUpToDate
+}
+
+var replicaReconcileCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_replica_reconcile_total",
+	Help: "Incremented each time the replica reconciler checks a replica, labelled by the action it took",
+}, []string{"action"})
+
+// ReconcileReplicas ensures that the replicas stored on this gitserver
+// converge to their primaries and is expected to run in a background
+// goroutine. Every interval, replicas that are missing are cloned and replicas
+// that are older than their primary are fetched, at most batchSize of them
+// per run.
+func (s *Server) ReconcileReplicas(interval time.Duration, batchSize int) {
+	for {
+		if conf.GitReplicationFactor() > 1 {
+			if err := s.reconcileReplicas(batchSize); err != nil {
+				log15.Error("Reconciling replicas", "error", err)
+			}
+		}
+		time.Sleep(interval)
+	}
+}
+
+func (s *Server) reconcileReplicas(batchSize int) error {
+	ctx := s.ctx
+
+	// We collect the work before doing any of it, so that we don't hold on to
+	// a database connection while fetching.
+	var clones, fetches []api.RepoName
+	err := database.GitserverRepos(s.DB).IterateRepoGitserverStatus(ctx, database.IterateRepoGitserverStatusOptions{}, func(repo types.RepoGitserverStatus) error {
+		if _, ok := s.replicaOf(repo.Name); !ok {
+			return nil
+		}
+
+		dir := s.dir(repo.Name)
+		cloned := repoCloned(dir)
+		var lastChanged time.Time
+		if cloned {
+			// A missing stamp is treated as outdated.
+			lastChanged, _ = repoLastChanged(dir)
+		}
+
+		action := reconcileReplicaAction(repo.GitserverRepo, cloned, lastChanged)
+		replicaReconcileCounter.WithLabelValues(string(action)).Inc()
+		switch action {
+		case replicaClone:
+			clones = append(clones, repo.Name)
+		case replicaFetch:
+			fetches = append(fetches, repo.Name)
+		}
+
+		if len(clones)+len(fetches) >= batchSize {
+			return errStopIteration
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopIteration) {
+		return err
+	}
+
+	// Clones are queued, the clone pipeline limits how many run at once.
+	for _, repo := range clones {
+		if _, err := s.cloneRepo(ctx, repo, nil); err != nil {
+			log15.Warn("Cloning replica", "repo", repo, "error", err)
+		}
+	}
+
+	// Fetches share the clone limiter with the repositories this gitserver is
+	// the primary of, so we run them one at a time to not starve those.
+	for _, repo := range fetches {
+		if err := s.doRepoUpdate(ctx, repo); err != nil {
+			log15.Warn("Fetching replica", "repo", repo, "error", err)
+		}
+	}
+
+	return nil
+}
+
+var errStopIteration = errors.New("stop iteration")
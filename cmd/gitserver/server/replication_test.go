@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestReconcileReplicaAction(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		primary     *types.GitserverRepo
+		cloned      bool
+		lastChanged time.Time
+		want        replicaAction
+	}{
+		{
+			name:    "primary has no state",
+			primary: nil,
+			want:    replicaUpToDate,
+		},
+		{
+			name:    "primary not cloned",
+			primary: &types.GitserverRepo{CloneStatus: types.CloneStatusCloning},
+			want:    replicaUpToDate,
+		},
+		{
+			name:    "replica not cloned",
+			primary: &types.GitserverRepo{CloneStatus: types.CloneStatusCloned, LastChanged: now},
+			want:    replicaClone,
+		},
+		{
+			name:        "replica older than primary",
+			primary:     &types.GitserverRepo{CloneStatus: types.CloneStatusCloned, LastChanged: now},
+			cloned:      true,
+			lastChanged: now.Add(-time.Hour),
+			want:        replicaFetch,
+		},
+		{
+			name:        "replica without last changed stamp",
+			primary:     &types.GitserverRepo{CloneStatus: types.CloneStatusCloned, LastChanged: now},
+			cloned:      true,
+			lastChanged: time.Time{},
+			want:        replicaFetch,
+		},
+		{
+			name:        "replica up to date",
+			primary:     &types.GitserverRepo{CloneStatus: types.CloneStatusCloned, LastChanged: now},
+			cloned:      true,
+			lastChanged: now.Add(time.Minute),
+			want:        replicaUpToDate,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := reconcileReplicaAction(test.primary, test.cloned, test.lastChanged); got != test.want {
+				t.Fatalf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCloneRepo_MigrateFrom(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	remote := t.TempDir()
+	repoName := api.RepoName("example.com/foo/bar")
+	cmd := func(name string, arg ...string) string {
+		t.Helper()
+		return runCmd(t, remote, name, arg...)
+	}
+	wantCommit := makeSingleCommitRepo(cmd)
+	cmd("git", "checkout", "-q", "-b", "feature")
+	cmd("git", "commit", "--allow-empty", "-q", "-m", "feature")
+	wantFeature := cmd("git", "rev-parse", "HEAD")
+
+	// The primary clones from the code host and serves the repository to its
+	// peers.
+	primary := makeTestServer(ctx, t.TempDir(), remote, nil)
+	if _, err := primary.cloneRepo(ctx, repoName, &cloneOptions{Block: true}); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.StripPrefix("/git", primary.gitServiceHandler()))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL)
+
+	// The code host is unreachable for the replica, it must copy the
+	// repository from the primary.
+	replica := makeTestServer(ctx, t.TempDir(), "/does/not/exist", nil)
+	if _, err := replica.cloneRepo(ctx, repoName, &cloneOptions{Block: true, MigrateFrom: u.Host}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := string(replica.dir(repoName))
+	if got := runCmd(t, dir, "git", "rev-parse", "refs/heads/master"); got != wantCommit {
+		t.Errorf("master: got %q, want %q", got, wantCommit)
+	}
+	if got := runCmd(t, dir, "git", "rev-parse", "refs/heads/feature"); got != wantFeature {
+		t.Errorf("feature: got %q, want %q", got, wantFeature)
+	}
+	if got := strings.TrimSpace(runCmd(t, dir, "git", "config", "sourcegraph.type")); got != "git" {
+		t.Errorf("sourcegraph.type: got %q, want %q", got, "git")
+	}
+}
@@ -211,6 +211,11 @@ type Server struct {
 	// actual hostname but can also be overridden by the HOSTNAME environment variable.
 	Hostname string
 
+	// Backups stores backups of repositories. If set, repositories are
+	// restored from their backup before falling back to cloning them from the
+	// code host, and are backed up via the /backup endpoint. It may be nil.
+	Backups BackupStore
+
 	// shared db handle
 	DB dbutil.DB
 
@@ -352,6 +357,7 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("/repos-stats", s.handleReposStats)
 	mux.HandleFunc("/repo-clone-progress", s.handleRepoCloneProgress)
 	mux.HandleFunc("/delete", s.handleRepoDelete)
+	mux.HandleFunc("/backup", s.handleRepoBackup)
 	mux.HandleFunc("/repo-update", s.handleRepoUpdate)
 	mux.HandleFunc("/getGitolitePhabricatorMetadata", s.handleGetGitolitePhabricatorMetadata)
 	mux.HandleFunc("/create-commit-from-patch", s.handleCreateCommitFromPatch)
@@ -486,16 +492,7 @@ func (s *Server) cloneJobConsumer(ctx context.Context, jobs <-chan *cloneJob) {
 // hostnameMatch checks whether the hostname matches the given address.
 // If we don't find an exact match, we look at the initial prefix.
 func (s *Server) hostnameMatch(addr string) bool {
-	if !strings.HasPrefix(addr, s.Hostname) {
-		return false
-	}
-	if addr == s.Hostname {
-		return true
-	}
-	// We know that s.Hostname is shorter than addr so we can safely check the next
-	// char
-	next := addr[len(s.Hostname)]
-	return next == '.' || next == ':'
+	return gitserver.HostnameMatch(s.Hostname, addr)
 }
 
 var (
@@ -1055,10 +1052,11 @@ func (s *Server) search(ctx context.Context, args *protocol.SearchRequest, match
 		}
 
 		searcher := &search.CommitSearcher{
-			RepoDir:     dir.Path(),
-			Revisions:   args.Revisions,
-			Query:       mt,
-			IncludeDiff: args.IncludeDiff,
+			RepoDir:       dir.Path(),
+			Revisions:     args.Revisions,
+			Query:         mt,
+			IncludeDiff:   args.IncludeDiff,
+			IncludeMerges: args.IncludeMerges,
 		}
 
 		return searcher.Search(ctx, func(match *protocol.CommitMatch) {
@@ -1503,6 +1501,9 @@ func (s *Server) setLastError(ctx context.Context, name api.RepoName, error stri
 	if s.DB == nil {
 		return nil
 	}
+	if !s.recordsRepoState(name) {
+		return nil
+	}
 	return database.GitserverRepos(s.DB).SetLastError(ctx, name, error, s.Hostname)
 }
 
@@ -1510,6 +1511,9 @@ func (s *Server) setLastFetched(ctx context.Context, name api.RepoName) error {
 	if s.DB == nil {
 		return nil
 	}
+	if !s.recordsRepoState(name) {
+		return nil
+	}
 
 	dir := s.dir(name)
 
@@ -1545,6 +1549,9 @@ func (s *Server) setCloneStatus(ctx context.Context, name api.RepoName, status t
 	if s.DB == nil {
 		return nil
 	}
+	if !s.recordsRepoState(name) {
+		return nil
+	}
 	return database.GitserverRepos(s.DB).SetCloneStatus(ctx, name, status, s.Hostname)
 }
 
@@ -1621,8 +1628,22 @@ func (s *Server) cloneRepo(ctx context.Context, repo api.RepoName, opts *cloneOp
 		return "", errors.Wrap(err, "get VCS syncer")
 	}
 
-	// We may be attempting to clone a private repo so we need an internal actor.
-	remoteURL, err := s.getRemoteURL(actor.WithInternalActor(ctx), repo)
+	// Replicas are copied from the primary gitserver of the repo.
+	var peer string
+	if primary, ok := s.replicaOf(repo); ok {
+		peer = primary
+	}
+	if opts != nil && opts.MigrateFrom != "" {
+		peer = opts.MigrateFrom
+	}
+
+	var remoteURL *vcs.URL
+	if peer != "" {
+		syncer, remoteURL, err = peerSource(syncer, peer, repo)
+	} else {
+		// We may be attempting to clone a private repo so we need an internal actor.
+		remoteURL, err = s.getRemoteURL(actor.WithInternalActor(ctx), repo)
+	}
 	if err != nil {
 		return "", err
 	}
@@ -1736,25 +1757,51 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir GitDir, syn
 		s.setCloneStatusNonFatal(context.Background(), repo, cloneStatus(repoCloned(dir), false))
 	}()
 
-	cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
-	if err != nil {
-		return errors.Wrap(err, "get clone command")
-	}
-	if cmd.Env == nil {
-		cmd.Env = os.Environ()
+	// Restoring a backup avoids cloning from the code host. Re-clones must
+	// not restore an old copy.
+	var restored bool
+	if s.Backups != nil && !overwrite && restorableSyncer(syncer) {
+		lock.SetStatus("restoring from backup")
+		restored, err = s.restoreRepo(ctx, repo, tmp)
+		if err != nil {
+			log15.Warn("restoring repo from backup failed, cloning instead", "repo", repo, "error", err)
+			if err := os.RemoveAll(tmpPath); err != nil {
+				return errors.Wrap(err, "failed to remove partial restore")
+			}
+		} else if restored {
+			log15.Info("restored repo from backup", "repo", repo, "dst", dstPath)
+
+			// The backup may be arbitrarily old, so we catch up with the code
+			// host. A restored repository that can't be fetched is still
+			// better than none, it is fetched again on its next update.
+			lock.SetStatus("fetching restored repo")
+			if err := syncer.Fetch(ctx, remoteURL, tmp); err != nil {
+				log15.Warn("fetching restored repo failed", "repo", repo, "error", err)
+			}
+		}
 	}
 
-	// see issue #7322: skip LFS content in repositories with Git LFS configured
-	cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
-	log15.Info("cloning repo", "repo", repo, "tmp", tmpPath, "dst", dstPath)
+	if !restored {
+		cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
+		if err != nil {
+			return errors.Wrap(err, "get clone command")
+		}
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+
+		// see issue #7322: skip LFS content in repositories with Git LFS configured
+		cmd.Env = append(cmd.Env, "GIT_LFS_SKIP_SMUDGE=1")
+		log15.Info("cloning repo", "repo", repo, "tmp", tmpPath, "dst", dstPath)
 
-	pr, pw := io.Pipe()
-	defer pw.Close()
+		pr, pw := io.Pipe()
+		defer pw.Close()
 
-	go readCloneProgress(newURLRedactor(remoteURL), lock, pr, repo)
+		go readCloneProgress(newURLRedactor(remoteURL), lock, pr, repo)
 
-	if output, err := runWithRemoteOpts(ctx, cmd, pw); err != nil {
-		return errors.Wrapf(err, "clone failed. Output: %s", string(output))
+		if output, err := runWithRemoteOpts(ctx, cmd, pw); err != nil {
+			return errors.Wrapf(err, "clone failed. Output: %s", string(output))
+		}
 	}
 
 	if testRepoCorrupter != nil {
@@ -1763,9 +1810,12 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir GitDir, syn
 
 	removeBadRefs(ctx, tmp)
 
-	if err := setHEAD(ctx, tmp, syncer, repo, remoteURL); err != nil {
-		log15.Error("Failed to ensure HEAD exists", "repo", repo, "error", err)
-		return errors.Wrap(err, "failed to ensure HEAD exists")
+	// The HEAD of a restored repository was restored from the backup.
+	if !restored {
+		if err := setHEAD(ctx, tmp, syncer, repo, remoteURL); err != nil {
+			log15.Error("Failed to ensure HEAD exists", "repo", repo, "error", err)
+			return errors.Wrap(err, "failed to ensure HEAD exists")
+		}
 	}
 
 	if err := setRepositoryType(tmp, syncer.Type()); err != nil {
@@ -2081,14 +2131,21 @@ func (s *Server) doBackgroundRepoUpdate(repo api.RepoName) error {
 	repo = protocol.NormalizeRepo(repo)
 	dir := s.dir(repo)
 
-	remoteURL, err := s.getRemoteURL(ctx, repo)
+	syncer, err := s.GetVCSSyncer(ctx, repo)
 	if err != nil {
-		return errors.Wrap(err, "failed to determine Git remote URL")
+		return errors.Wrap(err, "get VCS syncer")
 	}
 
-	syncer, err := s.GetVCSSyncer(ctx, repo)
+	var remoteURL *vcs.URL
+	if primary, ok := s.replicaOf(repo); ok {
+		// Replicas are fetched from the primary gitserver of the repo.
+		syncer, remoteURL, err = peerSource(syncer, primary, repo)
+	} else {
+		remoteURL, err = s.getRemoteURL(ctx, repo)
+		err = errors.Wrap(err, "failed to determine Git remote URL")
+	}
 	if err != nil {
-		return errors.Wrap(err, "get VCS syncer")
+		return err
 	}
 
 	// drop temporary pack files after a fetch. this function won't
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodproxy"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewGoModulesSyncer returns a VCSSyncer that creates git repositories from
+// the zips of Go modules served by a module proxy, with one tagged commit per
+// version.
+func NewGoModulesSyncer(config *schema.GoModulesConnection, dbStore repos.DependencyReposStore, httpClient httpcli.Doer) VCSSyncer {
+	return &packagesSyncer{
+		typ: "go_modules",
+		source: &goModulesSource{
+			config:  config,
+			dbStore: dbStore,
+			client:  gomodproxy.NewClient(config, httpClient),
+		},
+	}
+}
+
+type goModulesSource struct {
+	config  *schema.GoModulesConnection
+	dbStore repos.DependencyReposStore
+	client  *gomodproxy.Client
+}
+
+func (s *goModulesSource) Dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error) {
+	mod, err := reposource.ParseGoModuleFromRepoURL(repoURLPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []reposource.GoDependency
+	seen := map[string]bool{}
+	add := func(dependency reposource.GoDependency) {
+		if !seen[dependency.Version] {
+			seen[dependency.Version] = true
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	for _, dep := range s.config.Dependencies {
+		dependency, err := reposource.ParseGoDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		if dependency.GoModule == mod {
+			add(dependency)
+		}
+	}
+
+	dbDeps, err := s.dbStore.GetDependencyRepos(ctx, dbstore.GetDependencyReposOpts{
+		Scheme: repos.GoModulesScheme,
+		Name:   mod.Path,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get Go dependency repos from database for %s", repoURLPath)
+	}
+	for _, dbDep := range dbDeps {
+		dependency, err := reposource.ParseGoDependency(mod.Path + "@" + dbDep.Version)
+		if err != nil {
+			log15.Warn("error parsing Go module version", "error", err, "module", mod.Path, "version", dbDep.Version)
+			continue
+		}
+		// Existence is verified by repo-updater, so we don't check it here.
+		add(dependency)
+	}
+
+	if len(dependencies) == 0 {
+		return nil, errors.Errorf("no Go dependencies for URL path %s", repoURLPath)
+	}
+
+	log15.Info("fetched Go module versions for repo path", "repoPath", repoURLPath, "total", len(dependencies))
+	reposource.SortGoDependencies(dependencies)
+
+	result := make([]packageDependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		result = append(result, dependency)
+	}
+	return result, nil
+}
+
+func (s *goModulesSource) Exists(ctx context.Context, dep packageDependency) error {
+	_, err := s.client.GetVersion(ctx, dep.(reposource.GoDependency))
+	return err
+}
+
+func (s *goModulesSource) Download(ctx context.Context, dep packageDependency, dir string) error {
+	dependency := dep.(reposource.GoDependency)
+	zipBytes, err := s.client.GetZip(ctx, dependency)
+	if err != nil {
+		return err
+	}
+
+	return extractGoModuleZip(zipBytes, dependency, dir)
+}
+
+// extractGoModuleZip extracts the files of a module zip into destination. All
+// files of a module zip are nested under a "path@version/" directory, which is
+// stripped.
+func extractGoModuleZip(zipBytes []byte, dependency reposource.GoDependency, destination string) error {
+	reader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	if err != nil {
+		return err
+	}
+
+	prefix := dependency.PackageManagerSyntax() + "/"
+	for _, file := range reader.File {
+		outputPath, ok := packageFileOutputPath(destination, file.Name, prefix)
+		if !ok {
+			continue
+		}
+		if err := copyZipFileEntry(file, outputPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
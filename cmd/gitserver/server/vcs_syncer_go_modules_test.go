@@ -0,0 +1,82 @@
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func createGoModuleZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for name, contents := range files {
+		w, err := zipWriter.Create(name)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte(contents))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, zipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestGoModulesCloneCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{
+		"/example.com/mod/@v/v1.0.0.info": []byte(`{"Version":"v1.0.0"}`),
+		"/example.com/mod/@v/v1.0.0.zip": createGoModuleZip(t, map[string]string{
+			"example.com/mod@v1.0.0/go.mod":  "module example.com/mod\n",
+			"example.com/mod@v1.0.0/mod.go":  "package mod\n",
+			"example.com/mod@v1.0.0/.git/x":  "",
+			"example.com/other@v1.0.0/a.go":  "package other\n",
+			"example.com/mod@v1.0.0/../b.go": "",
+		}),
+		"/example.com/mod/@v/v1.1.0.info": []byte(`{"Version":"v1.1.0"}`),
+		"/example.com/mod/@v/v1.1.0.zip": createGoModuleZip(t, map[string]string{
+			"example.com/mod@v1.1.0/mod.go": "package mod // v1.1.0\n",
+		}),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if contents, ok := files[r.URL.Path]; ok {
+			w.Write(contents)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	config := &schema.GoModulesConnection{Urls: []string{srv.URL}}
+	s := NewGoModulesSyncer(config, &simpleDependencyReposStoreMock{}, srv.Client())
+	bareGitDirectory := path.Join(dir, "git")
+
+	clone := func(dependencies ...string) {
+		t.Helper()
+		config.Dependencies = dependencies
+		cmd, err := s.CloneCommand(context.Background(), &vcs.URL{URL: url.URL{Path: "go/example.com/mod"}}, bareGitDirectory)
+		assert.Nil(t, err)
+		assert.Nil(t, cmd.Run())
+	}
+
+	clone("example.com/mod@v1.0.0")
+	assertCommandOutput(t, exec.Command("git", "tag", "--list"), bareGitDirectory, "v1.0.0\n")
+	assertCommandOutput(t, exec.Command("git", "ls-tree", "-r", "--name-only", "v1.0.0"), bareGitDirectory, "go.mod\nmod.go\n")
+
+	clone("example.com/mod@v1.0.0", "example.com/mod@v1.1.0")
+	assertCommandOutput(t, exec.Command("git", "tag", "--list"), bareGitDirectory, "v1.0.0\nv1.1.0\n")
+	assertCommandOutput(t, exec.Command("git", "show", "latest:mod.go"), bareGitDirectory, "package mod // v1.1.0\n")
+}
@@ -6,10 +6,8 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/json"
-	"io"
 	"os"
 	"os/exec"
-	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -21,7 +19,6 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/jvmpackages/coursier"
 	"github.com/sourcegraph/sourcegraph/internal/repos"
-	"github.com/sourcegraph/sourcegraph/internal/vcs"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -35,154 +32,52 @@ const (
 	jvmMajorVersion0 = 44
 )
 
-// placeholderMavenDependency is used to set GIT_AUTHOR_NAME for git commands
-// that don't create commits or tags. The name of this dependency should never
-// be publicly visible so it can have any random value.
-var placeholderMavenDependency = reposource.MavenDependency{
-	MavenModule: reposource.MavenModule{
-		GroupID:    "com.sourcegraph",
-		ArtifactID: "sourcegraph",
-	},
-	Version: "1.0.0",
-}
-
-type JVMPackagesSyncer struct {
-	Config  *schema.JVMPackagesConnection
-	DBStore repos.JVMPackagesRepoStore
-}
-
-var _ VCSSyncer = &JVMPackagesSyncer{}
-
-func (s *JVMPackagesSyncer) MavenDependencies() []string {
-	if s.Config == nil || s.Config.Maven == nil || s.Config.Maven.Dependencies == nil {
-		return nil
-	}
-	return s.Config.Maven.Dependencies
-}
-
-func (s *JVMPackagesSyncer) Type() string {
-	return "jvm_packages"
-}
-
-// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
-// error indicates there is a problem.
-func (s *JVMPackagesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
-	dependencies, err := s.packageDependencies(ctx, remoteURL.Path)
-	if err != nil {
-		return err
-	}
-
-	for _, dependency := range dependencies {
-		_, err := coursier.FetchSources(ctx, s.Config, dependency)
-		if err != nil {
-			return err
-		}
+// NewJVMPackagesSyncer returns a VCSSyncer that creates git repositories from
+// the source jars of Maven dependencies, with one tagged commit per version.
+func NewJVMPackagesSyncer(config *schema.JVMPackagesConnection, dbStore repos.JVMPackagesRepoStore) VCSSyncer {
+	return &packagesSyncer{
+		typ: "jvm_packages",
+		source: &jvmPackagesSource{
+			config:  config,
+			dbStore: dbStore,
+		},
 	}
-	return nil
 }
 
-// CloneCommand returns the command to be executed for cloning from remote.
-// There is no external tool that performs all the step for creating a JVM
-// package repository so the actual cloning happens inside this method and the
-// returned command is a no-op. This means that the web UI can't display a
-// helpful progress bar while cloning JVM package repositories, but that's an
-// acceptable tradeoff we're willing to make.
-func (s *JVMPackagesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
-	err := os.MkdirAll(bareGitDirectory, 0755)
-	if err != nil {
-		return nil, err
-	}
-
-	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
-	if _, err := runCommandInDirectory(ctx, cmd, bareGitDirectory, placeholderMavenDependency); err != nil {
-		return nil, err
-	}
-
-	// The Fetch method is responsible for cleaning up temporary directories.
-	if err := s.Fetch(ctx, remoteURL, GitDir(bareGitDirectory)); err != nil {
-		return nil, err
-	}
-
-	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
-	return exec.CommandContext(ctx, "git", "--version"), nil
+type jvmPackagesSource struct {
+	config  *schema.JVMPackagesConnection
+	dbStore repos.JVMPackagesRepoStore
 }
 
-// Fetch adds git tags for newly added dependency versions and removes git tags
-// for deleted versions.
-func (s *JVMPackagesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
-	dependencies, err := s.packageDependencies(ctx, remoteURL.Path)
-	if err != nil {
-		return err
-	}
-
-	tags := map[string]bool{}
-
-	out, err := runCommandInDirectory(ctx, exec.CommandContext(ctx, "git", "tag"), string(dir), placeholderMavenDependency)
-	if err != nil {
-		return err
-	}
-
-	for _, line := range strings.Split(out, "\n") {
-		if len(line) == 0 {
-			continue
-		}
-		tags[line] = true
-	}
-
-	for i, dependency := range dependencies {
-		if tags[dependency.GitTagFromVersion()] {
-			continue
-		}
-		// the gitPushDependencyTag method is reponsible for cleaning up temporary directories.
-		if err := s.gitPushDependencyTag(ctx, string(dir), dependency, i == 0); err != nil {
-			return errors.Wrapf(err, "error pushing dependency %q", dependency.CoursierSyntax())
-		}
-	}
-
-	dependencyTags := make(map[string]struct{}, len(dependencies))
-	for _, dependency := range dependencies {
-		dependencyTags[dependency.GitTagFromVersion()] = struct{}{}
-	}
-
-	for tag := range tags {
-		if _, isDependencyTag := dependencyTags[tag]; !isDependencyTag {
-			cmd := exec.CommandContext(ctx, "git", "tag", "-d", tag)
-			if _, err := runCommandInDirectory(ctx, cmd, string(dir), placeholderMavenDependency); err != nil {
-				log15.Error("Failed to delete git tag", "error", err, "tag", tag)
-				continue
-			}
-		}
+func (s *jvmPackagesSource) mavenDependencies() []string {
+	if s.config == nil || s.config.Maven == nil || s.config.Maven.Dependencies == nil {
+		return nil
 	}
-
-	return nil
+	return s.config.Maven.Dependencies
 }
 
-// RemoteShowCommand returns the command to be executed for showing remote.
-func (s *JVMPackagesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
-	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
-}
-
-// packageDependencies returns the list of JVM dependencies that belong to the given URL path.
+// Dependencies returns the list of JVM dependencies that belong to the given URL path.
 // The returned package dependencies are sorted by semantic versioning.
 // A URL maps to a single JVM package, which may contain multiple versions (one git tag per version).
-func (s *JVMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath string) (dependencies []reposource.MavenDependency, err error) {
+func (s *jvmPackagesSource) Dependencies(ctx context.Context, repoUrlPath string) ([]packageDependency, error) {
 	module, err := reposource.ParseMavenModule(repoUrlPath)
 	if err != nil {
 		return nil, err
 	}
 
 	var (
+		dependencies       []reposource.MavenDependency
 		totalConfigMatched int
 		timedout           []reposource.MavenDependency
 	)
-	for _, dependency := range s.MavenDependencies() {
+	for _, dependency := range s.mavenDependencies() {
 		if module.MatchesDependencyString(dependency) {
 			dependency, err := reposource.ParseMavenDependency(dependency)
 			if err != nil {
 				return nil, err
 			}
 
-			exists, err := coursier.Exists(ctx, s.Config, dependency)
+			exists, err := coursier.Exists(ctx, s.config, dependency)
 			if exists {
 				totalConfigMatched++
 				dependencies = append(dependencies, dependency)
@@ -199,7 +94,7 @@ func (s *JVMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath
 		log15.Warn("non-zero number of timed-out coursier invocations", "count", len(timedout), "dependencies", timedout)
 	}
 
-	dbDeps, err := s.DBStore.GetJVMDependencyRepos(ctx, dbstore.GetJVMDependencyReposOpts{
+	dbDeps, err := s.dbStore.GetJVMDependencyRepos(ctx, dbstore.GetJVMDependencyReposOpts{
 		ArtifactName: repoUrlPath,
 	})
 	if err != nil {
@@ -230,66 +125,34 @@ func (s *JVMPackagesSyncer) packageDependencies(ctx context.Context, repoUrlPath
 
 	log15.Info("fetched maven artifact for repo path", "repoPath", repoUrlPath, "totalDB", totalDBMatched, "totalConfig", totalConfigMatched)
 	reposource.SortDependencies(dependencies)
-	return dependencies, nil
-}
 
-// gitPushDependencyTag pushes a git tag to the given bareGitDirectory path. The
-// tag points to a commit that adds all sources of given dependency. When
-// isMainBranch is true, the main branch of the bare git directory will also be
-// updated to point to the same commit as the git tag.
-func (s *JVMPackagesSyncer) gitPushDependencyTag(ctx context.Context, bareGitDirectory string, dependency reposource.MavenDependency, isLatestVersion bool) error {
-	tmpDirectory, err := os.MkdirTemp("", "maven")
-	if err != nil {
-		return err
-	}
-	// Always clean up created temporary directories.
-	defer os.RemoveAll(tmpDirectory)
-
-	sourceCodeJarPath, err := coursier.FetchSources(ctx, s.Config, dependency)
-	if err != nil {
-		return err
+	result := make([]packageDependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		result = append(result, dependency)
 	}
+	return result, nil
+}
 
-	cmd := exec.CommandContext(ctx, "git", "init")
-	if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-		return err
-	}
+func (s *jvmPackagesSource) Exists(ctx context.Context, dep packageDependency) error {
+	_, err := coursier.FetchSources(ctx, s.config, dep.(reposource.MavenDependency))
+	return err
+}
 
-	err = s.commitJar(ctx, dependency, tmpDirectory, sourceCodeJarPath, s.Config)
+func (s *jvmPackagesSource) Download(ctx context.Context, dep packageDependency, dir string) error {
+	dependency := dep.(reposource.MavenDependency)
+	sourceCodeJarPath, err := coursier.FetchSources(ctx, s.config, dependency)
 	if err != nil {
 		return err
 	}
 
-	cmd = exec.CommandContext(ctx, "git", "remote", "add", "origin", bareGitDirectory)
-	if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-		return err
-	}
-
-	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
-	cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", "--tags")
-	if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-		return err
-	}
-
-	if isLatestVersion {
-		defaultBranch, err := runCommandInDirectory(ctx, exec.CommandContext(ctx, "git", "rev-parse", "--abbrev-ref", "HEAD"), tmpDirectory, dependency)
-		if err != nil {
-			return err
-		}
-		// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
-		cmd = exec.CommandContext(ctx, "git", "push", "--no-verify", "--force", "origin", strings.TrimSpace(defaultBranch)+":latest", dependency.GitTagFromVersion())
-		if _, err := runCommandInDirectory(ctx, cmd, tmpDirectory, dependency); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return s.extractJar(ctx, dependency, sourceCodeJarPath, dir)
 }
 
-// commitJar creates a git commit in the given working directory that adds all the file contents of the given jar file.
-// A `*.jar` file works the same way as a `*.zip` file, it can even be uncompressed with the `unzip` command-line tool.
-func (s *JVMPackagesSyncer) commitJar(ctx context.Context, dependency reposource.MavenDependency,
-	workingDirectory, sourceCodeJarPath string, connection *schema.JVMPackagesConnection) error {
+// extractJar writes all the file contents of the given jar file to the given
+// directory, together with an lsif-java.json file that describes the
+// dependency. A `*.jar` file works the same way as a `*.zip` file, it can even
+// be uncompressed with the `unzip` command-line tool.
+func (s *jvmPackagesSource) extractJar(ctx context.Context, dependency reposource.MavenDependency, sourceCodeJarPath, workingDirectory string) error {
 	if err := unzipJarFile(sourceCodeJarPath, workingDirectory); err != nil {
 		return errors.Wrapf(err, "failed to unzip jar file for %s to %v", dependency.CoursierSyntax(), sourceCodeJarPath)
 	}
@@ -300,7 +163,7 @@ func (s *JVMPackagesSyncer) commitJar(ctx context.Context, dependency reposource
 	}
 	defer file.Close()
 
-	jvmVersion, err := inferJVMVersionFromByteCode(ctx, connection, dependency)
+	jvmVersion, err := inferJVMVersionFromByteCode(ctx, s.config, dependency)
 	if err != nil {
 		return err
 	}
@@ -315,27 +178,7 @@ func (s *JVMPackagesSyncer) commitJar(ctx context.Context, dependency reposource
 	}
 
 	_, err = file.Write(jsonContents)
-	if err != nil {
-		return err
-	}
-
-	cmd := exec.CommandContext(ctx, "git", "add", ".")
-	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
-		return err
-	}
-
-	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
-	cmd = exec.CommandContext(ctx, "git", "commit", "--no-verify", "-m", dependency.CoursierSyntax(), "--date", stableGitCommitDate)
-	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
-		return err
-	}
-
-	cmd = exec.CommandContext(ctx, "git", "tag", "-m", dependency.CoursierSyntax(), dependency.GitTagFromVersion())
-	if _, err := runCommandInDirectory(ctx, cmd, workingDirectory, dependency); err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
 func unzipJarFile(jarPath, destination string) (err error) {
@@ -344,34 +187,14 @@ func unzipJarFile(jarPath, destination string) (err error) {
 		return err
 	}
 	defer reader.Close()
-	destinationDirectory := strings.TrimSuffix(destination, string(os.PathSeparator)) + string(os.PathSeparator)
 
 	for _, file := range reader.File {
-		if strings.HasPrefix(file.Name, ".git/") {
-			// For security reasons, don't unzip files under the `.git/`
-			// directory. See https://github.com/sourcegraph/security-issues/issues/163
-			continue
-		}
-		if strings.HasSuffix(file.Name, "/") {
-			// Skip directory entries. Directory entries must end
-			// with a forward slash (even on Windows) according to
-			// `file.Name` docstring.
-			continue
-		}
-		if strings.HasPrefix(file.Name, "/") {
-			// Skip absolute paths. While they are extracted relative to `destination`,
-			// they should be unimportant. Related issue https://github.com/golang/go/issues/48085#issuecomment-912659635
-			continue
-		}
-		cleanedOutputPath := path.Join(destination, file.Name)
-		if !strings.HasPrefix(cleanedOutputPath, destinationDirectory) {
-			// For security reasons, skip file if it's not a child
-			// of the target directory. See "Zip Slip Vulnerability".
+		outputPath, ok := packageFileOutputPath(destination, file.Name, "")
+		if !ok {
 			continue
 		}
 
-		err := copyZipFileEntry(file, cleanedOutputPath)
-		if err != nil {
+		if err := copyZipFileEntry(file, outputPath); err != nil {
 			return err
 		}
 	}
@@ -391,22 +214,7 @@ func copyZipFileEntry(entry *zip.File, outputPath string) (err error) {
 		}
 	}()
 
-	if err = os.MkdirAll(path.Dir(outputPath), 0700); err != nil {
-		return err
-	}
-	outputFile, err := os.OpenFile(outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		err1 := outputFile.Close()
-		if err == nil {
-			err = err1
-		}
-	}()
-
-	_, err = io.Copy(outputFile, inputFile)
-	return err
+	return writePackageFile(outputPath, inputFile)
 }
 
 // inferJVMVersionFromByteCode returns the JVM version that was used to compile
@@ -467,8 +275,10 @@ func roundJVMVersionToNearestStableVersion(javaVersion int) int {
 	return javaVersion
 }
 
-func runCommandInDirectory(ctx context.Context, cmd *exec.Cmd, workingDirectory string, dependency reposource.MavenDependency) (string, error) {
-	gitName := dependency.MavenModule.CoursierSyntax() + " authors"
+// runCommandInDirectoryAs runs cmd in workingDirectory with a stable git
+// author and committer, so that package repos always produce the same commit
+// hashes.
+func runCommandInDirectoryAs(ctx context.Context, cmd *exec.Cmd, workingDirectory, gitName string) (string, error) {
 	gitEmail := "code-intel@sourcegraph.com"
 	cmd.Dir = workingDirectory
 	cmd.Env = append(cmd.Env, "EMAIL="+gitEmail)
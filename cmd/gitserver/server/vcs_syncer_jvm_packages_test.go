@@ -107,11 +107,11 @@ fi
 	return coursierPath.Name()
 }
 
-func (s JVMPackagesSyncer) runCloneCommand(t *testing.T, bareGitDirectory string, dependencies []string) {
+func runJVMCloneCommand(t *testing.T, s VCSSyncer, config *schema.JVMPackagesConnection, bareGitDirectory string, dependencies []string) {
 	url := vcs.URL{
 		URL: url.URL{Path: examplePackageUrl},
 	}
-	s.Config.Maven.Dependencies = dependencies
+	config.Maven.Dependencies = dependencies
 	cmd, err := s.CloneCommand(context.Background(), &url, bareGitDirectory)
 	assert.Nil(t, err)
 	assert.Nil(t, cmd.Run())
@@ -139,14 +139,14 @@ func TestNoMaliciousFiles(t *testing.T) {
 
 	createMaliciousJar(t, jarPath)
 
-	s := JVMPackagesSyncer{
-		Config:  &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}},
-		DBStore: &simpleJVMPackageDBStoreMock{},
+	s := jvmPackagesSource{
+		config:  &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}},
+		dbStore: &simpleJVMPackageDBStoreMock{},
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // cancel now  to prevent any network IO
-	err = s.commitJar(ctx, reposource.MavenDependency{}, extractPath, jarPath, &schema.JVMPackagesConnection{Maven: &schema.Maven{}})
+	err = s.extractJar(ctx, reposource.MavenDependency{}, jarPath, extractPath)
 	assert.NotNil(t, err)
 
 	dirEntries, err := os.ReadDir(extractPath)
@@ -190,13 +190,11 @@ func TestJVMCloneCommand(t *testing.T) {
 
 	coursier.CoursierBinary = coursierScript(t, dir)
 
-	s := JVMPackagesSyncer{
-		Config:  &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}},
-		DBStore: &simpleJVMPackageDBStoreMock{},
-	}
+	config := &schema.JVMPackagesConnection{Maven: &schema.Maven{Dependencies: []string{}}}
+	s := NewJVMPackagesSyncer(config, &simpleJVMPackageDBStoreMock{})
 	bareGitDirectory := path.Join(dir, "git")
 
-	s.runCloneCommand(t, bareGitDirectory, []string{examplePackageDependency})
+	runJVMCloneCommand(t, s, config, bareGitDirectory, []string{examplePackageDependency})
 	assertCommandOutput(t,
 		exec.Command("git", "tag", "--list"),
 		bareGitDirectory,
@@ -208,7 +206,7 @@ func TestJVMCloneCommand(t *testing.T) {
 		exampleFileContents,
 	)
 
-	s.runCloneCommand(t, bareGitDirectory, []string{examplePackageDependency, examplePackageDependency2})
+	runJVMCloneCommand(t, s, config, bareGitDirectory, []string{examplePackageDependency, examplePackageDependency2})
 	assertCommandOutput(t,
 		exec.Command("git", "tag", "--list"),
 		bareGitDirectory,
@@ -240,7 +238,7 @@ func TestJVMCloneCommand(t *testing.T) {
 		exampleFileContents2,
 	)
 
-	s.runCloneCommand(t, bareGitDirectory, []string{examplePackageDependency})
+	runJVMCloneCommand(t, s, config, bareGitDirectory, []string{examplePackageDependency})
 	assertCommandOutput(t,
 		exec.Command("git", "show", fmt.Sprintf("v%s:%s", examplePackageVersion, exampleFilePath)),
 		bareGitDirectory,
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// MercurialSyncer is a syncer for Mercurial repositories. The repositories are
+// mirrored into Git with the git-remote-hg remote helper, which writes the
+// Mercurial changesets into the Git repository with "git fast-import". The
+// helper keeps marks files inside the Git directory, so that subsequent
+// fetches only import new changesets.
+type MercurialSyncer struct{}
+
+var _ VCSSyncer = &MercurialSyncer{}
+
+func (s *MercurialSyncer) Type() string {
+	return "mercurial"
+}
+
+// mercurialCommandEnv returns the environment for hg commands and the
+// git-remote-hg helper. HGPLAIN disables any output customizations of the
+// user's hgrc.
+func mercurialCommandEnv() []string {
+	return append(os.Environ(), "HGPLAIN=1")
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *MercurialSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	// Like for Git, ssh must not prompt for host keys or passwords.
+	cmd := exec.CommandContext(ctx, "hg", "identify", "--noninteractive",
+		"--config", "ui.ssh=ssh -o BatchMode=yes -o ConnectTimeout=30",
+		remoteURL.String())
+	cmd.Env = mercurialCommandEnv()
+
+	out, err := runWith(ctx, cmd, false, nil)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = errors.Errorf("%s (output follows)\n\n%s", err, newURLRedactor(remoteURL).redact(string(out)))
+		}
+		return err
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for cloning a Mercurial
+// repository as a Git repository.
+func (s *MercurialSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (*exec.Cmd, error) {
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "init", "--bare", ".")
+	cmd.Dir = tmpPath
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "clone setup failed")
+	}
+
+	cmd = s.fetchCommand(ctx, remoteURL)
+	cmd.Dir = tmpPath
+	return cmd, nil
+}
+
+func (s *MercurialSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
+	// git-remote-hg exposes Mercurial branches and bookmarks as branches and
+	// Mercurial tags as tags.
+	cmd := exec.CommandContext(ctx, "git", "fetch",
+		"--progress", "--prune", "hg::"+remoteURL.String(),
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*")
+	cmd.Env = mercurialCommandEnv()
+	return cmd
+}
+
+// Fetch tries to fetch updates of a Mercurial repository as a Git repository.
+func (s *MercurialSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	cmd := s.fetchCommand(ctx, remoteURL)
+	dir.Set(cmd)
+	if output, err := runWithRemoteOpts(ctx, cmd, nil); err != nil {
+		return errors.Wrapf(err, "failed to update with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+	return nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing Git remote
+// of a Mercurial repository.
+func (s *MercurialSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	// Remote info is encoded as in the current repository
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+func TestMercurialSyncer_CloneCommand(t *testing.T) {
+	remoteURL, err := vcs.ParseURL("https://hg.sgdev.org/repos/legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpPath := filepath.Join(t.TempDir(), ".git")
+
+	s := &MercurialSyncer{}
+	cmd, err := s.CloneCommand(context.Background(), remoteURL, tmpPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have := runCmd(t, tmpPath, "git", "rev-parse", "--is-bare-repository"); strings.TrimSpace(have) != "true" {
+		t.Fatalf("clone directory is not a bare repository: %q", have)
+	}
+
+	wantArgs := []string{
+		"git", "fetch", "--progress", "--prune", "hg::https://hg.sgdev.org/repos/legacy",
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
+	}
+	if diff := cmp.Diff(wantArgs, cmd.Args); diff != "" {
+		t.Fatalf("unexpected clone command (-want +got):\n%s", diff)
+	}
+	if cmd.Dir != tmpPath {
+		t.Fatalf("unexpected clone command dir: want %q but got %q", tmpPath, cmd.Dir)
+	}
+	if !containsString(cmd.Env, "HGPLAIN=1") {
+		t.Fatalf("clone command environment doesn't set HGPLAIN: %v", cmd.Env)
+	}
+}
+
+func TestMercurialSyncer_RemoteShowCommand(t *testing.T) {
+	remoteURL, err := vcs.ParseURL("https://hg.sgdev.org/repos/legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &MercurialSyncer{}
+	cmd, err := s.RemoteShowCommand(context.Background(), remoteURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"git", "remote", "show", "./"}, cmd.Args); diff != "" {
+		t.Fatalf("unexpected remote show command (-want +got):\n%s", diff)
+	}
+}
+
+// TestMercurialSyncer_CloneAndFetch clones and updates a local Mercurial
+// repository. It requires hg and the git-remote-hg helper.
+func TestMercurialSyncer_CloneAndFetch(t *testing.T) {
+	for _, name := range []string{"hg", "git-remote-hg"} {
+		if _, err := exec.LookPath(name); err != nil {
+			t.Skipf("%s not found in $PATH", name)
+		}
+	}
+
+	ctx := context.Background()
+	root := t.TempDir()
+	hgDir := filepath.Join(root, "hg")
+	if err := os.MkdirAll(hgDir, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	hg := func(args ...string) string {
+		t.Helper()
+		return runCmd(t, hgDir, "hg", args...)
+	}
+	hg("init")
+	if err := os.WriteFile(filepath.Join(hgDir, "hello.txt"), []byte("hello world\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	hg("add", "hello.txt")
+	hg("--config", "ui.username=a", "commit", "-m", "hello")
+
+	remoteURL, err := vcs.ParseURL("file://" + hgDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &MercurialSyncer{}
+	if err := s.IsCloneable(ctx, remoteURL); err != nil {
+		t.Fatalf("unexpected error checking whether the repository is cloneable: %s", err)
+	}
+
+	missingURL, err := vcs.ParseURL("file://" + filepath.Join(root, "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.IsCloneable(ctx, missingURL); err == nil {
+		t.Fatal("expected an error checking whether a missing repository is cloneable")
+	}
+
+	gitDir := filepath.Join(root, "git", ".git")
+	cmd, err := s.CloneCommand(ctx, remoteURL, gitDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("clone failed: %s\nOutput: %s", err, output)
+	}
+
+	commitCount := func() string {
+		t.Helper()
+		return strings.TrimSpace(runCmd(t, gitDir, "git", "rev-list", "--all", "--count"))
+	}
+	if have := commitCount(); have != "1" {
+		t.Fatalf("unexpected number of commits after clone: want 1 but got %s", have)
+	}
+
+	if err := os.WriteFile(filepath.Join(hgDir, "hello.txt"), []byte("hello mercurial\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	hg("--config", "ui.username=a", "commit", "-m", "update")
+
+	if err := s.Fetch(ctx, remoteURL, GitDir(gitDir)); err != nil {
+		t.Fatal(err)
+	}
+	if have := commitCount(); have != "2" {
+		t.Fatalf("unexpected number of commits after fetch: want 2 but got %s", have)
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
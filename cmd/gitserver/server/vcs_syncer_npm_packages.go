@@ -0,0 +1,145 @@
+package server
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npm"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// NewNpmPackagesSyncer returns a VCSSyncer that creates git repositories from
+// the tarballs of npm packages, with one tagged commit per version.
+func NewNpmPackagesSyncer(config *schema.NpmPackagesConnection, dbStore repos.DependencyReposStore, httpClient httpcli.Doer) VCSSyncer {
+	return &packagesSyncer{
+		typ: "npm_packages",
+		source: &npmPackagesSource{
+			config:  config,
+			dbStore: dbStore,
+			client:  npm.NewClient(config, httpClient),
+		},
+	}
+}
+
+type npmPackagesSource struct {
+	config  *schema.NpmPackagesConnection
+	dbStore repos.DependencyReposStore
+	client  *npm.Client
+}
+
+func (s *npmPackagesSource) Dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error) {
+	pkg, err := reposource.ParseNpmPackageFromRepoURL(repoURLPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependencies []reposource.NpmDependency
+	seen := map[string]bool{}
+	add := func(dependency reposource.NpmDependency) {
+		if !seen[dependency.Version] {
+			seen[dependency.Version] = true
+			dependencies = append(dependencies, dependency)
+		}
+	}
+
+	for _, dep := range s.config.Dependencies {
+		dependency, err := reposource.ParseNpmDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		if dependency.NpmPackage == pkg {
+			add(dependency)
+		}
+	}
+
+	dbDeps, err := s.dbStore.GetDependencyRepos(ctx, dbstore.GetDependencyReposOpts{
+		Scheme: repos.NpmPackagesScheme,
+		Name:   pkg.PackageSyntax(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get npm dependency repos from database for %s", repoURLPath)
+	}
+	for _, dbDep := range dbDeps {
+		// Existence is verified by repo-updater, so we don't check it here.
+		add(reposource.NpmDependency{NpmPackage: pkg, Version: dbDep.Version})
+	}
+
+	if len(dependencies) == 0 {
+		return nil, errors.Errorf("no npm dependencies for URL path %s", repoURLPath)
+	}
+
+	log15.Info("fetched npm package versions for repo path", "repoPath", repoURLPath, "total", len(dependencies))
+	reposource.SortNpmDependencies(dependencies)
+
+	result := make([]packageDependency, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		result = append(result, dependency)
+	}
+	return result, nil
+}
+
+func (s *npmPackagesSource) Exists(ctx context.Context, dep packageDependency) error {
+	_, err := s.client.GetDependencyInfo(ctx, dep.(reposource.NpmDependency))
+	return err
+}
+
+func (s *npmPackagesSource) Download(ctx context.Context, dep packageDependency, dir string) error {
+	tarball, err := s.client.FetchTarball(ctx, dep.(reposource.NpmDependency))
+	if err != nil {
+		return err
+	}
+	defer tarball.Close()
+
+	return extractNpmTarball(tarball, dir)
+}
+
+// extractNpmTarball extracts the regular files of a gzipped package tarball
+// into destination. The files of npm packages are nested under a single
+// top-level directory, usually "package/", which is stripped.
+func extractNpmTarball(r io.Reader, destination string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			// Skip directories, symlinks and other special files.
+			continue
+		}
+
+		name := strings.TrimPrefix(header.Name, "./")
+		i := strings.Index(name, "/")
+		if i < 0 {
+			continue
+		}
+
+		outputPath, ok := packageFileOutputPath(destination, name, name[:i+1])
+		if !ok {
+			continue
+		}
+		if err := writePackageFile(outputPath, tarReader); err != nil {
+			return err
+		}
+	}
+}
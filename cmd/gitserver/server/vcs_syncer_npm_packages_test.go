@@ -0,0 +1,111 @@
+package server
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func createNpmTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range files {
+		assert.Nil(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(contents)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write([]byte(contents))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, tarWriter.Close())
+	assert.Nil(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func newNpmRegistry(t *testing.T, tarballs map[string][]byte) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tarball, ok := tarballs[strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/tarballs/"), ".tgz")]; ok {
+			w.Write(tarball)
+			return
+		}
+		version := path.Base(r.URL.Path)
+		if _, ok := tarballs[version]; ok {
+			fmt.Fprintf(w, `{"dist":{"tarball":"%s/tarballs/%s.tgz"}}`, srv.URL, version)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNpmCloneCommand(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	srv := newNpmRegistry(t, map[string][]byte{
+		"1.0.0": createNpmTarball(t, map[string]string{
+			"package/index.js":     "module.exports = 1;\n",
+			"package/package.json": `{"name":"example","version":"1.0.0"}`,
+		}),
+		"2.0.0": createNpmTarball(t, map[string]string{
+			"package/index.js": "module.exports = 2;\n",
+			// Malicious entries are skipped.
+			"package/.git/hooks/pre-commit": "#!/bin/sh\n",
+			"package/../../escape.js":       "",
+		}),
+	})
+
+	config := &schema.NpmPackagesConnection{Registry: srv.URL}
+	s := NewNpmPackagesSyncer(config, &simpleDependencyReposStoreMock{}, srv.Client())
+	bareGitDirectory := path.Join(dir, "git")
+
+	clone := func(dependencies ...string) {
+		t.Helper()
+		config.Dependencies = dependencies
+		cmd, err := s.CloneCommand(context.Background(), &vcs.URL{URL: url.URL{Path: "npm/example"}}, bareGitDirectory)
+		assert.Nil(t, err)
+		assert.Nil(t, cmd.Run())
+	}
+
+	clone("example@1.0.0")
+	assertCommandOutput(t, exec.Command("git", "tag", "--list"), bareGitDirectory, "v1.0.0\n")
+	assertCommandOutput(t, exec.Command("git", "show", "v1.0.0:index.js"), bareGitDirectory, "module.exports = 1;\n")
+
+	clone("example@1.0.0", "example@2.0.0")
+	assertCommandOutput(t, exec.Command("git", "tag", "--list"), bareGitDirectory, "v1.0.0\nv2.0.0\n")
+	assertCommandOutput(t, exec.Command("git", "show", "latest:index.js"), bareGitDirectory, "module.exports = 2;\n")
+	assertCommandOutput(t, exec.Command("git", "ls-tree", "-r", "--name-only", "v2.0.0"), bareGitDirectory, "index.js\n")
+
+	clone("example@1.0.0")
+	assertCommandOutput(t, exec.Command("git", "tag", "--list"), bareGitDirectory, "v1.0.0\n")
+}
+
+type simpleDependencyReposStoreMock struct{}
+
+func (m *simpleDependencyReposStoreMock) GetDependencyRepos(ctx context.Context, filter dbstore.GetDependencyReposOpts) ([]dbstore.DependencyRepo, error) {
+	return []dbstore.DependencyRepo{}, nil
+}
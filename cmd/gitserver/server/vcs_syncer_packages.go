@@ -0,0 +1,268 @@
+package server
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// packageDependency is a single published version of a package.
+type packageDependency interface {
+	// PackageSyntax returns the name of the package, without version.
+	PackageSyntax() string
+	// PackageManagerSyntax returns the name and version of the package in the
+	// syntax of its package manager.
+	PackageManagerSyntax() string
+	// GitTagFromVersion returns the git tag of the commit that holds the
+	// contents of this version.
+	GitTagFromVersion() string
+}
+
+// packagesSource knows how to list and download the versions of the packages
+// of a single ecosystem.
+type packagesSource interface {
+	// Dependencies returns the versions of the package that belongs to the
+	// given repository URL path, sorted so that the latest version comes
+	// first.
+	Dependencies(ctx context.Context, repoURLPath string) ([]packageDependency, error)
+	// Exists returns a non-nil error if the given version can't be downloaded.
+	Exists(ctx context.Context, dep packageDependency) error
+	// Download writes the files of the given version into dir.
+	Download(ctx context.Context, dep packageDependency, dir string) error
+}
+
+// packagesSyncer implements VCSSyncer for repositories that are synthesized
+// from the published versions of a package, with one tagged commit per
+// version. The commit of the latest version is also pushed to the "latest"
+// branch.
+type packagesSyncer struct {
+	typ    string
+	source packagesSource
+}
+
+var _ VCSSyncer = &packagesSyncer{}
+
+func (s *packagesSyncer) Type() string {
+	return s.typ
+}
+
+// IsCloneable checks to see if the VCS remote URL is cloneable. Any non-nil
+// error indicates there is a problem.
+func (s *packagesSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	dependencies, err := s.source.Dependencies(ctx, remoteURL.Path)
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range dependencies {
+		if err := s.source.Exists(ctx, dependency); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for cloning from remote.
+// There is no external tool that performs all the step for creating a package
+// repository so the actual cloning happens inside this method and the
+// returned command is a no-op. This means that the web UI can't display a
+// helpful progress bar while cloning package repositories, but that's an
+// acceptable tradeoff we're willing to make.
+func (s *packagesSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, bareGitDirectory string) (*exec.Cmd, error) {
+	err := os.MkdirAll(bareGitDirectory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "--bare", "init")
+	if _, err := runCommandInDirectoryAs(ctx, cmd, bareGitDirectory, placeholderGitAuthor); err != nil {
+		return nil, err
+	}
+
+	// The Fetch method is responsible for cleaning up temporary directories.
+	if err := s.Fetch(ctx, remoteURL, GitDir(bareGitDirectory)); err != nil {
+		return nil, err
+	}
+
+	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
+	return exec.CommandContext(ctx, "git", "--version"), nil
+}
+
+// placeholderGitAuthor is used for git commands that don't create commits or
+// tags.
+const placeholderGitAuthor = "Sourcegraph"
+
+// Fetch adds git tags for newly added dependency versions and removes git tags
+// for deleted versions.
+func (s *packagesSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	dependencies, err := s.source.Dependencies(ctx, remoteURL.Path)
+	if err != nil {
+		return err
+	}
+
+	out, err := runCommandInDirectoryAs(ctx, exec.CommandContext(ctx, "git", "tag"), string(dir), placeholderGitAuthor)
+	if err != nil {
+		return err
+	}
+
+	tags := map[string]bool{}
+	for _, line := range strings.Split(out, "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		tags[line] = true
+	}
+
+	for i, dependency := range dependencies {
+		if tags[dependency.GitTagFromVersion()] {
+			continue
+		}
+		// the gitPushDependencyTag method is reponsible for cleaning up temporary directories.
+		if err := s.gitPushDependencyTag(ctx, string(dir), dependency, i == 0); err != nil {
+			return errors.Wrapf(err, "error pushing dependency %q", dependency.PackageManagerSyntax())
+		}
+	}
+
+	dependencyTags := make(map[string]struct{}, len(dependencies))
+	for _, dependency := range dependencies {
+		dependencyTags[dependency.GitTagFromVersion()] = struct{}{}
+	}
+
+	for tag := range tags {
+		if _, isDependencyTag := dependencyTags[tag]; !isDependencyTag {
+			cmd := exec.CommandContext(ctx, "git", "tag", "-d", tag)
+			if _, err := runCommandInDirectoryAs(ctx, cmd, string(dir), placeholderGitAuthor); err != nil {
+				log15.Error("Failed to delete git tag", "error", err, "tag", tag)
+				continue
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing remote.
+func (s *packagesSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
+
+// gitPushDependencyTag pushes a git tag to the given bareGitDirectory path. The
+// tag points to a commit that adds all files of the given dependency. When
+// isLatestVersion is true, the "latest" branch of the bare git directory will
+// also be updated to point to the same commit as the git tag.
+func (s *packagesSyncer) gitPushDependencyTag(ctx context.Context, bareGitDirectory string, dependency packageDependency, isLatestVersion bool) error {
+	tmpDirectory, err := os.MkdirTemp("", "package")
+	if err != nil {
+		return err
+	}
+	// Always clean up created temporary directories.
+	defer os.RemoveAll(tmpDirectory)
+
+	gitName := dependency.PackageSyntax() + " authors"
+	run := func(args ...string) (string, error) {
+		return runCommandInDirectoryAs(ctx, exec.CommandContext(ctx, "git", args...), tmpDirectory, gitName)
+	}
+
+	if _, err := run("init"); err != nil {
+		return err
+	}
+
+	if err := s.source.Download(ctx, dependency, tmpDirectory); err != nil {
+		return errors.Wrapf(err, "failed to download %s", dependency.PackageManagerSyntax())
+	}
+
+	if _, err := run("add", "."); err != nil {
+		return err
+	}
+
+	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+	if _, err := run("commit", "--no-verify", "-m", dependency.PackageManagerSyntax(), "--date", stableGitCommitDate); err != nil {
+		return err
+	}
+
+	if _, err := run("tag", "-m", dependency.PackageManagerSyntax(), dependency.GitTagFromVersion()); err != nil {
+		return err
+	}
+
+	if _, err := run("remote", "add", "origin", bareGitDirectory); err != nil {
+		return err
+	}
+
+	// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+	if _, err := run("push", "--no-verify", "--force", "origin", "--tags"); err != nil {
+		return err
+	}
+
+	if isLatestVersion {
+		defaultBranch, err := run("rev-parse", "--abbrev-ref", "HEAD")
+		if err != nil {
+			return err
+		}
+		// Use --no-verify for security reasons. See https://github.com/sourcegraph/sourcegraph/pull/23399
+		if _, err := run("push", "--no-verify", "--force", "origin", strings.TrimSpace(defaultBranch)+":latest", dependency.GitTagFromVersion()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// packageFileOutputPath returns the path under destination that the archive
+// entry with the given name should be written to, after stripping the given
+// prefix from the name. It returns false for entries that must be skipped.
+func packageFileOutputPath(destination, name, prefix string) (string, bool) {
+	if !strings.HasPrefix(name, prefix) {
+		return "", false
+	}
+	name = strings.TrimPrefix(name, prefix)
+	if name == "" || strings.HasSuffix(name, "/") {
+		// Skip directory entries.
+		return "", false
+	}
+	if strings.HasPrefix(name, "/") {
+		// Skip absolute paths.
+		return "", false
+	}
+	if name == ".git" || strings.HasPrefix(name, ".git/") {
+		// For security reasons, don't extract files under the `.git/`
+		// directory. See https://github.com/sourcegraph/security-issues/issues/163
+		return "", false
+	}
+	destinationDirectory := strings.TrimSuffix(destination, string(os.PathSeparator)) + string(os.PathSeparator)
+	cleanedOutputPath := path.Join(destination, name)
+	if !strings.HasPrefix(cleanedOutputPath, destinationDirectory) {
+		// For security reasons, skip file if it's not a child of the target
+		// directory. See "Zip Slip Vulnerability".
+		return "", false
+	}
+	return cleanedOutputPath, true
+}
+
+// writePackageFile writes the contents of r to outputPath, creating parent
+// directories as needed.
+func writePackageFile(outputPath string, r io.Reader) (err error) {
+	if err = os.MkdirAll(path.Dir(outputPath), 0700); err != nil {
+		return err
+	}
+	outputFile, err := os.OpenFile(outputPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err1 := outputFile.Close()
+		if err == nil {
+			err = err1
+		}
+	}()
+
+	_, err = io.Copy(outputFile, r)
+	return err
+}
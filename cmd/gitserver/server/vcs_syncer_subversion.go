@@ -0,0 +1,308 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+)
+
+// SubversionSyncer is a syncer for Subversion repositories. The repositories
+// are mirrored into Git with git-svn, which records the last fetched revision
+// inside the Git directory, so that subsequent fetches only import new
+// revisions.
+type SubversionSyncer struct {
+	// Layout is the layout of the Subversion repositories, "standard" (the
+	// default) for repositories with trunk/, branches/ and tags/ directories
+	// or "root" for repositories without branches.
+	Layout string
+}
+
+var _ VCSSyncer = &SubversionSyncer{}
+
+func (s *SubversionSyncer) Type() string {
+	return "subversion"
+}
+
+// decomposeSubversionRemoteURL decomposes a clone URL for a Subversion
+// repository into the credentials and the URL without the credentials, which
+// is the URL to pass to svn and git-svn.
+func decomposeSubversionRemoteURL(remoteURL *vcs.URL) (username, password, svnURL string) {
+	password, _ = remoteURL.User.Password()
+	u := *remoteURL
+	u.User = nil
+	return remoteURL.User.Username(), password, u.String()
+}
+
+// subversionCommand returns an svn command that runs the subcommand against the
+// path below the Subversion remote URL without prompting for or caching
+// credentials.
+func subversionCommand(ctx context.Context, remoteURL *vcs.URL, subcommand, path string) *exec.Cmd {
+	username, password, svnURL := decomposeSubversionRemoteURL(remoteURL)
+
+	args := []string{subcommand, "--non-interactive", "--no-auth-cache"}
+	if username != "" {
+		args = append(args, "--username", username, "--password-from-stdin")
+	}
+	if path != "" {
+		svnURL = strings.TrimSuffix(svnURL, "/") + "/" + path
+	}
+	args = append(args, svnURL)
+
+	cmd := exec.CommandContext(ctx, "svn", args...)
+	if username != "" {
+		cmd.Stdin = strings.NewReader(password)
+	}
+	return cmd
+}
+
+// IsCloneable checks to see if the Subversion remote URL is cloneable.
+func (s *SubversionSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	cmd := subversionCommand(ctx, remoteURL, "info", "")
+	out, err := runWith(ctx, cmd, false, nil)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = errors.Errorf("%s (output follows)\n\n%s", err, newURLRedactor(remoteURL).redact(string(out)))
+		}
+		return err
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for cloning from remote.
+// Like for packages, the actual cloning happens inside this method and the
+// returned command is a no-op, because git-svn needs to be initialized
+// before the first fetch.
+func (s *SubversionSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (*exec.Cmd, error) {
+	username, _, svnURL := decomposeSubversionRemoteURL(remoteURL)
+
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "init", "--bare", ".")
+	cmd.Dir = tmpPath
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, "clone setup failed")
+	}
+
+	// Example: git svn init --prefix=origin/ --stdlayout --username alice https://svn.example.com/repo
+	args := []string{"svn", "init", "--prefix=origin/"}
+	if s.Layout != "root" {
+		args = append(args, "--stdlayout")
+	}
+	if username != "" {
+		args = append(args, "--username", username)
+	}
+	args = append(args, svnURL)
+
+	cmd = exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = tmpPath
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return nil, errors.Wrapf(err, "git svn init failed with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+
+	if err := s.Fetch(ctx, remoteURL, GitDir(tmpPath)); err != nil {
+		return nil, err
+	}
+
+	// no-op command to satisfy VCSSyncer interface, see docstring for more details.
+	return exec.CommandContext(ctx, "git", "--version"), nil
+}
+
+// Fetch tries to fetch updates of a Subversion repository as a Git repository
+// and points the branches and tags of the Git repository at the fetched
+// revisions. Branches and tags that were deleted in the Subversion repository
+// are deleted from the Git repository.
+func (s *SubversionSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir) error {
+	_, password, _ := decomposeSubversionRemoteURL(remoteURL)
+
+	cmd := exec.CommandContext(ctx, "git", "svn", "fetch", "--no-auth-cache")
+	cmd.Env = append(os.Environ(), "GIT_ASKPASS=true") // disable password prompt
+	if password != "" {
+		askPass, err := writeSubversionAskPass()
+		if err != nil {
+			return err
+		}
+		defer os.Remove(askPass)
+		cmd.Env = append(os.Environ(), "GIT_ASKPASS="+askPass, "SRC_SVN_PASSWORD="+password)
+	}
+	dir.Set(cmd)
+
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return errors.Wrapf(err, "failed to update with output %q", newURLRedactor(remoteURL).redact(string(output)))
+	}
+
+	// git-svn keeps the remote refs of deleted branches and tags, so the
+	// branches and tags that still exist are listed with svn.
+	var live map[string]bool
+	if s.Layout != "root" {
+		var err error
+		if live, err = liveSubversionRefs(ctx, remoteURL); err != nil {
+			return err
+		}
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "for-each-ref", "--format=%(objectname) %(refname)", "refs/remotes/origin/", "refs/heads/", "refs/tags/")
+	dir.Set(cmd)
+	output, err := runWith(ctx, cmd, false, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list refs with output %q", string(output))
+	}
+
+	cmd = exec.CommandContext(ctx, "git", "update-ref", "--stdin")
+	cmd.Stdin = strings.NewReader(subversionRefUpdates(s.Layout, string(output), live))
+	dir.Set(cmd)
+	if output, err := runWith(ctx, cmd, false, nil); err != nil {
+		return errors.Wrapf(err, "failed to update refs with output %q", string(output))
+	}
+
+	return nil
+}
+
+// liveSubversionRefs returns the names of the git-svn remote refs of the trunk,
+// branches and tags that exist in a Subversion repository with the standard
+// layout: "trunk", "<branch>" and "tags/<tag>".
+func liveSubversionRefs(ctx context.Context, remoteURL *vcs.URL) (map[string]bool, error) {
+	list := func(path string) ([]string, error) {
+		cmd := subversionCommand(ctx, remoteURL, "list", path)
+		output, err := runWith(ctx, cmd, false, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %q with output %q", path, newURLRedactor(remoteURL).redact(string(output)))
+		}
+		var dirs []string
+		for _, line := range strings.Split(string(output), "\n") {
+			if strings.HasSuffix(line, "/") {
+				dirs = append(dirs, strings.TrimSuffix(line, "/"))
+			}
+		}
+		return dirs, nil
+	}
+
+	root, err := list("")
+	if err != nil {
+		return nil, err
+	}
+
+	live := map[string]bool{}
+	for _, dir := range root {
+		switch dir {
+		case "trunk":
+			live["trunk"] = true
+		case "branches", "tags":
+			names, err := list(dir)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range names {
+				if dir == "tags" {
+					name = "tags/" + name
+				}
+				live[name] = true
+			}
+		}
+	}
+	return live, nil
+}
+
+// writeSubversionAskPass writes a GIT_ASKPASS helper that answers the password
+// prompt of git-svn with the SRC_SVN_PASSWORD environment variable, so that
+// the password is neither passed on the command line nor written to disk.
+func writeSubversionAskPass() (string, error) {
+	f, err := os.CreateTemp("", "svn-askpass-")
+	if err != nil {
+		return "", errors.Wrap(err, "create askpass helper")
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString("#!/bin/sh\nprintf '%s\\n' \"$SRC_SVN_PASSWORD\"\n"); err != nil {
+		os.Remove(f.Name())
+		return "", errors.Wrap(err, "write askpass helper")
+	}
+	if err := f.Chmod(0700); err != nil {
+		os.Remove(f.Name())
+		return "", errors.Wrap(err, "chmod askpass helper")
+	}
+	return f.Name(), nil
+}
+
+// subversionRefUpdates returns the input of "git update-ref --stdin" that
+// points the branches and tags of the Git repository at the git-svn remote
+// refs listed in forEachRef, the output of "git for-each-ref
+// --format='%(objectname) %(refname)' refs/remotes/origin/ refs/heads/
+// refs/tags/".
+//
+// With the standard layout, trunk becomes master, tags/<name> becomes the tag
+// <name> and every other ref becomes a branch of the same name. With the root
+// layout, the single git-svn ref becomes master. Refs that git-svn creates for
+// older incarnations of deleted branches, named <branch>@<revision>, are
+// skipped, as are remote refs missing from live unless live is nil. Branches
+// and tags that no remote ref points at anymore are deleted.
+func subversionRefUpdates(layout, forEachRef string, live map[string]bool) string {
+	var (
+		b      strings.Builder
+		wanted = map[string]bool{}
+		local  []string
+	)
+	scanner := bufio.NewScanner(strings.NewReader(forEachRef))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if !strings.HasPrefix(fields[1], "refs/remotes/origin/") {
+			local = append(local, fields[1])
+			continue
+		}
+		sha, name := fields[0], strings.TrimPrefix(fields[1], "refs/remotes/origin/")
+		if strings.Contains(name, "@") {
+			continue
+		}
+		if live != nil && !live[name] {
+			continue
+		}
+
+		var ref string
+		switch {
+		case layout == "root":
+			if name != "git-svn" {
+				continue
+			}
+			ref = "refs/heads/master"
+		case name == "trunk":
+			ref = "refs/heads/master"
+		case strings.HasPrefix(name, "tags/"):
+			ref = "refs/tags/" + strings.TrimPrefix(name, "tags/")
+		default:
+			ref = "refs/heads/" + name
+		}
+		wanted[ref] = true
+		fmt.Fprintf(&b, "update %s %s\n", ref, sha)
+	}
+	for _, ref := range local {
+		if !wanted[ref] {
+			fmt.Fprintf(&b, "delete %s\n", ref)
+		}
+	}
+	return b.String()
+}
+
+// RemoteShowCommand returns the command to be executed for showing Git remote
+// of a Subversion repository.
+func (s *SubversionSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (cmd *exec.Cmd, err error) {
+	// Remote info is encoded as in the current repository
+	return exec.CommandContext(ctx, "git", "remote", "show", "./"), nil
+}
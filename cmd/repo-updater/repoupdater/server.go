@@ -51,6 +51,13 @@ type Server struct {
 		// ScheduleRepos schedules new permissions syncing requests for given repositories.
 		ScheduleRepos(ctx context.Context, repoIDs ...api.RepoID)
 	}
+	GitserverRebalancer interface {
+		// Trigger requests a run of the job that moves repositories between
+		// gitservers.
+		Trigger()
+		// Status returns the progress of the current or last run.
+		Status() protocol.GitserverRebalanceStatus
+	}
 }
 
 // Handler returns the http.Handler that should be used to serve requests.
@@ -65,6 +72,8 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("/sync-external-service", s.handleExternalServiceSync)
 	mux.HandleFunc("/enqueue-changeset-sync", s.handleEnqueueChangesetSync)
 	mux.HandleFunc("/schedule-perms-sync", s.handleSchedulePermsSync)
+	mux.HandleFunc("/gitserver-rebalance", s.handleGitserverRebalance)
+	mux.HandleFunc("/gitserver-rebalance-status", s.handleGitserverRebalanceStatus)
 	return mux
 }
 
@@ -383,3 +392,22 @@ func (s *Server) handleSchedulePermsSync(w http.ResponseWriter, r *http.Request)
 
 	respond(w, http.StatusOK, nil)
 }
+
+func (s *Server) handleGitserverRebalance(w http.ResponseWriter, r *http.Request) {
+	if s.GitserverRebalancer == nil {
+		respond(w, http.StatusForbidden, nil)
+		return
+	}
+
+	s.GitserverRebalancer.Trigger()
+	respond(w, http.StatusOK, s.GitserverRebalancer.Status())
+}
+
+func (s *Server) handleGitserverRebalanceStatus(w http.ResponseWriter, r *http.Request) {
+	if s.GitserverRebalancer == nil {
+		respond(w, http.StatusForbidden, nil)
+		return
+	}
+
+	respond(w, http.StatusOK, s.GitserverRebalancer.Status())
+}
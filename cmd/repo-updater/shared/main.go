@@ -50,6 +50,8 @@ import (
 
 const port = "3182"
 
+var gitserverRebalanceConcurrency = env.MustGetInt("SRC_GITSERVER_REBALANCE_CONCURRENCY", 4, "Maximum number of repositories moved between gitservers at once.")
+
 //go:embed state.html.tmpl
 var stateHTMLTemplate string
 
@@ -205,6 +207,11 @@ func Main(enterpriseInit EnterpriseInit) {
 		go repos.RunRepositoryPurgeWorker(ctx, db)
 	}
 
+	// Moves repositories between gitservers when gitservers are added or removed
+	rebalancer := repos.NewGitserverRebalancer(db.GitserverRepos(), gitserver.DefaultClient, conf.GitReplicationFactor, gitserverRebalanceConcurrency)
+	server.GitserverRebalancer = rebalancer
+	go rebalancer.Run(ctx, time.Minute)
+
 	// Git fetches scheduler
 	go repos.RunScheduler(ctx, scheduler)
 	log15.Debug("started scheduler")
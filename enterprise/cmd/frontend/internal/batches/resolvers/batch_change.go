@@ -58,6 +58,10 @@ func (r *batchChangeResolver) Description() *string {
 	return &r.batchChange.Description
 }
 
+func (r *batchChangeResolver) AutoRebase() bool {
+	return r.batchChange.AutoRebase
+}
+
 func (r *batchChangeResolver) InitialApplier(ctx context.Context) (*graphqlbackend.UserResolver, error) {
 	user, err := graphqlbackend.UserByIDInt32(ctx, r.store.DatabaseDB(), r.batchChange.InitialApplierID)
 	if errcode.IsNotFound(err) {
@@ -187,6 +191,17 @@ func (r *batchChangeResolver) ChangesetCountsOverTime(
 		return nil, err
 	}
 
+	// Changesets waiting for upstream changesets to be merged aren't published
+	// yet, but we still want to count them.
+	waiting, _, err := r.store.ListChangesets(ctx, store.ListChangesetsOpts{
+		BatchChangeID:    r.batchChange.ID,
+		IncludeArchived:  args.IncludeArchived,
+		ReconcilerStates: []btypes.ReconcilerState{btypes.ReconcilerStateWaiting},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	var es []*btypes.ChangesetEvent
 	changesetIDs := cs.IDs()
 	if len(changesetIDs) > 0 {
@@ -219,7 +234,7 @@ func (r *batchChangeResolver) ChangesetCountsOverTime(
 		end = args.To.Time.UTC()
 	}
 
-	counts, err := state.CalcCounts(start, end, cs, es...)
+	counts, err := state.CalcCounts(start, end, append(cs, waiting...), es...)
 	if err != nil {
 		return nil, err
 	}
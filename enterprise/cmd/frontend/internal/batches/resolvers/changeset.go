@@ -315,6 +315,8 @@ func (r *changesetResolver) State() (string, error) {
 		return string(btypes.ChangesetStateFailed), nil
 	case btypes.ReconcilerStateScheduled:
 		return string(btypes.ChangesetStateScheduled), nil
+	case btypes.ReconcilerStateWaiting:
+		return string(btypes.ChangesetStateWaiting), nil
 	default:
 		if r.changeset.ReconcilerState != btypes.ReconcilerStateCompleted {
 			return string(btypes.ChangesetStateProcessing), nil
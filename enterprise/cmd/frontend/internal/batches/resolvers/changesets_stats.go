@@ -20,6 +20,9 @@ func (r *changesetsStatsResolver) Failed() int32 {
 func (r *changesetsStatsResolver) Scheduled() int32 {
 	return r.stats.Scheduled
 }
+func (r *changesetsStatsResolver) Waiting() int32 {
+	return r.stats.Waiting
+}
 func (r *changesetsStatsResolver) Processing() int32 {
 	return r.stats.Processing
 }
@@ -0,0 +1,223 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	gh "github.com/google/go-github/v28/github"
+	"github.com/inconshreveable/log15"
+
+	fewebhooks "github.com/sourcegraph/sourcegraph/cmd/frontend/webhooks"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type BitbucketCloudWebhook struct {
+	*Webhook
+}
+
+func NewBitbucketCloudWebhook(store *store.Store) *BitbucketCloudWebhook {
+	return &BitbucketCloudWebhook{
+		Webhook: &Webhook{store, extsvc.TypeBitbucketCloud},
+	}
+}
+
+func (h *BitbucketCloudWebhook) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	e, extSvc, hErr := h.parseEvent(r)
+	if hErr != nil {
+		respond(w, hErr.code, hErr)
+		return
+	}
+	if e == nil {
+		// Events we don't act upon are acknowledged, so that Bitbucket Cloud
+		// doesn't retry them.
+		respond(w, http.StatusNoContent, nil)
+		return
+	}
+
+	fewebhooks.SetExternalServiceID(r.Context(), extSvc.ID)
+
+	// 🚨 SECURITY: now that the shared secret has been validated, we can use an
+	// internal actor on the context.
+	ctx := actor.WithInternalActor(r.Context())
+
+	externalServiceID, err := extractExternalServiceID(extSvc)
+	if err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	if err := h.handleEvent(ctx, externalServiceID, e); err != nil {
+		respond(w, http.StatusInternalServerError, err)
+		return
+	}
+	respond(w, http.StatusNoContent, nil)
+}
+
+func (h *BitbucketCloudWebhook) parseEvent(r *http.Request) (interface{}, *types.ExternalService, *httpError) {
+	payload, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+
+	sig := r.Header.Get("X-Hub-Signature")
+	if sig == "" {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("missing signature")}
+	}
+
+	rawID := r.FormValue(extsvc.IDParam)
+	if rawID == "" {
+		return nil, nil, &httpError{http.StatusBadRequest, errors.New("missing external service id")}
+	}
+	externalServiceID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil {
+		return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "invalid external service id")}
+	}
+
+	es, err := h.Store.ExternalServices().List(r.Context(), database.ExternalServicesListOptions{
+		IDs:   []int64{externalServiceID},
+		Kinds: []string{extsvc.KindBitbucketCloud},
+	})
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+	if len(es) != 1 {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("external service not found")}
+	}
+	extSvc := es[0]
+
+	c, err := extSvc.Configuration()
+	if err != nil {
+		return nil, nil, &httpError{http.StatusInternalServerError, err}
+	}
+	con, ok := c.(*schema.BitbucketCloudConnection)
+	if !ok || con.WebhookSecret == "" {
+		return nil, nil, &httpError{http.StatusUnauthorized, errors.New("no webhook secret configured")}
+	}
+
+	// 🚨 SECURITY: Bitbucket Cloud signs the payload with the secret configured
+	// on the webhook, using the same scheme as GitHub.
+	if err := gh.ValidateSignature(sig, payload, []byte(con.WebhookSecret)); err != nil {
+		return nil, nil, &httpError{http.StatusUnauthorized, err}
+	}
+
+	e, err := bitbucketcloud.ParseWebhookEvent(bitbucketcloud.WebhookEventType(r), payload)
+	if err != nil {
+		if bitbucketcloud.IsUnknownWebhookEvent(err) {
+			log15.Debug("ignoring unknown Bitbucket Cloud webhook event", "err", err)
+			return nil, extSvc, nil
+		}
+		return nil, nil, &httpError{http.StatusBadRequest, errors.Wrap(err, "parsing webhook")}
+	}
+	return e, extSvc, nil
+}
+
+// handleEvent dispatches on the event type. Events that carry enough
+// information to update the changeset are stored as changeset events, while
+// the others enqueue a sync of the affected changeset.
+func (h *BitbucketCloudWebhook) handleEvent(ctx context.Context, externalServiceID string, theirs interface{}) error {
+	log15.Debug("Bitbucket Cloud webhook received", "type", fmt.Sprintf("%T", theirs))
+
+	switch e := theirs.(type) {
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		return h.upsertChangesetEvent(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent), e)
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		return h.upsertChangesetEvent(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent), e)
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		return h.upsertChangesetEvent(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent), e)
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		return h.upsertChangesetEvent(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent), e)
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		return h.upsertChangesetEvent(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent), e)
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		return h.upsertChangesetEvent(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent), e)
+
+	case *bitbucketcloud.PullRequestCreatedEvent:
+		return h.enqueueChangesetSyncForPR(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent))
+	case *bitbucketcloud.PullRequestUpdatedEvent:
+		return h.enqueueChangesetSyncForPR(ctx, externalServiceID, bitbucketCloudToPR(&e.PullRequestEvent))
+
+	case *bitbucketcloud.RepoCommitStatusCreatedEvent:
+		return h.enqueueChangesetSyncForCommitStatus(ctx, externalServiceID, &e.RepoCommitStatusEvent)
+	case *bitbucketcloud.RepoCommitStatusUpdatedEvent:
+		return h.enqueueChangesetSyncForCommitStatus(ctx, externalServiceID, &e.RepoCommitStatusEvent)
+	}
+
+	return nil
+}
+
+func (h *BitbucketCloudWebhook) enqueueChangesetSyncForPR(ctx context.Context, externalServiceID string, pr PR) error {
+	repo, err := h.getRepoForPR(ctx, h.Store, pr, externalServiceID)
+	if err != nil {
+		log15.Warn("Webhook event could not be matched to repo", "err", err)
+		return nil
+	}
+
+	c, err := h.Store.GetChangeset(ctx, store.GetChangesetOpts{
+		RepoID:              repo.ID,
+		ExternalID:          strconv.FormatInt(pr.ID, 10),
+		ExternalServiceType: h.ServiceType,
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil
+		}
+		return errors.Wrap(err, "getting changeset")
+	}
+
+	if err := repoupdater.DefaultClient.EnqueueChangesetSync(ctx, []int64{c.ID}); err != nil {
+		return errors.Wrap(err, "enqueuing changeset sync")
+	}
+	return nil
+}
+
+// enqueueChangesetSyncForCommitStatus enqueues a sync of the changeset whose
+// head branch the commit status was reported for. Commit status events don't
+// reference pull requests, so the changeset is matched on its branch instead.
+func (h *BitbucketCloudWebhook) enqueueChangesetSyncForCommitStatus(ctx context.Context, externalServiceID string, e *bitbucketcloud.RepoCommitStatusEvent) error {
+	if e.CommitStatus.RefName == "" {
+		return nil
+	}
+
+	repo, err := h.getRepoForPR(ctx, h.Store, PR{RepoExternalID: e.Repository.UUID}, externalServiceID)
+	if err != nil {
+		log15.Warn("Webhook event could not be matched to repo", "err", err)
+		return nil
+	}
+
+	c, err := h.Store.GetChangeset(ctx, store.GetChangesetOpts{
+		RepoID:              repo.ID,
+		ExternalBranch:      "refs/heads/" + e.CommitStatus.RefName,
+		ExternalServiceType: h.ServiceType,
+	})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil
+		}
+		return errors.Wrap(err, "getting changeset")
+	}
+
+	if err := repoupdater.DefaultClient.EnqueueChangesetSync(ctx, []int64{c.ID}); err != nil {
+		return errors.Wrap(err, "enqueuing changeset sync")
+	}
+	return nil
+}
+
+// bitbucketCloudToPR instantiates a new PR instance given the fields common to
+// all Bitbucket Cloud pull request webhook payloads.
+func bitbucketCloudToPR(e *bitbucketcloud.PullRequestEvent) PR {
+	return PR{
+		ID:             e.PullRequest.ID,
+		RepoExternalID: e.Repository.UUID,
+	}
+}
@@ -0,0 +1,299 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/types/typestest"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// Run from integration_test.go
+func testBitbucketCloudWebhook(db *sql.DB, userID int32) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.Background()
+
+		t.Run("ServeHTTP", func(t *testing.T) {
+			t.Run("missing signature", func(t *testing.T) {
+				store := gitLabTestSetup(t, db)
+				h := NewBitbucketCloudWebhook(store)
+				es := createBitbucketCloudExternalService(t, ctx, store.ExternalServices())
+
+				u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, es.ID, "https://example.com/")
+				req, err := http.NewRequest("POST", u, bytes.NewBufferString("{}"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				req.Header.Set("X-Event-Key", "pullrequest:approved")
+
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+
+				resp := rec.Result()
+				if have, want := resp.StatusCode, http.StatusUnauthorized; have != want {
+					t.Errorf("unexpected status code: have %d; want %d", have, want)
+				}
+				assertBodyIncludes(t, resp.Body, "missing signature")
+			})
+
+			t.Run("missing external service", func(t *testing.T) {
+				store := gitLabTestSetup(t, db)
+				h := NewBitbucketCloudWebhook(store)
+
+				u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, 12345, "https://example.com/")
+				req := newBitbucketCloudRequest(t, u, "pullrequest:approved", []byte("{}"))
+
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+
+				if have, want := rec.Result().StatusCode, http.StatusUnauthorized; have != want {
+					t.Errorf("unexpected status code: have %d; want %d", have, want)
+				}
+			})
+
+			t.Run("incorrect signature", func(t *testing.T) {
+				store := gitLabTestSetup(t, db)
+				h := NewBitbucketCloudWebhook(store)
+				es := createBitbucketCloudExternalService(t, ctx, store.ExternalServices())
+
+				u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, es.ID, "https://example.com/")
+				req, err := http.NewRequest("POST", u, bytes.NewBufferString("{}"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				req.Header.Set("X-Event-Key", "pullrequest:approved")
+				req.Header.Set("X-Hub-Signature", sign(t, []byte("{}"), []byte("not the secret")))
+
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+
+				if have, want := rec.Result().StatusCode, http.StatusUnauthorized; have != want {
+					t.Errorf("unexpected status code: have %d; want %d", have, want)
+				}
+			})
+
+			t.Run("malformed body", func(t *testing.T) {
+				store := gitLabTestSetup(t, db)
+				h := NewBitbucketCloudWebhook(store)
+				es := createBitbucketCloudExternalService(t, ctx, store.ExternalServices())
+
+				u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, es.ID, "https://example.com/")
+				req := newBitbucketCloudRequest(t, u, "pullrequest:approved", []byte("invalid JSON"))
+
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+
+				resp := rec.Result()
+				if have, want := resp.StatusCode, http.StatusBadRequest; have != want {
+					t.Errorf("unexpected status code: have %d; want %d", have, want)
+				}
+				assertBodyIncludes(t, resp.Body, "parsing webhook")
+			})
+
+			t.Run("unknown event", func(t *testing.T) {
+				store := gitLabTestSetup(t, db)
+				h := NewBitbucketCloudWebhook(store)
+				es := createBitbucketCloudExternalService(t, ctx, store.ExternalServices())
+
+				u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, es.ID, "https://example.com/")
+				req := newBitbucketCloudRequest(t, u, "repo:push", []byte("{}"))
+
+				rec := httptest.NewRecorder()
+				h.ServeHTTP(rec, req)
+
+				if have, want := rec.Result().StatusCode, http.StatusNoContent; have != want {
+					t.Errorf("unexpected status code: have %d; want %d", have, want)
+				}
+			})
+
+			t.Run("valid pull request events", func(t *testing.T) {
+				for eventType, want := range map[string]btypes.ChangesetEventKind{
+					"pullrequest:approved":  btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
+					"pullrequest:fulfilled": btypes.ChangesetEventKindBitbucketCloudPullRequestFulfilled,
+					"pullrequest:rejected":  btypes.ChangesetEventKindBitbucketCloudPullRequestRejected,
+				} {
+					t.Run(eventType, func(t *testing.T) {
+						store := gitLabTestSetup(t, db)
+						repoStore := database.ReposWith(store)
+						h := NewBitbucketCloudWebhook(store)
+						es := createBitbucketCloudExternalService(t, ctx, store.ExternalServices())
+						repo := createBitbucketCloudRepo(t, ctx, repoStore, es)
+						changeset := createBitbucketCloudChangeset(t, ctx, store, repo, extsvc.TypeBitbucketCloud)
+						body := createBitbucketCloudPullRequestPayload(t, repo, changeset)
+
+						// Send the event twice to ensure we are idempotent.
+						for i := 0; i < 2; i++ {
+							u := extsvc.WebhookURL(extsvc.TypeBitbucketCloud, es.ID, "https://example.com/")
+							req := newBitbucketCloudRequest(t, u, eventType, body)
+
+							rec := httptest.NewRecorder()
+							h.ServeHTTP(rec, req)
+
+							if have, want := rec.Result().StatusCode, http.StatusNoContent; have != want {
+								t.Errorf("unexpected status code: have %d; want %d", have, want)
+							}
+						}
+
+						assertChangesetEventForChangeset(t, ctx, store, changeset, want)
+					})
+				}
+			})
+		})
+
+		t.Run("enqueueChangesetSyncForCommitStatus", func(t *testing.T) {
+			store := gitLabTestSetup(t, db)
+			repoStore := database.ReposWith(store)
+			h := NewBitbucketCloudWebhook(store)
+			es := createBitbucketCloudExternalService(t, ctx, store.ExternalServices())
+			repo := createBitbucketCloudRepo(t, ctx, repoStore, es)
+
+			esid, err := extractExternalServiceID(es)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var enqueued []int64
+			repoupdater.MockEnqueueChangesetSync = func(ctx context.Context, ids []int64) error {
+				enqueued = append(enqueued, ids...)
+				return nil
+			}
+			t.Cleanup(func() { repoupdater.MockEnqueueChangesetSync = nil })
+
+			event := &bitbucketcloud.RepoCommitStatusEvent{
+				Repository:   bitbucketcloud.Repo{UUID: repo.ExternalRepo.ID},
+				CommitStatus: bitbucketcloud.PullRequestStatus{RefName: "my-branch"},
+			}
+
+			t.Run("changeset of another code host", func(t *testing.T) {
+				createBitbucketCloudChangeset(t, ctx, store, repo, extsvc.TypeGitHub)
+
+				if err := h.enqueueChangesetSyncForCommitStatus(ctx, esid, event); err != nil {
+					t.Fatal(err)
+				}
+				if len(enqueued) != 0 {
+					t.Errorf("unexpected changeset syncs enqueued: %v", enqueued)
+				}
+			})
+
+			t.Run("success", func(t *testing.T) {
+				changeset := createBitbucketCloudChangeset(t, ctx, store, repo, extsvc.TypeBitbucketCloud)
+
+				if err := h.enqueueChangesetSyncForCommitStatus(ctx, esid, event); err != nil {
+					t.Fatal(err)
+				}
+				if len(enqueued) != 1 || enqueued[0] != changeset.ID {
+					t.Errorf("unexpected changeset syncs enqueued: have %v; want [%d]", enqueued, changeset.ID)
+				}
+			})
+		})
+	}
+}
+
+// newBitbucketCloudRequest creates a webhook request for the given event type
+// and payload, signed with the secret of the external service created by
+// createBitbucketCloudExternalService.
+func newBitbucketCloudRequest(t *testing.T, u, eventType string, payload []byte) *http.Request {
+	req, err := http.NewRequest("POST", u, bytes.NewReader(payload))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Event-Key", eventType)
+	req.Header.Set("X-Hub-Signature", sign(t, payload, []byte("secret")))
+	return req
+}
+
+// createBitbucketCloudExternalService creates a mock Bitbucket Cloud service
+// with a valid configuration, including the webhook secret "secret".
+func createBitbucketCloudExternalService(t *testing.T, ctx context.Context, esStore database.ExternalServiceStore) *types.ExternalService {
+	es := &types.ExternalService{
+		Kind:        extsvc.KindBitbucketCloud,
+		DisplayName: "bitbucketcloud",
+		Config: ct.MarshalJSON(t, &schema.BitbucketCloudConnection{
+			Url:           "https://bitbucket.org",
+			Username:      "user",
+			AppPassword:   "password",
+			WebhookSecret: "secret",
+		}),
+	}
+	if err := esStore.Upsert(ctx, es); err != nil {
+		t.Fatal(err)
+	}
+
+	return es
+}
+
+// createBitbucketCloudRepo creates a mock Bitbucket Cloud repo attached to the
+// given external service.
+func createBitbucketCloudRepo(t *testing.T, ctx context.Context, rstore database.RepoStore, es *types.ExternalService) *types.Repo {
+	repo := (&types.Repo{
+		Name: "bitbucket.org/sourcegraph/test",
+		URI:  "bitbucket.org/sourcegraph/test",
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          "{c4f2ab4e-8b54-4c5c-94f3-3a2e5d7b1f11}",
+			ServiceType: extsvc.TypeBitbucketCloud,
+			ServiceID:   "https://bitbucket.org/",
+		},
+	}).With(typestest.Opt.RepoSources(es.URN()))
+	if err := rstore.Create(ctx, repo); err != nil {
+		t.Fatal(err)
+	}
+
+	return repo
+}
+
+// createBitbucketCloudChangeset creates a mock changeset on the branch
+// my-branch of the given code host type.
+func createBitbucketCloudChangeset(t *testing.T, ctx context.Context, store *store.Store, repo *types.Repo, serviceType string) *btypes.Changeset {
+	c := &btypes.Changeset{
+		RepoID:              repo.ID,
+		ExternalID:          "1",
+		ExternalServiceType: serviceType,
+		ExternalBranch:      "refs/heads/my-branch",
+	}
+	if err := store.CreateChangeset(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+// createBitbucketCloudPullRequestPayload creates a mock Bitbucket Cloud
+// webhook payload for the pull request of the given changeset.
+func createBitbucketCloudPullRequestPayload(t *testing.T, repo *types.Repo, changeset *btypes.Changeset) []byte {
+	id, err := strconv.ParseInt(changeset.ExternalID, 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	approval := bitbucketcloud.Approval{
+		Date: time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC),
+		User: bitbucketcloud.Account{UUID: "{user}"},
+	}
+	payload, err := json.Marshal(&bitbucketcloud.PullRequestApprovedEvent{
+		PullRequestEvent: bitbucketcloud.PullRequestEvent{
+			PullRequest: bitbucketcloud.PullRequest{ID: id},
+			Repository:  bitbucketcloud.Repo{UUID: repo.ExternalRepo.ID},
+		},
+		Approval: approval,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
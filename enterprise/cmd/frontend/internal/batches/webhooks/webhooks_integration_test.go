@@ -21,4 +21,5 @@ func TestWebhooksIntegration(t *testing.T) {
 	t.Run("GitHubWebhook", testGitHubWebhook(db, user.ID))
 	t.Run("BitbucketWebhook", testBitbucketWebhook(db, user.ID))
 	t.Run("GitLabWebhook", testGitLabWebhook(db, user.ID))
+	t.Run("BitbucketCloudWebhook", testBitbucketCloudWebhook(db, user.ID))
 }
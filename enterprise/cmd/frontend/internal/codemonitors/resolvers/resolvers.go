@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"net/url"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -146,7 +147,7 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 	}
 
 	// Create trigger.
-	_, err = tx.store.CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+	_, err = tx.store.CreateQueryTrigger(ctx, m.ID, args.Trigger.Query, triggerType(args.Trigger))
 	if err != nil {
 		return nil, err
 	}
@@ -220,6 +221,9 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	toCreate, toDelete, err := splitActionIDs(ctx, args, actionIDs)
+	if err != nil {
+		return nil, err
+	}
 	if len(toDelete) == len(actionIDs) {
 		return nil, errors.Errorf("you tried to delete all actions, but every monitor must be connected to at least 1 action")
 	}
@@ -231,7 +235,7 @@ func (r *Resolver) UpdateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 	defer func() { err = tx.store.Done(err) }()
 
-	err = tx.store.DeleteEmailActions(ctx, toDelete, monitorID)
+	err = tx.deleteActions(ctx, monitorID, toDelete)
 	if err != nil {
 		return nil, err
 	}
@@ -264,11 +268,91 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 				return err
 			}
 		}
-		// TODO(camdencheek): add other action types (webhooks) here
+		if a.TeamsWebhook != nil {
+			if err := validateWebhookURL(a.TeamsWebhook.URL); err != nil {
+				return err
+			}
+			if _, err := r.store.CreateTeamsWebhookAction(ctx, monitorID, a.TeamsWebhook.Enabled, a.TeamsWebhook.URL); err != nil {
+				return err
+			}
+		}
+		if a.TemplatedWebhook != nil {
+			args, err := templatedWebhookActionArgs(a.TemplatedWebhook)
+			if err != nil {
+				return err
+			}
+			if _, err := r.store.CreateTemplatedWebhookAction(ctx, monitorID, args); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteActions deletes the actions with the given GraphQL IDs from the
+// monitor.
+func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
+	var emailIDs, teamsWebhookIDs, templatedWebhookIDs []int64
+	for _, id := range ids {
+		var actionID int64
+		if err := relay.UnmarshalSpec(id, &actionID); err != nil {
+			return err
+		}
+		switch relay.UnmarshalKind(id) {
+		case monitorActionEmailKind:
+			emailIDs = append(emailIDs, actionID)
+		case monitorActionTeamsWebhookKind:
+			teamsWebhookIDs = append(teamsWebhookIDs, actionID)
+		case monitorActionTemplatedWebhookKind:
+			templatedWebhookIDs = append(templatedWebhookIDs, actionID)
+		default:
+			return errors.Errorf("invalid action ID %s", id)
+		}
+	}
+
+	if err := r.store.DeleteEmailActions(ctx, emailIDs, monitorID); err != nil {
+		return err
+	}
+	if err := r.store.DeleteTeamsWebhookActions(ctx, monitorID, teamsWebhookIDs...); err != nil {
+		return err
+	}
+	return r.store.DeleteTemplatedWebhookActions(ctx, monitorID, templatedWebhookIDs...)
+}
+
+// validateWebhookURL returns an error if rawURL is not an absolute HTTP(S) URL.
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrap(err, "invalid webhook URL")
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid webhook URL %q: must be an absolute http or https URL", rawURL)
 	}
 	return nil
 }
 
+// templatedWebhookActionArgs validates args and converts them to the arguments
+// expected by the store.
+func templatedWebhookActionArgs(args *graphqlbackend.CreateActionTemplatedWebhookArgs) (*cm.TemplatedWebhookActionArgs, error) {
+	if err := validateWebhookURL(args.URL); err != nil {
+		return nil, err
+	}
+	if err := background.ValidateTemplatedWebhookPayloadTemplate(args.PayloadTemplate); err != nil {
+		return nil, errors.Wrap(err, "invalid payload template")
+	}
+
+	contentType := background.DefaultTemplatedWebhookContentType
+	if args.ContentType != nil && *args.ContentType != "" {
+		contentType = *args.ContentType
+	}
+	return &cm.TemplatedWebhookActionArgs{
+		Enabled:         args.Enabled,
+		URL:             args.URL,
+		ContentType:     contentType,
+		PayloadTemplate: args.PayloadTemplate,
+	}, nil
+}
+
 func (r *Resolver) createRecipients(ctx context.Context, emailID int64, recipients []graphql.ID) error {
 	for _, recipient := range recipients {
 		userID, orgID, err := graphqlbackend.UnmarshalNamespaceToIDs(recipient)
@@ -337,51 +421,111 @@ func sendTestEmail(ctx context.Context, recipient graphql.ID, description string
 	return background.SendEmailForNewSearchResult(ctx, userID, data)
 }
 
+func (r *Resolver) TriggerTestTeamsWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestTeamsWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateWebhookURL(args.TeamsWebhook.URL); err != nil {
+		return nil, err
+	}
+	if err := background.SendTestTeamsWebhook(ctx, args.Description, args.TeamsWebhook.URL); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+func (r *Resolver) TriggerTestTemplatedWebhookAction(ctx context.Context, args *graphqlbackend.TriggerTestTemplatedWebhookActionArgs) (*graphqlbackend.EmptyResponse, error) {
+	err := r.isAllowedToCreate(ctx, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	action, err := templatedWebhookActionArgs(args.TemplatedWebhook)
+	if err != nil {
+		return nil, err
+	}
+	if err := background.SendTestTemplatedWebhook(ctx, args.Description, action); err != nil {
+		return nil, err
+	}
+
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int64) ([]graphql.ID, error) {
-	emailActions, err := r.store.ListEmailActions(ctx, cm.ListActionsOpts{
-		MonitorID: &monitorID,
-	})
+	actions, err := r.listActions(ctx, nil, monitorID)
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, len(emailActions))
-	for i, emailAction := range emailActions {
-		ids[i] = (&monitorEmail{EmailAction: emailAction}).ID()
+	ids := make([]graphql.ID, len(actions))
+	for i, a := range actions {
+		ids[i] = a.id()
 	}
 	return ids, nil
 }
 
 // splitActionIDs splits actions into three buckets: create, delete and update.
 // Note: args is mutated. After splitActionIDs, args only contains actions to be updated.
-func splitActionIDs(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []graphql.ID) (toCreate []*graphqlbackend.CreateActionArgs, toDelete []int64, err error) {
+func splitActionIDs(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []graphql.ID) (toCreate []*graphqlbackend.CreateActionArgs, toDelete []graphql.ID, err error) {
 	aMap := make(map[graphql.ID]struct{}, len(actionIDs))
 	for _, id := range actionIDs {
 		aMap[id] = struct{}{}
 	}
 	var toUpdateActions []*graphqlbackend.EditActionArgs
-	for _, a := range args.Actions {
-		if a.Email.Id == nil {
-			toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{Email: a.Email.Update})
+	for i, a := range args.Actions {
+		id, create, err := unpackEditAction(a)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "action %d", i)
+		}
+		if id == nil {
+			toCreate = append(toCreate, create)
 			continue
 		}
-		if _, ok := aMap[*a.Email.Id]; !ok {
-			return nil, nil, errors.Errorf("unknown ID=%s for action", *a.Email.Id)
+		if _, ok := aMap[*id]; !ok {
+			return nil, nil, errors.Errorf("unknown ID=%s for action", *id)
 		}
 		toUpdateActions = append(toUpdateActions, a)
-		delete(aMap, *a.Email.Id)
+		delete(aMap, *id)
 	}
-	var actionID int64
 	for k := range aMap {
-		err = relay.UnmarshalSpec(k, &actionID)
-		if err != nil {
-			return nil, nil, err
-		}
-		toDelete = append(toDelete, actionID)
+		toDelete = append(toDelete, k)
 	}
 	args.Actions = toUpdateActions
 	return toCreate, toDelete, nil
 }
 
+// unpackEditAction returns the ID of the action a edits, or nil if a creates a
+// new action, together with the arguments to create the action. Exactly one
+// action type must be set, and the kind of the ID must match it.
+func unpackEditAction(a *graphqlbackend.EditActionArgs) (id *graphql.ID, create *graphqlbackend.CreateActionArgs, err error) {
+	var kind string
+	set := 0
+	if a.Email != nil {
+		set++
+		id, kind = a.Email.Id, monitorActionEmailKind
+		create = &graphqlbackend.CreateActionArgs{Email: a.Email.Update}
+	}
+	if a.TeamsWebhook != nil {
+		set++
+		id, kind = a.TeamsWebhook.Id, monitorActionTeamsWebhookKind
+		create = &graphqlbackend.CreateActionArgs{TeamsWebhook: a.TeamsWebhook.Update}
+	}
+	if a.TemplatedWebhook != nil {
+		set++
+		id, kind = a.TemplatedWebhook.Id, monitorActionTemplatedWebhookKind
+		create = &graphqlbackend.CreateActionArgs{TemplatedWebhook: a.TemplatedWebhook.Update}
+	}
+	if set != 1 {
+		return nil, nil, errors.New("exactly one of email, teamsWebhook or templatedWebhook must be set")
+	}
+	if id != nil && relay.UnmarshalKind(*id) != kind {
+		return nil, nil, errors.Errorf("ID=%s is not a %s", *id, kind)
+	}
+	return id, create, nil
+}
+
 func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.UpdateCodeMonitorArgs) (graphqlbackend.MonitorResolver, error) {
 	// Update monitor.
 	var monitorID int64
@@ -410,7 +554,7 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	// Update trigger.
-	err = r.store.UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	err = r.store.UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query, triggerType(args.Trigger.Update))
 	if err != nil {
 		return nil, err
 	}
@@ -422,30 +566,38 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 		}, nil
 	}
 	for i, action := range args.Actions {
-		if action.Email == nil {
-			return nil, errors.Errorf("missing email object for action %d", i)
-		}
-		var emailID int64
-		err = relay.UnmarshalSpec(*action.Email.Id, &emailID)
-		if err != nil {
-			return nil, err
-		}
-		err = r.store.DeleteRecipients(ctx, emailID)
-		if err != nil {
-			return nil, err
-		}
-
-		e, err := r.store.UpdateEmailAction(ctx, emailID, &cm.EmailActionArgs{
-			Enabled:  action.Email.Update.Enabled,
-			Priority: action.Email.Update.Priority,
-			Header:   action.Email.Update.Header,
-		})
-		if err != nil {
-			return nil, err
-		}
-		err = r.createRecipients(ctx, e.ID, action.Email.Update.Recipients)
-		if err != nil {
-			return nil, err
+		switch {
+		case action.Email != nil:
+			if err := r.updateEmailAction(ctx, action.Email); err != nil {
+				return nil, err
+			}
+		case action.TeamsWebhook != nil:
+			var teamsWebhookID int64
+			if err := relay.UnmarshalSpec(*action.TeamsWebhook.Id, &teamsWebhookID); err != nil {
+				return nil, err
+			}
+			if err := validateWebhookURL(action.TeamsWebhook.Update.URL); err != nil {
+				return nil, err
+			}
+			_, err = r.store.UpdateTeamsWebhookAction(ctx, teamsWebhookID, action.TeamsWebhook.Update.Enabled, action.TeamsWebhook.Update.URL)
+			if err != nil {
+				return nil, err
+			}
+		case action.TemplatedWebhook != nil:
+			var templatedWebhookID int64
+			if err := relay.UnmarshalSpec(*action.TemplatedWebhook.Id, &templatedWebhookID); err != nil {
+				return nil, err
+			}
+			updateArgs, err := templatedWebhookActionArgs(action.TemplatedWebhook.Update)
+			if err != nil {
+				return nil, err
+			}
+			_, err = r.store.UpdateTemplatedWebhookAction(ctx, templatedWebhookID, updateArgs)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.Errorf("missing action object for action %d", i)
 		}
 	}
 	return &monitor{
@@ -454,6 +606,28 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}, nil
 }
 
+func (r *Resolver) updateEmailAction(ctx context.Context, args *graphqlbackend.EditActionEmailArgs) error {
+	var emailID int64
+	err := relay.UnmarshalSpec(*args.Id, &emailID)
+	if err != nil {
+		return err
+	}
+	err = r.store.DeleteRecipients(ctx, emailID)
+	if err != nil {
+		return err
+	}
+
+	e, err := r.store.UpdateEmailAction(ctx, emailID, &cm.EmailActionArgs{
+		Enabled:  args.Update.Enabled,
+		Priority: args.Update.Priority,
+		Header:   args.Update.Header,
+	})
+	if err != nil {
+		return err
+	}
+	return r.createRecipients(ctx, e.ID, args.Update.Recipients)
+}
+
 func (r *Resolver) transact(ctx context.Context) (*Resolver, error) {
 	txStore, err := r.store.Transact(ctx)
 	if err != nil {
@@ -543,12 +717,14 @@ type monitor struct {
 }
 
 const (
-	MonitorKind                     = "CodeMonitor"
-	monitorTriggerQueryKind         = "CodeMonitorTriggerQuery"
-	monitorTriggerEventKind         = "CodeMonitorTriggerEvent"
-	monitorActionEmailKind          = "CodeMonitorActionEmail"
-	monitorActionEventKind          = "CodeMonitorActionEmailEvent"
-	monitorActionEmailRecipientKind = "CodeMonitorActionEmailRecipient"
+	MonitorKind                       = "CodeMonitor"
+	monitorTriggerQueryKind           = "CodeMonitorTriggerQuery"
+	monitorTriggerEventKind           = "CodeMonitorTriggerEvent"
+	monitorActionEmailKind            = "CodeMonitorActionEmail"
+	monitorActionTeamsWebhookKind     = "CodeMonitorActionTeamsWebhook"
+	monitorActionTemplatedWebhookKind = "CodeMonitorActionTemplatedWebhook"
+	monitorActionEventKind            = "CodeMonitorActionEmailEvent"
+	monitorActionEmailRecipientKind   = "CodeMonitorActionEmailRecipient"
 )
 
 func (m *monitor) ID() graphql.ID {
@@ -588,27 +764,77 @@ func (m *monitor) Actions(ctx context.Context, args *graphqlbackend.ListActionAr
 	return m.actionConnectionResolverWithTriggerID(ctx, nil, m.Monitor.ID, args)
 }
 
+// actionKindOrder is the order in which actions of different kinds are listed.
+var actionKindOrder = map[string]int{
+	monitorActionEmailKind:            0,
+	monitorActionTeamsWebhookKind:     1,
+	monitorActionTemplatedWebhookKind: 2,
+}
+
 func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, triggerEventID *int32, monitorID int64, args *graphqlbackend.ListActionArgs) (graphqlbackend.MonitorActionConnectionResolver, error) {
-	after, err := unmarshalAfter(args.After)
+	// Monitors only have a handful of actions, so we list all of them and
+	// paginate in memory rather than paginating across several tables.
+	all, err := r.listActions(ctx, triggerEventID, monitorID)
 	if err != nil {
 		return nil, err
 	}
-	// For now, we only support emails as actions. Once we add other actions such as
-	// webhooks, we have to query those tables here too.
-	es, err := r.store.ListEmailActions(ctx, cm.ListActionsOpts{
-		MonitorID: &monitorID,
-		After:     after,
-		First:     intPtr(int(args.First)),
-	})
+
+	start := 0
+	if args.After != nil {
+		afterID := graphql.ID(*args.After)
+		afterKind, ok := actionKindOrder[relay.UnmarshalKind(afterID)]
+		if !ok {
+			return nil, errors.Errorf("invalid cursor %q", *args.After)
+		}
+		var afterActionID int64
+		if err := relay.UnmarshalSpec(afterID, &afterActionID); err != nil {
+			return nil, err
+		}
+		for start < len(all) {
+			id := all[start].id()
+			var actionID int64
+			if err := relay.UnmarshalSpec(id, &actionID); err != nil {
+				return nil, err
+			}
+			kind := actionKindOrder[relay.UnmarshalKind(id)]
+			if kind > afterKind || (kind == afterKind && actionID > afterActionID) {
+				break
+			}
+			start++
+		}
+	}
+
+	end := len(all)
+	if args.First >= 0 && start+int(args.First) < end {
+		end = start + int(args.First)
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, end-start)
+	for _, a := range all[start:end] {
+		actions = append(actions, a)
+	}
+	return &monitorActionConnection{actions: actions, totalCount: int32(len(all))}, nil
+}
+
+// listActions returns all actions of the monitor, ordered by actionKindOrder
+// and ID.
+func (r *Resolver) listActions(ctx context.Context, triggerEventID *int32, monitorID int64) ([]*action, error) {
+	opts := cm.ListActionsOpts{MonitorID: &monitorID}
+
+	es, err := r.store.ListEmailActions(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-
-	totalCount, err := r.store.CountEmailActions(ctx, monitorID)
+	tws, err := r.store.ListTeamsWebhookActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	tpws, err := r.store.ListTemplatedWebhookActions(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es))
+
+	actions := make([]*action, 0, len(es)+len(tws)+len(tpws))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -618,7 +844,25 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
-	return &monitorActionConnection{actions: actions, totalCount: totalCount}, nil
+	for _, w := range tws {
+		actions = append(actions, &action{
+			teamsWebhook: &monitorTeamsWebhook{
+				Resolver:           r,
+				TeamsWebhookAction: w,
+				triggerEventID:     triggerEventID,
+			},
+		})
+	}
+	for _, w := range tpws {
+		actions = append(actions, &action{
+			templatedWebhook: &monitorTemplatedWebhook{
+				Resolver:               r,
+				TemplatedWebhookAction: w,
+				triggerEventID:         triggerEventID,
+			},
+		})
+	}
+	return actions, nil
 }
 
 //
@@ -648,6 +892,10 @@ func (q *monitorQuery) Query() string {
 	return q.QueryString
 }
 
+func (q *monitorQuery) Type() string {
+	return string(q.QueryTrigger.Type)
+}
+
 func (q *monitorQuery) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorTriggerEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -765,23 +1013,41 @@ func (a *monitorActionConnection) PageInfo() *graphqlutil.PageInfo {
 		return graphqlutil.HasNextPage(false)
 	}
 	last := a.actions[len(a.actions)-1]
-	if email, ok := last.ToMonitorEmail(); ok {
-		return graphqlutil.NextPageCursor(string(email.ID()))
-	}
-	panic("found non-email monitor action")
+	return graphqlutil.NextPageCursor(string(last.(*action).id()))
 }
 
 //
 // Action <<UNION>>
 //
 type action struct {
-	email graphqlbackend.MonitorEmailResolver
+	email            graphqlbackend.MonitorEmailResolver
+	teamsWebhook     graphqlbackend.MonitorTeamsWebhookResolver
+	templatedWebhook graphqlbackend.MonitorTemplatedWebhookResolver
 }
 
 func (a *action) ToMonitorEmail() (graphqlbackend.MonitorEmailResolver, bool) {
 	return a.email, a.email != nil
 }
 
+func (a *action) ToMonitorTeamsWebhook() (graphqlbackend.MonitorTeamsWebhookResolver, bool) {
+	return a.teamsWebhook, a.teamsWebhook != nil
+}
+
+func (a *action) ToMonitorTemplatedWebhook() (graphqlbackend.MonitorTemplatedWebhookResolver, bool) {
+	return a.templatedWebhook, a.templatedWebhook != nil
+}
+
+func (a *action) id() graphql.ID {
+	switch {
+	case a.email != nil:
+		return a.email.ID()
+	case a.teamsWebhook != nil:
+		return a.teamsWebhook.ID()
+	default:
+		return a.templatedWebhook.ID()
+	}
+}
+
 //
 // Email
 //
@@ -854,35 +1120,118 @@ func (m *monitorEmail) ID() graphql.ID {
 }
 
 func (m *monitorEmail) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
-	after, err := unmarshalAfter(args.After)
+	return m.actionEvents(ctx, cm.ListActionJobsOpts{
+		EmailID:        intPtr(int(m.EmailAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	}, args)
+}
+
+//
+// Teams webhook
+//
+type monitorTeamsWebhook struct {
+	*Resolver
+	*cm.TeamsWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorTeamsWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionTeamsWebhookKind, m.TeamsWebhookAction.ID)
+}
+
+func (m *monitorTeamsWebhook) Enabled() bool {
+	return m.TeamsWebhookAction.Enabled
+}
+
+func (m *monitorTeamsWebhook) URL() string {
+	return m.TeamsWebhookAction.URL
+}
+
+func (m *monitorTeamsWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	return m.actionEvents(ctx, cm.ListActionJobsOpts{
+		TeamsWebhookID: intPtr(int(m.TeamsWebhookAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	}, args)
+}
+
+//
+// Templated webhook
+//
+type monitorTemplatedWebhook struct {
+	*Resolver
+	*cm.TemplatedWebhookAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorTemplatedWebhook) ID() graphql.ID {
+	return relay.MarshalID(monitorActionTemplatedWebhookKind, m.TemplatedWebhookAction.ID)
+}
+
+func (m *monitorTemplatedWebhook) Enabled() bool {
+	return m.TemplatedWebhookAction.Enabled
+}
+
+func (m *monitorTemplatedWebhook) URL() string {
+	return m.TemplatedWebhookAction.URL
+}
+
+func (m *monitorTemplatedWebhook) ContentType() string {
+	return m.TemplatedWebhookAction.ContentType
+}
+
+func (m *monitorTemplatedWebhook) PayloadTemplate() string {
+	return m.TemplatedWebhookAction.PayloadTemplate
+}
+
+func (m *monitorTemplatedWebhook) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	return m.actionEvents(ctx, cm.ListActionJobsOpts{
+		TemplatedWebhookID: intPtr(int(m.TemplatedWebhookAction.ID)),
+		TriggerEventID:     m.triggerEventID,
+	}, args)
+}
+
+// actionEvents returns a page of the action jobs matching opts.
+func (r *Resolver) actionEvents(ctx context.Context, opts cm.ListActionJobsOpts, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	totalCount, err := r.store.CountActionJobs(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	ajs, err := m.store.ListActionJobs(ctx, cm.ListActionJobsOpts{
-		EmailID:        intPtr(int(m.EmailAction.ID)),
-		TriggerEventID: m.triggerEventID,
-		First:          intPtr(int(args.First)),
-		After:          after,
-	})
+	opts.After, err = unmarshalAfter(args.After)
 	if err != nil {
 		return nil, err
 	}
+	opts.First = intPtr(int(args.First))
 
-	totalCount, err := m.store.CountActionJobs(ctx, cm.ListActionJobsOpts{
-		EmailID:        intPtr(int(m.EmailAction.ID)),
-		TriggerEventID: m.triggerEventID,
-	})
+	ajs, err := r.store.ListActionJobs(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
+
 	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
 	for i, aj := range ajs {
-		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+		events[i] = &monitorActionEvent{Resolver: r, ActionJob: aj}
 	}
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+// triggerType returns the trigger type of args. Triggers created before
+// trigger types existed trigger on commits.
+func triggerType(args *graphqlbackend.CreateTriggerArgs) cm.TriggerType {
+	if args.Type == "" {
+		return cm.TriggerTypeCommits
+	}
+	return cm.TriggerType(args.Type)
+}
+
 func intPtr(i int) *int { return &i }
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
@@ -3,6 +3,10 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
@@ -1156,6 +1160,85 @@ func TestTriggerTestEmailAction(t *testing.T) {
 	}
 }
 
+func TestTriggerTestTemplatedWebhookAction(t *testing.T) {
+	var gotBody, gotContentType string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		gotBody, gotContentType = string(b), r.Header.Get("Content-Type")
+		w.WriteHeader(200)
+	}))
+	defer s.Close()
+
+	background.MockExternalURL = func() *url.URL {
+		externalURL, _ := url.Parse("https://www.sourcegraph.com")
+		return externalURL
+	}
+	defer func() { background.MockExternalURL = nil }()
+
+	ctx := actor.WithInternalActor(context.Background())
+	r := newTestResolver(t, nil)
+	namespaceID := relay.MarshalID("User", actor.FromContext(ctx).UID)
+
+	args := &graphqlbackend.TriggerTestTemplatedWebhookActionArgs{
+		Namespace:   namespaceID,
+		Description: "A code monitor name",
+		TemplatedWebhook: &graphqlbackend.CreateActionTemplatedWebhookArgs{
+			Enabled:         true,
+			URL:             s.URL,
+			PayloadTemplate: `{"summary": {{json .Monitor.Description}}, "test": {{.IsTest}}}`,
+		},
+	}
+
+	_, err := r.TriggerTestTemplatedWebhookAction(ctx, args)
+	require.NoError(t, err)
+	require.Equal(t, `{"summary": "A code monitor name", "test": true}`, gotBody)
+	require.Equal(t, "application/json", gotContentType)
+
+	args.TemplatedWebhook.PayloadTemplate = `{{.Monitor.Name}}`
+	_, err = r.TriggerTestTemplatedWebhookAction(ctx, args)
+	require.Error(t, err)
+}
+
+func TestSplitActionIDs(t *testing.T) {
+	emailID := relay.MarshalID(monitorActionEmailKind, 1)
+	teamsWebhookID := relay.MarshalID(monitorActionTeamsWebhookKind, 1)
+	templatedWebhookID := relay.MarshalID(monitorActionTemplatedWebhookKind, 1)
+	actionIDs := []graphql.ID{emailID, teamsWebhookID, templatedWebhookID}
+
+	teamsWebhook := &graphqlbackend.CreateActionTeamsWebhookArgs{Enabled: true, URL: "https://example.com"}
+	args := &graphqlbackend.UpdateCodeMonitorArgs{
+		Actions: []*graphqlbackend.EditActionArgs{
+			{TeamsWebhook: &graphqlbackend.EditActionTeamsWebhookArgs{Id: &teamsWebhookID, Update: teamsWebhook}},
+			{TeamsWebhook: &graphqlbackend.EditActionTeamsWebhookArgs{Update: teamsWebhook}},
+		},
+	}
+
+	toCreate, toDelete, err := splitActionIDs(context.Background(), args, actionIDs)
+	require.NoError(t, err)
+	require.Equal(t, []*graphqlbackend.CreateActionArgs{{TeamsWebhook: teamsWebhook}}, toCreate)
+	require.ElementsMatch(t, []graphql.ID{emailID, templatedWebhookID}, toDelete)
+	require.Len(t, args.Actions, 1)
+
+	t.Run("mismatched kind", func(t *testing.T) {
+		args := &graphqlbackend.UpdateCodeMonitorArgs{
+			Actions: []*graphqlbackend.EditActionArgs{
+				{TeamsWebhook: &graphqlbackend.EditActionTeamsWebhookArgs{Id: &emailID, Update: teamsWebhook}},
+			},
+		}
+		_, _, err := splitActionIDs(context.Background(), args, actionIDs)
+		require.Error(t, err)
+	})
+
+	t.Run("no action type", func(t *testing.T) {
+		args := &graphqlbackend.UpdateCodeMonitorArgs{
+			Actions: []*graphqlbackend.EditActionArgs{{}},
+		}
+		_, _, err := splitActionIDs(context.Background(), args, actionIDs)
+		require.Error(t, err)
+	})
+}
+
 func TestMonitorKindEqualsResolvers(t *testing.T) {
 	got := background.MonitorKind
 	want := MonitorKind
@@ -92,6 +92,7 @@ func (r *Resolver) CreateSearchContext(ctx context.Context, args graphqlbackend.
 			Public:          args.SearchContext.Public,
 			NamespaceUserID: namespaceUserID,
 			NamespaceOrgID:  namespaceOrgID,
+			Query:           deref(args.SearchContext.Query),
 		},
 		repositoryRevisions,
 	)
@@ -121,6 +122,7 @@ func (r *Resolver) UpdateSearchContext(ctx context.Context, args graphqlbackend.
 	updated.Name = args.SearchContext.Name
 	updated.Description = args.SearchContext.Description
 	updated.Public = args.SearchContext.Public
+	updated.Query = deref(args.SearchContext.Query)
 
 	searchContext, err := searchcontexts.UpdateSearchContextWithRepositoryRevisions(
 		ctx,
@@ -382,6 +384,10 @@ func (r *searchContextResolver) ViewerCanManage(ctx context.Context) bool {
 	return !searchcontexts.IsAutoDefinedSearchContext(r.sc) && hasWriteAccess
 }
 
+func (r *searchContextResolver) Query() string {
+	return r.sc.Query
+}
+
 func (r *searchContextResolver) Repositories(ctx context.Context) ([]graphqlbackend.SearchContextRepositoryRevisionsResolver, error) {
 	if searchcontexts.IsAutoDefinedSearchContext(r.sc) {
 		return []graphqlbackend.SearchContextRepositoryRevisionsResolver{}, nil
@@ -434,3 +440,10 @@ func (r *searchContextRepositoryRevisionsResolver) Repository(ctx context.Contex
 func (r *searchContextRepositoryRevisionsResolver) Revisions(ctx context.Context) []string {
 	return r.revisions
 }
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -262,8 +262,9 @@ func requeueIfCloningOrCommitUnknown(ctx context.Context, db database.DB, worker
 }
 
 // withUploadData will invoke the given function with a reader of the upload's raw data. The
-// consumer should expect raw newline-delimited JSON content. If the function returns without
-// an error, the upload file will be deleted.
+// consumer should expect either raw newline-delimited LSIF JSON content or a protobuf-encoded
+// SCIP index (see conversion.Read). If the function returns without an error, the upload file
+// will be deleted.
 func withUploadData(ctx context.Context, uploadStore uploadstore.Store, id int, trace observation.TraceLogger, fn func(r io.Reader) error) error {
 	uploadFilename := fmt.Sprintf("upload-%d.lsif.gz", id)
 
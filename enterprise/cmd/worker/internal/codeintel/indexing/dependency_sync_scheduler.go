@@ -22,6 +22,8 @@ import (
 
 var schemeToExternalService = map[string]string{
 	"semanticdb": extsvc.KindJVMPackages,
+	"npm":        extsvc.KindNpmPackages,
+	"gomod":      extsvc.KindGoModules,
 }
 
 // NewDependencySyncScheduler returns a new worker instance that processes
@@ -95,8 +97,8 @@ func (h *dependencySyncSchedulerHandler) Handle(ctx context.Context, record work
 		}
 
 		extsvcKind, ok := schemeToExternalService[packageReference.Scheme]
-		// add entry for empty string/kind here so dependencies such as lsif-go ones still get
-		// an associated dependency indexing job
+		// add entry for empty string/kind here so dependencies without a package host
+		// still get an associated dependency indexing job
 		kinds[extsvcKind] = struct{}{}
 		if !ok {
 			continue
@@ -91,18 +91,17 @@ func TestDependencySyncSchedulerGomod(t *testing.T) {
 			kinds = append(kinds, call.Arg2)
 		}
 
-		expectedKinds := []string{""}
-
+		expectedKinds := []string{extsvc.KindGoModules}
 		if diff := cmp.Diff(expectedKinds, kinds); diff != "" {
 			t.Errorf("unexpected kinds (-want +got):\n%s", diff)
 		}
 	}
 
-	if len(mockExtsvcStore.ListFunc.History()) != 0 {
-		t.Errorf("unexpected number of calls to extsvc.List. want=%d have=%d", 0, len(mockExtsvcStore.ListFunc.History()))
+	if len(mockExtsvcStore.ListFunc.History()) != 1 {
+		t.Errorf("unexpected number of calls to extsvc.List. want=%d have=%d", 1, len(mockExtsvcStore.ListFunc.History()))
 	}
 
-	if len(mockDBStore.InsertCloneableDependencyRepoFunc.History()) != 0 {
-		t.Errorf("unexpected number of calls to InsertCloneableDependencyRepo. want=%d have=%d", 0, len(mockDBStore.InsertCloneableDependencyRepoFunc.History()))
+	if len(mockDBStore.InsertCloneableDependencyRepoFunc.History()) != 1 {
+		t.Errorf("unexpected number of calls to InsertCloneableDependencyRepo. want=%d have=%d", 1, len(mockDBStore.InsertCloneableDependencyRepoFunc.History()))
 	}
 }
@@ -30,6 +30,7 @@ func Routines(ctx context.Context, batchesStore *store.Store, cf *httpcli.Factor
 		newCacheEntryCleanerJob(ctx, batchesStore),
 
 		scheduler.NewScheduler(ctx, batchesStore),
+		scheduler.NewWaitingChangesetReleaser(ctx, batchesStore),
 
 		newBulkOperationWorker(ctx, batchesStore, bulkProcessorWorkerStore, sourcer, metrics),
 		newBulkOperationWorkerResetter(bulkProcessorWorkerStore, metrics),
@@ -17,7 +17,6 @@ import (
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution/cache"
-	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 )
 
 // batchSpecWorkspaceCreator takes in BatchSpecs, resolves them into
@@ -107,41 +106,11 @@ func (r *batchSpecWorkspaceCreator) process(
 			continue
 		}
 
-		r := batcheslib.Repository{
-			ID:          string(graphqlbackend.MarshalRepositoryID(w.Repo.ID)),
-			Name:        string(w.Repo.Name),
-			BaseRef:     w.Branch,
-			BaseRev:     string(w.Commit),
-			FileMatches: w.FileMatches,
-		}
-
-		key := cache.KeyForWorkspace(
-			&template.BatchChangeAttributes{
-				Name:        spec.Spec.Name,
-				Description: spec.Spec.Description,
-			},
-			r,
-			w.Path,
-			w.OnlyFetchWorkspace,
-			w.Steps,
-		)
-
-		rawKey, err := key.Key()
+		r, rawKey, stepCacheKeys, err := service.WorkspaceCacheKeys(spec, w.Repo, workspace)
 		if err != nil {
 			return err
 		}
 
-		stepCacheKeys := make([]string, 0, len(workspace.Steps))
-		// Generate cache keys for all the step results as well.
-		for i := 0; i < len(workspace.Steps)-1; i++ {
-			key := cache.StepsCacheKey{ExecutionKey: &key, StepIndex: i}
-			rawStepKey, err := key.Key()
-			if err != nil {
-				return nil
-			}
-			stepCacheKeys = append(stepCacheKeys, rawStepKey)
-		}
-
 		cacheKeyWorkspaces[rawKey] = struct {
 			dbWorkspace   *btypes.BatchSpecWorkspace
 			repo          batcheslib.Repository
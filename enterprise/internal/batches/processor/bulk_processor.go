@@ -101,6 +101,8 @@ func (b *bulkProcessor) Process(ctx context.Context, job *btypes.ChangesetJob) (
 		return b.closeChangeset(ctx, job)
 	case btypes.ChangesetJobTypePublish:
 		return b.publishChangeset(ctx, job)
+	case btypes.ChangesetJobTypeRebase:
+		return b.rebaseChangeset(ctx, job)
 
 	default:
 		return &unknownJobTypeErr{jobType: string(job.JobType)}
@@ -266,3 +268,12 @@ func (b *bulkProcessor) publishChangeset(ctx context.Context, job *btypes.Change
 
 	return nil
 }
+
+func (b *bulkProcessor) rebaseChangeset(ctx context.Context, job *btypes.ChangesetJob) error {
+	svc := service.New(b.tx)
+	err := svc.RebaseChangeset(ctx, b.ch)
+	if errors.Is(err, service.ErrRebaseImportedChangeset) || errors.Is(err, service.ErrRebaseNotExecutedServerSide) {
+		return errcode.MakeNonRetryable(err)
+	}
+	return err
+}
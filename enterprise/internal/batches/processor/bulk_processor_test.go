@@ -10,11 +10,14 @@ import (
 	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
 )
 
 func TestBulkProcessor(t *testing.T) {
@@ -357,4 +360,139 @@ func TestBulkProcessor(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("Rebase job", func(t *testing.T) {
+		bp := &bulkProcessor{
+			tx:      bstore,
+			sourcer: sources.NewFakeSourcer(nil, &sources.FakeChangesetSource{}),
+		}
+
+		t.Run("errors", func(t *testing.T) {
+			for name, tc := range map[string]struct {
+				spec      *ct.TestSpecOpts
+				changeset ct.TestChangesetOpts
+			}{
+				"imported changeset": {
+					spec: nil,
+					changeset: ct.TestChangesetOpts{
+						Repo:            repo.ID,
+						BatchChange:     batchChange.ID,
+						ReconcilerState: btypes.ReconcilerStateCompleted,
+					},
+				},
+				"not executed server-side": {
+					spec: &ct.TestSpecOpts{
+						User:      user.ID,
+						Repo:      repo.ID,
+						BatchSpec: batchSpec.ID,
+						HeadRef:   "not-executed",
+					},
+					changeset: ct.TestChangesetOpts{
+						Repo:            repo.ID,
+						BatchChange:     batchChange.ID,
+						ReconcilerState: btypes.ReconcilerStateCompleted,
+					},
+				},
+			} {
+				t.Run(name, func(t *testing.T) {
+					if tc.spec != nil {
+						tc.changeset.CurrentSpec = ct.CreateChangesetSpec(t, ctx, bstore, *tc.spec).ID
+					}
+					changeset := ct.CreateChangeset(t, ctx, bstore, tc.changeset)
+
+					job := &types.ChangesetJob{
+						JobType:       types.ChangesetJobTypeRebase,
+						BatchChangeID: batchChange.ID,
+						ChangesetID:   changeset.ID,
+						UserID:        user.ID,
+						Payload:       &types.ChangesetJobRebasePayload{},
+					}
+
+					if err := bp.Process(ctx, job); err == nil {
+						t.Error("unexpected nil error")
+					} else if !errcode.IsNonRetryable(err) {
+						t.Errorf("error is retryable: %v", err)
+					}
+				})
+			}
+		})
+
+		t.Run("success", func(t *testing.T) {
+			spec := ct.CreateChangesetSpec(t, ctx, bstore, ct.TestSpecOpts{
+				User:      user.ID,
+				Repo:      repo.ID,
+				BatchSpec: batchSpec.ID,
+				HeadRef:   "rebase-me",
+				BaseRev:   "d34db33f",
+			})
+			changeset := ct.CreateChangeset(t, ctx, bstore, ct.TestChangesetOpts{
+				Repo:            repo.ID,
+				BatchChange:     batchChange.ID,
+				CurrentSpec:     spec.ID,
+				ReconcilerState: btypes.ReconcilerStateCompleted,
+			})
+			workspace := &btypes.BatchSpecWorkspace{
+				BatchSpecID:      batchSpec.ID,
+				ChangesetSpecIDs: []int64{spec.ID},
+				RepoID:           repo.ID,
+				Branch:           "refs/heads/main",
+				Commit:           "d34db33f",
+				Steps:            []batcheslib.Step{{Run: "echo 1", Container: "alpine:3"}},
+			}
+			if err := bstore.CreateBatchSpecWorkspace(ctx, workspace); err != nil {
+				t.Fatal(err)
+			}
+
+			git.Mocks.ResolveRevision = func(spec string, opt git.ResolveRevisionOptions) (api.CommitID, error) {
+				return "c0ffee", nil
+			}
+			t.Cleanup(git.ResetMocks)
+
+			job := &types.ChangesetJob{
+				JobType:       types.ChangesetJobTypeRebase,
+				BatchChangeID: batchChange.ID,
+				ChangesetID:   changeset.ID,
+				UserID:        user.ID,
+				Payload:       &types.ChangesetJobRebasePayload{},
+			}
+			if err := bp.Process(ctx, job); err != nil {
+				t.Fatal(err)
+			}
+
+			jobs, err := bstore.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{
+				RebaseChangesetID: changeset.ID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) != 1 {
+				t.Fatalf("unexpected number of execution jobs: %d", len(jobs))
+			}
+
+			rebased, err := bstore.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: jobs[0].BatchSpecWorkspaceID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have, want := rebased.Commit, "c0ffee"; have != want {
+				t.Errorf("unexpected commit: have=%q want=%q", have, want)
+			}
+			if have, want := rebased.RebaseChangesetID, changeset.ID; have != want {
+				t.Errorf("unexpected rebase changeset ID: have=%d want=%d", have, want)
+			}
+
+			// A second rebase while the first one is queued is a noop.
+			if err := bp.Process(ctx, job); err != nil {
+				t.Fatal(err)
+			}
+			jobs, err = bstore.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{
+				RebaseChangesetID: changeset.ID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) != 1 {
+				t.Fatalf("unexpected number of execution jobs: %d", len(jobs))
+			}
+		})
+	})
 }
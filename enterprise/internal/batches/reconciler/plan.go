@@ -102,6 +102,16 @@ type Plan struct {
 func (p *Plan) AddOp(op btypes.ReconcilerOperation) { p.Ops = append(p.Ops, op) }
 func (p *Plan) SetOp(op btypes.ReconcilerOperation) { p.Ops = Operations{op} }
 
+// Publishes returns true if the plan publishes the changeset on the code host.
+func (p *Plan) Publishes() bool {
+	for _, op := range p.Ops {
+		if op == btypes.ReconcilerOperationPublish || op == btypes.ReconcilerOperationPublishDraft {
+			return true
+		}
+	}
+	return false
+}
+
 // DeterminePlan looks at the given changeset to determine what action the
 // reconciler should take.
 // It consumes the current and the previous changeset spec, if they exist. If
@@ -279,6 +289,21 @@ func compareChangesetSpecs(previous, current *btypes.ChangesetSpec, uiPublicatio
 	if previous.Spec.BaseRef != current.Spec.BaseRef {
 		delta.BaseRefChanged = true
 	}
+	// Sources leave the code host metadata untouched when the spec has no
+	// value for it, so removing all values from the spec is not a change we
+	// can apply.
+	if len(current.Spec.Labels) > 0 && !equalStringSets(previous.Spec.Labels, current.Spec.Labels) {
+		delta.LabelsChanged = true
+	}
+	if len(current.Spec.Reviewers) > 0 && !equalStringSets(previous.Spec.Reviewers, current.Spec.Reviewers) {
+		delta.ReviewersChanged = true
+	}
+	if len(current.Spec.Assignees) > 0 && !equalStringSets(previous.Spec.Assignees, current.Spec.Assignees) {
+		delta.AssigneesChanged = true
+	}
+	if current.Spec.Milestone != "" && previous.Spec.Milestone != current.Spec.Milestone {
+		delta.MilestoneChanged = true
+	}
 
 	// If was set to "draft" and now "true", need to undraft the changeset.
 	// We currently ignore going from "true" to "draft".
@@ -348,6 +373,10 @@ type ChangesetSpecDelta struct {
 	BodyChanged          bool
 	Undraft              bool
 	BaseRefChanged       bool
+	LabelsChanged        bool
+	ReviewersChanged     bool
+	AssigneesChanged     bool
+	MilestoneChanged     bool
 	DiffChanged          bool
 	CommitMessageChanged bool
 	AuthorNameChanged    bool
@@ -361,9 +390,35 @@ func (d *ChangesetSpecDelta) NeedCommitUpdate() bool {
 }
 
 func (d *ChangesetSpecDelta) NeedCodeHostUpdate() bool {
-	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged
+	return d.TitleChanged || d.BodyChanged || d.BaseRefChanged || d.CodeHostMetadataChanged()
+}
+
+// CodeHostMetadataChanged returns true if the labels, reviewers, assignees or
+// milestone of the changeset changed.
+func (d *ChangesetSpecDelta) CodeHostMetadataChanged() bool {
+	return d.LabelsChanged || d.ReviewersChanged || d.AssigneesChanged || d.MilestoneChanged
 }
 
 func (d *ChangesetSpecDelta) AttributesChanged() bool {
 	return d.NeedCommitUpdate() || d.NeedCodeHostUpdate()
 }
+
+// equalStringSets returns true if a and b contain the same strings, ignoring
+// their order.
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	set := make(map[string]int, len(a))
+	for _, s := range a {
+		set[s]++
+	}
+	for _, s := range b {
+		if set[s] == 0 {
+			return false
+		}
+		set[s]--
+	}
+	return true
+}
@@ -206,6 +206,60 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 			},
 			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
 		},
+		{
+			name:         "labels changed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, Labels: []string{"a", "b"}},
+			currentSpec:  &ct.TestSpecOpts{Published: true, Labels: []string{"a", "c"}},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:         "labels reordered on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, Labels: []string{"a", "b"}},
+			currentSpec:  &ct.TestSpecOpts{Published: true, Labels: []string{"b", "a"}},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "reviewers and assignees changed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true},
+			currentSpec:  &ct.TestSpecOpts{Published: true, Reviewers: []string{"alice"}, Assignees: []string{"bob"}},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
+		{
+			name:         "labels and reviewers removed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, Labels: []string{"a"}, Reviewers: []string{"alice"}},
+			currentSpec:  &ct.TestSpecOpts{Published: true},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "milestone removed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, Milestone: "v1"},
+			currentSpec:  &ct.TestSpecOpts{Published: true},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{},
+		},
+		{
+			name:         "milestone changed on published changeset",
+			previousSpec: &ct.TestSpecOpts{Published: true, Milestone: "v1"},
+			currentSpec:  &ct.TestSpecOpts{Published: true, Milestone: "v2"},
+			changeset: ct.TestChangesetOpts{
+				PublicationState: btypes.ChangesetPublicationStatePublished,
+			},
+			wantOperations: Operations{btypes.ReconcilerOperationUpdate},
+		},
 		{
 			name:         "commit diff changed on published changeset",
 			previousSpec: &ct.TestSpecOpts{Published: true, CommitDiff: "testDiff"},
@@ -420,6 +474,25 @@ func TestDetermineReconcilerPlan(t *testing.T) {
 	}
 }
 
+func TestPlan_Publishes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		ops  Operations
+		want bool
+	}{
+		"no operations": {ops: Operations{}, want: false},
+		"publish":       {ops: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublish}, want: true},
+		"publish draft": {ops: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationPublishDraft}, want: true},
+		"update":        {ops: Operations{btypes.ReconcilerOperationPush, btypes.ReconcilerOperationUpdate}, want: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := &Plan{Ops: tc.ops}
+			if have := plan.Publishes(); have != tc.want {
+				t.Errorf("unexpected result: have=%t want=%t", have, tc.want)
+			}
+		})
+	}
+}
+
 func uiPublicationStatePtr(state btypes.ChangesetUiPublicationState) *btypes.ChangesetUiPublicationState {
 	return &state
 }
@@ -79,6 +79,21 @@ func (r *Reconciler) process(ctx context.Context, tx *store.Store, ch *btypes.Ch
 		return err
 	}
 
+	// Changesets in the later stages of a batch spec are only published once
+	// all changesets in the previous stages are merged. Until then, they're
+	// marked as waiting and enqueued again by the scheduler.
+	if plan.Publishes() {
+		waiting, err := tx.IsWaitingForUpstreamChangesets(ctx, ch)
+		if err != nil {
+			return err
+		}
+		if waiting {
+			log15.Info("Reconciler holding back changeset until upstream changesets are merged", "changeset", ch.ID)
+			ch.ReconcilerState = btypes.ReconcilerStateWaiting
+			return tx.UpdateChangeset(ctx, ch)
+		}
+	}
+
 	log15.Info("Reconciler processing changeset", "changeset", ch.ID, "operations", plan.Ops)
 
 	return executePlan(
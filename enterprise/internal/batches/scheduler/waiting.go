@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/global"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+)
+
+const waitingChangesetsInterval = 1 * time.Minute
+
+// NewWaitingChangesetReleaser returns a background routine that periodically
+// enqueues the changesets that are waiting for their upstream changesets to
+// be merged, once they are.
+func NewWaitingChangesetReleaser(ctx context.Context, bstore *store.Store) goroutine.BackgroundRoutine {
+	return goroutine.NewPeriodicGoroutine(
+		ctx,
+		waitingChangesetsInterval,
+		goroutine.NewHandlerWithErrorMessage("release waiting changesets", func(ctx context.Context) error {
+			return releaseWaitingChangesets(ctx, bstore)
+		}),
+	)
+}
+
+func releaseWaitingChangesets(ctx context.Context, bstore *store.Store) error {
+	cs, _, err := bstore.ListChangesets(ctx, store.ListChangesetsOpts{
+		ReconcilerStates: []btypes.ReconcilerState{btypes.ReconcilerStateWaiting},
+	})
+	if err != nil {
+		return errors.Wrap(err, "listing waiting changesets")
+	}
+
+	for _, c := range cs {
+		if err := releaseWaitingChangeset(ctx, bstore, c.ID); err != nil {
+			log15.Warn("error releasing waiting changeset", "changeset", c.ID, "err", err)
+		}
+	}
+	return nil
+}
+
+func releaseWaitingChangeset(ctx context.Context, bstore *store.Store, id int64) (err error) {
+	tx, err := bstore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	// The changeset might have been updated since we listed it, so we reload
+	// it in the transaction.
+	c, err := tx.GetChangeset(ctx, store.GetChangesetOpts{ID: id})
+	if err != nil {
+		return err
+	}
+	if c.ReconcilerState != btypes.ReconcilerStateWaiting {
+		return nil
+	}
+
+	waiting, err := tx.IsWaitingForUpstreamChangesets(ctx, c)
+	if err != nil || waiting {
+		return err
+	}
+
+	c.ResetReconcilerState(global.DefaultReconcilerEnqueueState())
+	return tx.UpdateChangeset(ctx, c)
+}
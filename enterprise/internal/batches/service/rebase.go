@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/cockroachdb/errors"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+)
+
+// ErrRebaseImportedChangeset is returned by RebaseChangeset if the changeset
+// was imported and hence has no workspace that could be re-executed.
+var ErrRebaseImportedChangeset = errors.New("cannot rebase an imported changeset")
+
+// ErrRebaseNotExecutedServerSide is returned by RebaseChangeset if the current
+// spec of the changeset wasn't produced by a server-side execution.
+var ErrRebaseNotExecutedServerSide = errors.New("cannot rebase a changeset that was not created by a server-side execution")
+
+// RebaseChangeset re-executes the workspace that produced the current spec of
+// the given changeset against the latest revision of its base branch. Cached
+// step results for the new revision are reused. Once the execution completes,
+// the changeset is updated to the resulting changeset spec and the reconciler
+// force-pushes the new commit.
+//
+// Nothing is done if the changeset is already based on the latest revision or
+// if a rebase for it is still queued or processing.
+func (s *Service) RebaseChangeset(ctx context.Context, changeset *btypes.Changeset) (err error) {
+	ctx, endObservation := s.operations.rebaseChangeset.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("changesetID", int(changeset.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if changeset.CurrentSpecID == 0 {
+		return ErrRebaseImportedChangeset
+	}
+
+	spec, err := s.store.GetChangesetSpecByID(ctx, changeset.CurrentSpecID)
+	if err != nil {
+		return errors.Wrap(err, "loading changeset spec")
+	}
+
+	workspace, err := s.store.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ChangesetSpecID: spec.ID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return ErrRebaseNotExecutedServerSide
+		}
+		return errors.Wrap(err, "loading batch spec workspace")
+	}
+
+	batchSpec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: workspace.BatchSpecID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch spec")
+	}
+
+	// 🚨 SECURITY: We use database.Repos.Get to check whether the user has access to
+	// the repository or not.
+	repo, err := s.store.Repos().Get(ctx, workspace.RepoID)
+	if err != nil {
+		return errors.Wrap(err, "loading repo")
+	}
+
+	// Resolving the revision talks to gitserver, so we do it before opening
+	// the transaction.
+	commit, err := git.ResolveRevision(ctx, repo.Name, workspace.Branch, git.ResolveRevisionOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "resolving base branch %q", workspace.Branch)
+	}
+	if string(commit) == spec.Spec.BaseRev {
+		return nil
+	}
+
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	jobs, err := tx.ListBatchSpecWorkspaceExecutionJobs(ctx, store.ListBatchSpecWorkspaceExecutionJobsOpts{
+		RebaseChangesetID: changeset.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "loading rebase executions")
+	}
+	for _, j := range jobs {
+		if j.State == btypes.BatchSpecWorkspaceExecutionJobStateQueued || j.State == btypes.BatchSpecWorkspaceExecutionJobStateProcessing {
+			return nil
+		}
+	}
+
+	rebased := &btypes.BatchSpecWorkspace{
+		BatchSpecID:      workspace.BatchSpecID,
+		ChangesetSpecIDs: []int64{},
+
+		RepoID:             workspace.RepoID,
+		Branch:             workspace.Branch,
+		Commit:             string(commit),
+		Path:               workspace.Path,
+		FileMatches:        workspace.FileMatches,
+		OnlyFetchWorkspace: workspace.OnlyFetchWorkspace,
+		Steps:              workspace.Steps,
+
+		RebaseChangesetID: changeset.ID,
+	}
+
+	if !batchSpec.NoCache {
+		if err := loadStepCacheResults(ctx, tx, batchSpec, repo, rebased); err != nil {
+			return errors.Wrap(err, "loading cached step results")
+		}
+	}
+
+	if err := tx.CreateBatchSpecWorkspace(ctx, rebased); err != nil {
+		return errors.Wrap(err, "creating batch spec workspace")
+	}
+
+	return tx.CreateBatchSpecWorkspaceExecutionJobsForWorkspaces(ctx, []int64{rebased.ID})
+}
+
+// loadStepCacheResults sets the step cache results found for the given
+// workspace, up until the first step that has no cached result.
+func loadStepCacheResults(ctx context.Context, tx *store.Store, batchSpec *btypes.BatchSpec, repo *types.Repo, workspace *btypes.BatchSpecWorkspace) error {
+	_, _, stepCacheKeys, err := WorkspaceCacheKeys(batchSpec, repo, workspace)
+	if err != nil {
+		return err
+	}
+	if len(stepCacheKeys) == 0 {
+		return nil
+	}
+
+	entries, err := tx.ListBatchSpecExecutionCacheEntries(ctx, store.ListBatchSpecExecutionCacheEntriesOpts{
+		UserID: batchSpec.UserID,
+		Keys:   stepCacheKeys,
+	})
+	if err != nil {
+		return err
+	}
+	entriesByKey := make(map[string]*btypes.BatchSpecExecutionCacheEntry, len(entries))
+	for _, e := range entries {
+		entriesByKey[e.Key] = e
+	}
+
+	for i, rawKey := range stepCacheKeys {
+		entry, ok := entriesByKey[rawKey]
+		if !ok {
+			break
+		}
+		var res execution.AfterStepResult
+		if err := json.Unmarshal([]byte(entry.Value), &res); err != nil {
+			return err
+		}
+		workspace.SetStepCacheResult(i+1, btypes.StepCacheResult{Key: rawKey, Value: &res})
+	}
+
+	return nil
+}
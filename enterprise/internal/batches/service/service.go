@@ -78,6 +78,7 @@ type operations struct {
 	deleteBatchChange                    *observation.Operation
 	enqueueChangesetSync                 *observation.Operation
 	reenqueueChangeset                   *observation.Operation
+	rebaseChangeset                      *observation.Operation
 	checkNamespaceAccess                 *observation.Operation
 	fetchUsernameForBitbucketServerToken *observation.Operation
 	validateAuthenticator                *observation.Operation
@@ -128,6 +129,7 @@ func newOperations(observationContext *observation.Context) *operations {
 			deleteBatchChange:                    op("DeleteBatchChange"),
 			enqueueChangesetSync:                 op("EnqueueChangesetSync"),
 			reenqueueChangeset:                   op("ReenqueueChangeset"),
+			rebaseChangeset:                      op("RebaseChangeset"),
 			checkNamespaceAccess:                 op("CheckNamespaceAccess"),
 			fetchUsernameForBitbucketServerToken: op("FetchUsernameForBitbucketServerToken"),
 			validateAuthenticator:                op("ValidateAuthenticator"),
@@ -197,5 +197,6 @@ func (s *Service) ReconcileBatchChange(
 	batchChange.LastApplierID = a.UID
 	batchChange.LastAppliedAt = s.clock()
 	batchChange.Description = batchSpec.Spec.Description
+	batchChange.AutoRebase = batchSpec.Spec.AutoRebase
 	return batchChange, previousSpecID, nil
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution/cache"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
+)
+
+// WorkspaceCacheKeys returns the repository the given workspace of the batch
+// spec is executed in, the cache key of the result of the whole execution, and
+// the cache keys of the results of all steps but the last one.
+func WorkspaceCacheKeys(batchSpec *btypes.BatchSpec, repo *types.Repo, workspace *btypes.BatchSpecWorkspace) (r batcheslib.Repository, key string, stepKeys []string, err error) {
+	r = batcheslib.Repository{
+		ID:          string(graphqlbackend.MarshalRepositoryID(repo.ID)),
+		Name:        string(repo.Name),
+		BaseRef:     workspace.Branch,
+		BaseRev:     workspace.Commit,
+		FileMatches: workspace.FileMatches,
+	}
+
+	executionKey := cache.KeyForWorkspace(
+		&template.BatchChangeAttributes{
+			Name:        batchSpec.Spec.Name,
+			Description: batchSpec.Spec.Description,
+		},
+		r,
+		workspace.Path,
+		workspace.OnlyFetchWorkspace,
+		workspace.Steps,
+	)
+
+	key, err = executionKey.Key()
+	if err != nil {
+		return r, "", nil, err
+	}
+
+	stepKeys = make([]string, 0, len(workspace.Steps))
+	for i := 0; i < len(workspace.Steps)-1; i++ {
+		stepKey := cache.StepsCacheKey{ExecutionKey: &executionKey, StepIndex: i}
+		rawStepKey, err := stepKey.Key()
+		if err != nil {
+			return r, "", nil, err
+		}
+		stepKeys = append(stepKeys, rawStepKey)
+	}
+
+	return r, key, stepKeys, nil
+}
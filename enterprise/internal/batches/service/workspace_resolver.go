@@ -7,6 +7,7 @@ import (
 	"path"
 	"regexp"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -141,6 +142,10 @@ func (wr *workspaceResolver) determineRepositories(ctx context.Context, batchSpe
 			continue
 		}
 
+		// Explicitly listed branches take precedence over the base branch
+		// configured in the changeset template.
+		branches, _ := on.GetBranches()
+
 		result := agg.NewRuleRevisions(ruleType)
 		for _, rev := range revs {
 			// Skip repos where no branch exists.
@@ -148,6 +153,14 @@ func (wr *workspaceResolver) determineRepositories(ctx context.Context, batchSpe
 				continue
 			}
 
+			if len(branches) == 0 {
+				rev, err = resolveBaseBranch(ctx, batchSpec, rev)
+				if err != nil {
+					errs = multierror.Append(errs, errors.Wrapf(err, "resolving %q", on.String()))
+					continue
+				}
+			}
+
 			result.AddRepoRevision(rev.Repo.ID, rev)
 		}
 	}
@@ -432,6 +445,46 @@ func repoToRepoRevisionWithDefaultBranch(ctx context.Context, repo *types.Repo,
 	return repoRev, nil
 }
 
+// resolveBaseBranch returns the revision of the base branch configured for the
+// repository in the changeset template of the batch spec, so that the steps
+// run on the branch the changeset will be merged into. If no base branch is
+// configured, rev is returned.
+func resolveBaseBranch(ctx context.Context, batchSpec *batcheslib.BatchSpec, rev *RepoRevision) (_ *RepoRevision, err error) {
+	if batchSpec.ChangesetTemplate == nil || batchSpec.ChangesetTemplate.BaseBranch == nil {
+		return rev, nil
+	}
+
+	// Rules with an @branch suffix depend on the changeset branch, which is
+	// only known after the steps ran, so they can't be applied here.
+	branch := batchSpec.ChangesetTemplate.BaseBranch.ValueWithSuffix(string(rev.Repo.Name), "")
+	if branch == "" || strings.TrimPrefix(branch, "refs/heads/") == strings.TrimPrefix(rev.Branch, "refs/heads/") {
+		return rev, nil
+	}
+
+	tr, ctx := trace.New(ctx, "resolveBaseBranch", "")
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	commit, err := git.ResolveRevision(ctx, rev.Repo.Name, branch, git.ResolveRevisionOptions{
+		NoEnsureRevision: true,
+	})
+	if err != nil {
+		if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) {
+			return nil, fmt.Errorf("no base branch matching %q found for repository %s", branch, rev.Repo.Name)
+		}
+		return nil, err
+	}
+
+	return &RepoRevision{
+		Repo:        rev.Repo,
+		Branch:      branch,
+		Commit:      commit,
+		FileMatches: rev.FileMatches,
+	}, nil
+}
+
 func hasBatchIgnoreFile(ctx context.Context, r *RepoRevision) (_ bool, err error) {
 	traceTitle := fmt.Sprintf("RepoID: %q", r.Repo.ID)
 	tr, ctx := trace.New(ctx, "hasBatchIgnoreFile", traceTitle)
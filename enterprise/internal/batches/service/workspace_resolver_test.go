@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io/fs"
 	"net/http"
@@ -25,6 +26,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/util"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/overridable"
 )
 
 func TestSetDefaultQueryCount(t *testing.T) {
@@ -361,6 +363,46 @@ func TestService_ResolveWorkspacesForBatchSpec(t *testing.T) {
 		want := []*RepoWorkspace{ws0, ws1}
 		resolveWorkspacesAndCompare(t, s, u, searchMatches, batchSpec, want)
 	})
+
+	t.Run("changeset template base branch", func(t *testing.T) {
+		var baseBranch overridable.String
+		if err := json.Unmarshal([]byte(fmt.Sprintf(`[{%q: "release"}, {"*@other-branch": "develop"}]`, rs[0].Name)), &baseBranch); err != nil {
+			t.Fatal(err)
+		}
+		batchSpec := &batcheslib.BatchSpec{
+			On: []batcheslib.OnQueryOrRepository{
+				{Repository: string(rs[0].Name)},
+				// Explicit branches take precedence over the base branch.
+				{Repository: string(rs[1].Name), Branch: "non-default-branch"},
+				// Rules with a branch suffix are ignored.
+				{Repository: string(rs[2].Name)},
+			},
+			Steps:             steps,
+			ChangesetTemplate: &batcheslib.ChangesetTemplate{BaseBranch: &baseBranch},
+		}
+
+		mockResolveRevision(t, map[string]api.CommitID{
+			defaultBranches[rs[0].Name].branch: defaultBranches[rs[0].Name].commit,
+			"release":                          api.CommitID("5e1ea5e"),
+			"non-default-branch":               api.CommitID("d34db33f"),
+			defaultBranches[rs[2].Name].branch: defaultBranches[rs[2].Name].commit,
+		})
+
+		mockBatchIgnores(t, map[api.CommitID]bool{
+			api.CommitID("5e1ea5e"):            false,
+			api.CommitID("d34db33f"):           false,
+			defaultBranches[rs[2].Name].commit: false,
+		})
+
+		searchMatches := []streamhttp.EventMatch{}
+
+		want := []*RepoWorkspace{
+			buildRepoWorkspace(rs[0], "release", "5e1ea5e", []string{}),
+			buildRepoWorkspace(rs[1], "non-default-branch", "d34db33f", []string{}),
+			buildRepoWorkspace(rs[2], "", "", []string{}),
+		}
+		resolveWorkspacesAndCompare(t, s, u, searchMatches, batchSpec, want)
+	})
 }
 
 func resolveWorkspacesAndCompare(t *testing.T, s *store.Store, u *types.User, matches []streamhttp.EventMatch, spec *batcheslib.BatchSpec, want []*RepoWorkspace) {
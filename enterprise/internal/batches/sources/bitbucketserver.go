@@ -104,6 +104,9 @@ func (s BitbucketServerSource) CreateChangeset(ctx context.Context, c *Changeset
 	repo := c.Repo.Metadata.(*bitbucketserver.Repo)
 
 	pr := &bitbucketserver.PullRequest{Title: c.Title, Description: c.Body}
+	for _, name := range c.Reviewers {
+		pr.Reviewers = append(pr.Reviewers, bitbucketserver.Reviewer{User: &bitbucketserver.User{Name: name}})
+	}
 
 	pr.ToRef.Repository.Slug = repo.Slug
 	pr.ToRef.Repository.ID = repo.ID
@@ -220,6 +223,25 @@ func (s BitbucketServerSource) UpdateChangeset(ctx context.Context, c *Changeset
 	update.ToRef.Repository.Slug = pr.ToRef.Repository.Slug
 	update.ToRef.Repository.Project.Key = pr.ToRef.Repository.Project.Key
 
+	// Bitbucket Server replaces all reviewers on update, so we keep the
+	// existing ones and only add the missing reviewers. Labels, assignees and
+	// milestones aren't supported by Bitbucket Server.
+	if len(c.Reviewers) > 0 {
+		seen := make(map[string]bool)
+		for _, r := range pr.Reviewers {
+			if r.User != nil && !seen[r.User.Name] {
+				seen[r.User.Name] = true
+				update.Reviewers = append(update.Reviewers, r.User.Name)
+			}
+		}
+		for _, name := range c.Reviewers {
+			if !seen[name] {
+				seen[name] = true
+				update.Reviewers = append(update.Reviewers, name)
+			}
+		}
+	}
+
 	updated, err := s.client.UpdatePullRequest(ctx, update)
 	if err != nil {
 		return err
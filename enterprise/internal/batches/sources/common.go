@@ -97,6 +97,14 @@ type Changeset struct {
 	HeadRef string
 	BaseRef string
 
+	// Labels, Reviewers, Assignees and Milestone are applied to the changeset
+	// on the code host, if the source supports it. Sources ignore empty values,
+	// so that metadata set on the code host by other means is left untouched.
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+	Milestone string
+
 	*btypes.Changeset
 	*types.Repo
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -140,6 +141,15 @@ func (s GithubSource) createChangeset(ctx context.Context, c *Changeset, prInput
 		exists = true
 	}
 
+	if md := pullRequestMetadata(c); !md.IsEmpty() {
+		// Labels, assignees, reviewers and milestones can't be set when
+		// creating a pull request, so we need to update it right away.
+		pr, err = s.updatePullRequest(ctx, c, pr)
+		if err != nil {
+			return exists, errors.Wrap(err, "setting pull request metadata")
+		}
+	}
+
 	if err := c.SetMetadata(pr); err != nil {
 		return false, errors.Wrap(err, "setting changeset metadata")
 	}
@@ -212,18 +222,88 @@ func (s GithubSource) UpdateChangeset(ctx context.Context, c *Changeset) error {
 		return errors.New("Changeset is not a GitHub pull request")
 	}
 
-	updated, err := s.client.UpdatePullRequest(ctx, &github.UpdatePullRequestInput{
+	updated, err := s.updatePullRequest(ctx, c, pr)
+	if err != nil {
+		return err
+	}
+
+	return c.Changeset.SetMetadata(updated)
+}
+
+// updatePullRequest updates the title, body and base ref of the given pull
+// request and applies the labels, assignees and milestone of the changeset, if
+// there are any. Reviews are only requested from reviewers that are new to the
+// pull request.
+func (s GithubSource) updatePullRequest(ctx context.Context, c *Changeset, pr *github.PullRequest) (*github.PullRequest, error) {
+	input := &github.UpdatePullRequestInput{
 		PullRequestID: pr.ID,
 		Title:         c.Title,
 		Body:          c.Body,
 		BaseRefName:   git.AbbreviateRef(c.BaseRef),
-	})
+	}
 
-	if err != nil {
-		return err
+	md := pullRequestMetadata(c)
+	md.Reviewers = newReviewers(pr, md.Reviewers)
+	if !md.IsEmpty() {
+		repo := c.Repo.Metadata.(*github.Repository)
+		owner, name, err := github.SplitRepositoryNameWithOwner(repo.NameWithOwner)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting repo owner and name")
+		}
+
+		ids, err := s.client.ResolvePullRequestMetadata(ctx, owner, name, md)
+		if err != nil {
+			return nil, errors.Wrap(err, "resolving pull request metadata")
+		}
+
+		if err := s.client.RequestReviews(ctx, pr, ids.ReviewerUserIDs, ids.ReviewerTeamIDs); err != nil {
+			return nil, errors.Wrap(err, "requesting reviews")
+		}
+
+		input.LabelIDs = ids.LabelIDs
+		input.AssigneeIDs = ids.AssigneeIDs
+		input.MilestoneID = ids.MilestoneID
 	}
 
-	return c.Changeset.SetMetadata(updated)
+	return s.client.UpdatePullRequest(ctx, input)
+}
+
+// newReviewers returns the reviewers whose review hasn't been requested on the
+// pull request yet and who haven't reviewed it, so that we don't request
+// reviews again from reviewers that already submitted theirs or were removed
+// on the code host.
+func newReviewers(pr *github.PullRequest, reviewers []string) []string {
+	existing := make(map[string]bool)
+	for _, item := range pr.TimelineItems {
+		switch e := item.Item.(type) {
+		case *github.ReviewRequestedEvent:
+			if e.RequestedReviewer.Login != "" {
+				existing[strings.ToLower(e.RequestedReviewer.Login)] = true
+			}
+			if e.RequestedTeam.Slug != "" && e.RequestedTeam.Organization != nil {
+				existing[strings.ToLower(e.RequestedTeam.Organization.Login+"/"+e.RequestedTeam.Slug)] = true
+			}
+		case *github.PullRequestReview:
+			existing[strings.ToLower(e.Author.Login)] = true
+		}
+	}
+
+	var added []string
+	for _, reviewer := range reviewers {
+		if !existing[strings.ToLower(reviewer)] {
+			added = append(added, reviewer)
+		}
+	}
+	return added
+}
+
+func pullRequestMetadata(c *Changeset) *github.PullRequestMetadata {
+	return &github.PullRequestMetadata{
+		Labels:    c.Labels,
+		Reviewers: c.Reviewers,
+		Assignees: c.Assignees,
+		Milestone: c.Milestone,
+	}
 }
 
 // ReopenChangeset reopens the given *Changeset on the code host.
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
 	"github.com/inconshreveable/log15"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
@@ -451,6 +452,35 @@ func TestGithubSource_LoadChangeset(t *testing.T) {
 	}
 }
 
+func TestNewReviewers(t *testing.T) {
+	pr := &github.PullRequest{
+		TimelineItems: []github.TimelineItem{
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{
+				RequestedReviewer: github.Actor{Login: "alice"},
+			}},
+			{Type: "ReviewRequestedEvent", Item: &github.ReviewRequestedEvent{
+				RequestedTeam: github.Team{Slug: "batchers", Organization: &github.Org{Login: "sourcegraph"}},
+			}},
+			{Type: "PullRequestReview", Item: &github.PullRequestReview{
+				Author: github.Actor{Login: "Bob"},
+			}},
+		},
+	}
+
+	have := newReviewers(pr, []string{"alice", "bob", "sourcegraph/batchers", "carol", "sourcegraph/frontend"})
+	want := []string{"carol", "sourcegraph/frontend"}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("wrong reviewers (-want +have):\n%s", diff)
+	}
+
+	// Reviews are requested from all reviewers of new pull requests.
+	have = newReviewers(&github.PullRequest{}, []string{"alice", "bob"})
+	want = []string{"alice", "bob"}
+	if diff := cmp.Diff(want, have); diff != "" {
+		t.Fatalf("wrong reviewers (-want +have):\n%s", diff)
+	}
+}
+
 func TestGithubSource_WithAuthenticator(t *testing.T) {
 	svc := &types.ExternalService{
 		Kind: extsvc.KindGitHub,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 
@@ -110,11 +111,20 @@ func (s *GitLabSource) CreateChangeset(ctx context.Context, c *Changeset) (bool,
 	source := git.AbbreviateRef(c.HeadRef)
 	target := git.AbbreviateRef(c.BaseRef)
 
+	md, err := s.resolveMergeRequestMetadata(ctx, project, c)
+	if err != nil {
+		return exists, err
+	}
+
 	mr, err := s.client.CreateMergeRequest(ctx, project, gitlab.CreateMergeRequestOpts{
 		SourceBranch: source,
 		TargetBranch: target,
 		Title:        c.Title,
 		Description:  c.Body,
+		Labels:       md.labels,
+		AssigneeIDs:  md.assigneeIDs,
+		ReviewerIDs:  md.reviewerIDs,
+		MilestoneID:  md.milestoneID,
 	})
 	if err != nil {
 		if err == gitlab.ErrMergeRequestAlreadyExists {
@@ -399,10 +409,19 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 		title = gitlab.SetWIP(c.Title)
 	}
 
+	md, err := s.resolveMergeRequestMetadata(ctx, project, c)
+	if err != nil {
+		return err
+	}
+
 	updated, err := s.client.UpdateMergeRequest(ctx, project, mr, gitlab.UpdateMergeRequestOpts{
 		Title:        title,
 		Description:  c.Body,
 		TargetBranch: git.AbbreviateRef(c.BaseRef),
+		Labels:       md.labels,
+		AssigneeIDs:  md.assigneeIDs,
+		ReviewerIDs:  md.reviewerIDs,
+		MilestoneID:  md.milestoneID,
 	})
 	if err != nil {
 		return errors.Wrap(err, "updating GitLab merge request")
@@ -416,6 +435,52 @@ func (s *GitLabSource) UpdateChangeset(ctx context.Context, c *Changeset) error
 	return c.Changeset.SetMetadata(updated)
 }
 
+// mergeRequestMetadata holds the labels, assignees, reviewers and milestone of
+// a changeset in the form expected by the GitLab merge request API.
+type mergeRequestMetadata struct {
+	labels      string
+	assigneeIDs []gitlab.ID
+	reviewerIDs []gitlab.ID
+	milestoneID gitlab.ID
+}
+
+// resolveMergeRequestMetadata looks up the IDs of the users and the milestone
+// given in the changeset. Labels are passed by name, since GitLab creates
+// missing labels on the fly.
+func (s *GitLabSource) resolveMergeRequestMetadata(ctx context.Context, project *gitlab.Project, c *Changeset) (*mergeRequestMetadata, error) {
+	md := &mergeRequestMetadata{labels: strings.Join(c.Labels, ",")}
+
+	userIDs := func(usernames []string) ([]gitlab.ID, error) {
+		var ids []gitlab.ID
+		for _, username := range usernames {
+			u, err := s.client.GetUserByUsername(ctx, username)
+			if err != nil {
+				return nil, errors.Wrapf(err, "looking up GitLab user %q", username)
+			}
+			ids = append(ids, gitlab.ID(u.ID))
+		}
+		return ids, nil
+	}
+
+	var err error
+	if md.assigneeIDs, err = userIDs(c.Assignees); err != nil {
+		return nil, err
+	}
+	if md.reviewerIDs, err = userIDs(c.Reviewers); err != nil {
+		return nil, err
+	}
+
+	if c.Milestone != "" {
+		m, err := s.client.GetMilestoneByTitle(ctx, project, c.Milestone)
+		if err != nil {
+			return nil, errors.Wrapf(err, "looking up GitLab milestone %q", c.Milestone)
+		}
+		md.milestoneID = m.ID
+	}
+
+	return md, nil
+}
+
 // UndraftChangeset marks the changeset as *not* work in progress anymore.
 func (s *GitLabSource) UndraftChangeset(ctx context.Context, c *Changeset) error {
 	mr, ok := c.Changeset.Metadata.(*gitlab.MergeRequest)
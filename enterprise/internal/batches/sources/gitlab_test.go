@@ -640,6 +640,67 @@ func TestGitLabSource_ChangesetSource(t *testing.T) {
 		}
 	})
 
+	t.Run("UpdateChangeset metadata", func(t *testing.T) {
+		in := &gitlab.MergeRequest{IID: 2}
+		out := &gitlab.MergeRequest{}
+
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = in
+		p.changeset.Labels = []string{"batch-change", "sourcegraph"}
+		p.changeset.Reviewers = []string{"alice"}
+		p.changeset.Assignees = []string{"bob"}
+		p.changeset.Milestone = "v1.0"
+
+		p.mockGetUserByUsername(map[string]int32{"alice": 1, "bob": 2})
+		p.mockGetMilestoneByTitle("v1.0", &gitlab.Milestone{ID: 42, Title: "v1.0"}, nil)
+
+		oldMock := gitlab.MockUpdateMergeRequest
+		t.Cleanup(func() { gitlab.MockUpdateMergeRequest = oldMock })
+		gitlab.MockUpdateMergeRequest = func(c *gitlab.Client, ctx context.Context, project *gitlab.Project, mr *gitlab.MergeRequest, opts gitlab.UpdateMergeRequestOpts) (*gitlab.MergeRequest, error) {
+			want := gitlab.UpdateMergeRequestOpts{
+				Title:        "title",
+				Description:  "description",
+				TargetBranch: "base",
+				Labels:       "batch-change,sourcegraph",
+				ReviewerIDs:  []gitlab.ID{1},
+				AssigneeIDs:  []gitlab.ID{2},
+				MilestoneID:  42,
+			}
+			if diff := cmp.Diff(want, opts); diff != "" {
+				t.Errorf("unexpected options (-want +have):\n%s", diff)
+			}
+			return out, nil
+		}
+
+		p.mockGetMergeRequestNotes(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestResourceStateEvents(in.IID, nil, 20, nil)
+		p.mockGetMergeRequestPipelines(in.IID, nil, 20, nil)
+
+		if err := p.source.UpdateChangeset(p.ctx, p.changeset); err != nil {
+			t.Errorf("unexpected non-nil error: %+v", err)
+		}
+		if p.changeset.Changeset.Metadata != out {
+			t.Errorf("metadata not correctly updated: have %+v; want %+v", p.changeset.Changeset.Metadata, out)
+		}
+	})
+
+	t.Run("UpdateChangeset unknown milestone", func(t *testing.T) {
+		in := &gitlab.MergeRequest{IID: 2}
+
+		p := newGitLabChangesetSourceTestProvider(t)
+		p.changeset.Changeset.Metadata = in
+		p.changeset.Milestone = "v1.0"
+		p.mockGetMilestoneByTitle("v1.0", nil, gitlab.ErrMilestoneNotFound)
+
+		err := p.source.UpdateChangeset(p.ctx, p.changeset)
+		if !errors.Is(err, gitlab.ErrMilestoneNotFound) {
+			t.Errorf("unexpected error: %+v", err)
+		}
+		if p.changeset.Changeset.Metadata != in {
+			t.Errorf("metadata unexpectedly updated: from %+v; to %+v", in, p.changeset.Changeset.Metadata)
+		}
+	})
+
 	t.Run("UndraftChangeset", func(t *testing.T) {
 		in := &gitlab.MergeRequest{IID: 2, WorkInProgress: true}
 		out := &gitlab.MergeRequest{}
@@ -1006,6 +1067,26 @@ func (p *gitLabChangesetSourceTestProvider) mockCreateComment(expected string, m
 	}
 }
 
+func (p *gitLabChangesetSourceTestProvider) mockGetUserByUsername(ids map[string]int32) {
+	gitlab.MockGetUserByUsername = func(client *gitlab.Client, ctx context.Context, username string) (*gitlab.User, error) {
+		id, ok := ids[username]
+		if !ok {
+			return nil, gitlab.ErrUserNotFound
+		}
+		return &gitlab.User{ID: id, Username: username}, nil
+	}
+}
+
+func (p *gitLabChangesetSourceTestProvider) mockGetMilestoneByTitle(expected string, milestone *gitlab.Milestone, err error) {
+	gitlab.MockGetMilestoneByTitle = func(client *gitlab.Client, ctx context.Context, project *gitlab.Project, title string) (*gitlab.Milestone, error) {
+		p.testCommonParams(ctx, client, project)
+		if expected != title {
+			p.t.Errorf("unexpected milestone title: have %q; want %q", title, expected)
+		}
+		return milestone, err
+	}
+}
+
 func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockCreateMergeRequest = nil
 	gitlab.MockGetMergeRequest = nil
@@ -1015,6 +1096,8 @@ func (p *gitLabChangesetSourceTestProvider) unmock() {
 	gitlab.MockGetOpenMergeRequestByRefs = nil
 	gitlab.MockUpdateMergeRequest = nil
 	gitlab.MockCreateMergeRequestNote = nil
+	gitlab.MockGetUserByUsername = nil
+	gitlab.MockGetMilestoneByTitle = nil
 }
 
 // panicDoer provides a httpcli.Doer implementation that panics if any attempt
@@ -22,10 +22,11 @@ type ChangesetCounts struct {
 	OpenApproved         int32
 	OpenChangesRequested int32
 	OpenPending          int32
+	Waiting              int32
 }
 
 func (cc *ChangesetCounts) String() string {
-	return fmt.Sprintf("%s (Total: %d, Merged: %d, Closed: %d, Draft: %d, Open: %d, OpenApproved: %d, OpenChangesRequested: %d, OpenPending: %d)",
+	return fmt.Sprintf("%s (Total: %d, Merged: %d, Closed: %d, Draft: %d, Open: %d, OpenApproved: %d, OpenChangesRequested: %d, OpenPending: %d, Waiting: %d)",
 		cc.Time.String(),
 		cc.Total,
 		cc.Merged,
@@ -35,6 +36,7 @@ func (cc *ChangesetCounts) String() string {
 		cc.OpenApproved,
 		cc.OpenChangesRequested,
 		cc.OpenPending,
+		cc.Waiting,
 	)
 }
 
@@ -43,6 +45,10 @@ func (cc *ChangesetCounts) String() string {
 // The number of ChangesetCounts returned is always `timestampCount`. Between
 // start and end, it generates `timestampCount` datapoints with each ChangesetCounts
 // representing a point in time. `es` are expected to be pre-sorted.
+//
+// Changesets that are waiting for their upstream changesets to be merged have
+// never been published, so they're counted as waiting from the time they were
+// created.
 func CalcCounts(start, end time.Time, cs []*btypes.Changeset, es ...*btypes.ChangesetEvent) ([]*ChangesetCounts, error) {
 	ts := GenerateTimestamps(start, end)
 	counts := make([]*ChangesetCounts, len(ts))
@@ -64,6 +70,16 @@ func CalcCounts(start, end time.Time, cs []*btypes.Changeset, es ...*btypes.Chan
 	}
 
 	for changeset, csEvents := range byChangeset {
+		if changeset.ReconcilerState == btypes.ReconcilerStateWaiting {
+			for _, c := range counts {
+				if !c.Time.Before(changeset.CreatedAt) {
+					c.Total++
+					c.Waiting++
+				}
+			}
+			continue
+		}
+
 		// Compute history of changeset
 		history, err := computeHistory(changeset, csEvents)
 		if err != nil {
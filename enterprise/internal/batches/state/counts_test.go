@@ -1425,6 +1425,23 @@ func TestCalcCounts(t *testing.T) {
 				{Time: daysAgo(0), Total: 1, Draft: 1},
 			},
 		},
+		{
+			codehosts: extsvc.TypeGitHub,
+			name:      "changeset waiting for upstream changesets",
+			changesets: []*btypes.Changeset{
+				ghChangeset(1, daysAgo(2)),
+				waitingChangeset(2, daysAgo(1)),
+			},
+			start: daysAgo(2),
+			events: []*btypes.ChangesetEvent{
+				event(t, daysAgo(0), btypes.ChangesetEventKindGitHubMerged, 1),
+			},
+			want: []*ChangesetCounts{
+				{Time: daysAgo(2), Total: 1, Open: 1, OpenPending: 1},
+				{Time: daysAgo(1), Total: 2, Open: 1, OpenPending: 1, Waiting: 1},
+				{Time: daysAgo(0), Total: 2, Merged: 1, Waiting: 1},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -1468,6 +1485,15 @@ func ghChangeset(id int64, t time.Time) *btypes.Changeset {
 	return &btypes.Changeset{ID: id, Metadata: &github.PullRequest{CreatedAt: t}}
 }
 
+func waitingChangeset(id int64, t time.Time) *btypes.Changeset {
+	return &btypes.Changeset{
+		ID:               id,
+		CreatedAt:        t,
+		PublicationState: btypes.ChangesetPublicationStateUnpublished,
+		ReconcilerState:  btypes.ReconcilerStateWaiting,
+	}
+}
+
 func bbsChangeset(id int64, t time.Time) *btypes.Changeset {
 	return &btypes.Changeset{
 		ID:       id,
@@ -9,6 +9,7 @@ import (
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -276,6 +277,74 @@ func TestComputeBitbucketBuildStatus(t *testing.T) {
 	}
 }
 
+func TestComputeBitbucketCloudBuildState(t *testing.T) {
+	t.Parallel()
+
+	now := timeutil.Now()
+	lastSynced := now.Add(-1 * time.Minute)
+
+	statusEvent := func(updatedOn time.Time, key string, state bitbucketcloud.PullRequestStatusState) *btypes.ChangesetEvent {
+		return &btypes.ChangesetEvent{
+			Kind: btypes.ChangesetEventKindBitbucketCloudCommitStatus,
+			Metadata: &bitbucketcloud.PullRequestStatus{
+				Key:       key,
+				State:     state,
+				UpdatedOn: updatedOn,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		statuses []*bitbucketcloud.PullRequestStatus
+		events   []*btypes.ChangesetEvent
+		want     btypes.ChangesetCheckState
+	}{
+		{
+			name: "no statuses",
+			want: btypes.ChangesetCheckStateUnknown,
+		},
+		{
+			name: "synced statuses only",
+			statuses: []*bitbucketcloud.PullRequestStatus{
+				{Key: "ctx1", State: bitbucketcloud.PullRequestStatusStateSuccessful},
+				{Key: "ctx2", State: bitbucketcloud.PullRequestStatusStateStopped},
+			},
+			want: btypes.ChangesetCheckStateFailed,
+		},
+		{
+			name: "newer event overrides synced status",
+			statuses: []*bitbucketcloud.PullRequestStatus{
+				{Key: "ctx1", State: bitbucketcloud.PullRequestStatusStateInProgress},
+			},
+			events: []*btypes.ChangesetEvent{
+				statusEvent(now, "ctx1", bitbucketcloud.PullRequestStatusStateSuccessful),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+		{
+			name: "older event is ignored",
+			statuses: []*bitbucketcloud.PullRequestStatus{
+				{Key: "ctx1", State: bitbucketcloud.PullRequestStatusStateSuccessful},
+			},
+			events: []*btypes.ChangesetEvent{
+				statusEvent(lastSynced.Add(-1*time.Minute), "ctx1", bitbucketcloud.PullRequestStatusStateFailed),
+			},
+			want: btypes.ChangesetCheckStatePassed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pr := &bitbucketcloud.PullRequest{Statuses: tc.statuses}
+			have := computeBitbucketCloudBuildState(lastSynced, pr, tc.events)
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Fatalf(diff)
+			}
+		})
+	}
+}
+
 func TestComputeGitLabCheckState(t *testing.T) {
 	t.Parallel()
 
@@ -653,6 +722,38 @@ func TestComputeExternalState(t *testing.T) {
 			},
 			want: btypes.ChangesetExternalStateDeleted,
 		},
+		{
+			name:      "bitbucketcloud - no events, open",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateOpen),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateOpen,
+		},
+		{
+			name:      "bitbucketcloud - no events, declined",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateDeclined),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "bitbucketcloud - no events, superseded",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateSuperseded),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateClosed,
+		},
+		{
+			name:      "bitbucketcloud - changeset older than events",
+			changeset: bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateOpen),
+			history: []changesetStatesAtTime{
+				{t: daysAgo(0), externalState: btypes.ChangesetExternalStateMerged},
+			},
+			want: btypes.ChangesetExternalStateMerged,
+		},
+		{
+			name:      "bitbucketcloud draft - no events",
+			changeset: setDraft(bitbucketCloudChangeset(daysAgo(10), bitbucketcloud.PullRequestStateOpen)),
+			history:   []changesetStatesAtTime{},
+			want:      btypes.ChangesetExternalStateDraft,
+		},
 		{
 			name:      "gitlab - no events, opened",
 			changeset: gitLabChangeset(daysAgo(0), gitlab.MergeRequestStateOpened, nil),
@@ -846,6 +947,14 @@ func bitbucketChangeset(updatedAt time.Time, state, reviewStatus string) *btypes
 	}
 }
 
+func bitbucketCloudChangeset(updatedAt time.Time, state bitbucketcloud.PullRequestState) *btypes.Changeset {
+	return &btypes.Changeset{
+		ExternalServiceType: extsvc.TypeBitbucketCloud,
+		UpdatedAt:           updatedAt,
+		Metadata:            &bitbucketcloud.PullRequest{State: state},
+	}
+}
+
 func githubChangeset(updatedAt time.Time, state string) *btypes.Changeset {
 	return &btypes.Changeset{
 		ExternalServiceType: extsvc.TypeGitHub,
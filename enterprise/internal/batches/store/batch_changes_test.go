@@ -34,6 +34,7 @@ func testStoreBatchChanges(t *testing.T, ctx context.Context, s *Store, clock ct
 
 				BatchSpecID: 1742 + int64(i),
 				ClosedAt:    clock.Now(),
+				AutoRebase:  i%2 == 1,
 			}
 
 			if i == 0 {
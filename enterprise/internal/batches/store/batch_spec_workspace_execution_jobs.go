@@ -227,6 +227,7 @@ type ListBatchSpecWorkspaceExecutionJobsOpts struct {
 	IDs                    []int64
 	OnlyWithFailureMessage bool
 	BatchSpecID            int64
+	RebaseChangesetID      int64
 }
 
 // ListBatchSpecWorkspaceExecutionJobs lists batch changes with the given filters.
@@ -290,11 +291,18 @@ func listBatchSpecWorkspaceExecutionJobsQuery(opts ListBatchSpecWorkspaceExecuti
 		preds = append(preds, sqlf.Sprintf("batch_spec_workspace_execution_jobs.failure_message IS NOT NULL"))
 	}
 
-	if opts.BatchSpecID != 0 {
+	if opts.BatchSpecID != 0 || opts.RebaseChangesetID != 0 {
 		joins = append(joins, sqlf.Sprintf("JOIN batch_spec_workspaces ON batch_spec_workspace_execution_jobs.batch_spec_workspace_id = batch_spec_workspaces.id"))
+	}
+
+	if opts.BatchSpecID != 0 {
 		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.batch_spec_id = %d", opts.BatchSpecID))
 	}
 
+	if opts.RebaseChangesetID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_spec_workspaces.rebase_changeset_id = %d", opts.RebaseChangesetID))
+	}
+
 	if len(preds) == 0 {
 		preds = append(preds, sqlf.Sprintf("TRUE"))
 	}
@@ -116,6 +116,18 @@ func testStoreBatchSpecWorkspaces(t *testing.T, ctx context.Context, s *Store, c
 			}
 		})
 
+		t.Run("GetByChangesetSpecID", func(t *testing.T) {
+			job := workspaces[0]
+			have, err := s.GetBatchSpecWorkspace(ctx, GetBatchSpecWorkspaceOpts{ChangesetSpecID: job.ChangesetSpecIDs[1]})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(have, job); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+
 		t.Run("NoResults", func(t *testing.T) {
 			opts := GetBatchSpecWorkspaceOpts{ID: 0xdeadbeef}
 
@@ -204,6 +204,8 @@ func scanChangesetJob(c *btypes.ChangesetJob, s dbutil.Scanner) error {
 		c.Payload = new(btypes.ChangesetJobClosePayload)
 	case btypes.ChangesetJobTypePublish:
 		c.Payload = new(btypes.ChangesetJobPublishPayload)
+	case btypes.ChangesetJobTypeRebase:
+		c.Payload = new(btypes.ChangesetJobRebasePayload)
 	default:
 		return errors.Errorf("unknown job type %q", c.JobType)
 	}
@@ -714,12 +714,15 @@ func getRewirerMappingCurrentState(state *btypes.ChangesetState) (*sqlf.Query, e
 		q = sqlf.Sprintf("reconciler_state = %s", btypes.ReconcilerStateFailed.ToDB())
 	case btypes.ChangesetStateScheduled:
 		q = sqlf.Sprintf("reconciler_state = %s", btypes.ReconcilerStateScheduled.ToDB())
+	case btypes.ChangesetStateWaiting:
+		q = sqlf.Sprintf("reconciler_state = %s", btypes.ReconcilerStateWaiting.ToDB())
 	case btypes.ChangesetStateProcessing:
 		q = sqlf.Sprintf("reconciler_state NOT IN (%s)",
 			sqlf.Join([]*sqlf.Query{
 				sqlf.Sprintf("%s", btypes.ReconcilerStateErrored.ToDB()),
 				sqlf.Sprintf("%s", btypes.ReconcilerStateFailed.ToDB()),
 				sqlf.Sprintf("%s", btypes.ReconcilerStateScheduled.ToDB()),
+				sqlf.Sprintf("%s", btypes.ReconcilerStateWaiting.ToDB()),
 				sqlf.Sprintf("%s", btypes.ReconcilerStateCompleted.ToDB()),
 			}, ","),
 		)
@@ -885,6 +885,7 @@ func testStoreChangesetSpecsCurrentState(t *testing.T, ctx context.Context, s *S
 			btypes.ChangesetStateRetrying:    {ReconcilerState: btypes.ReconcilerStateErrored},
 			btypes.ChangesetStateFailed:      {ReconcilerState: btypes.ReconcilerStateFailed},
 			btypes.ChangesetStateScheduled:   {ReconcilerState: btypes.ReconcilerStateScheduled},
+			btypes.ChangesetStateWaiting:     {ReconcilerState: btypes.ReconcilerStateWaiting},
 			btypes.ChangesetStateUnpublished: {PublicationState: btypes.ChangesetPublicationStateUnpublished},
 			btypes.ChangesetStateDraft:       {ExternalState: btypes.ChangesetExternalStateDraft},
 			btypes.ChangesetStateOpen:        {ExternalState: btypes.ChangesetExternalStateOpen},
@@ -945,7 +946,7 @@ func testStoreChangesetSpecsCurrentState(t *testing.T, ctx context.Context, s *S
 	}
 
 	// Finally, PROCESSING is special, and should match everything that isn't
-	// retrying, failed, scheduled, or completed.
+	// retrying, failed, scheduled, waiting, or completed.
 	t.Run(string(btypes.ChangesetStateProcessing), func(t *testing.T) {
 		want := []int64{}
 		for state, changeset := range changesets {
@@ -953,6 +954,7 @@ func testStoreChangesetSpecsCurrentState(t *testing.T, ctx context.Context, s *S
 			case btypes.ChangesetStateRetrying:
 			case btypes.ChangesetStateFailed:
 			case btypes.ChangesetStateScheduled:
+			case btypes.ChangesetStateWaiting:
 			default:
 				want = append(want, changeset.ID)
 			}
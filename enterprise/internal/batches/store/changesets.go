@@ -22,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -404,10 +405,10 @@ func getChangesetQuery(opts *GetChangesetOpts) *sqlf.Query {
 	}
 
 	if opts.ExternalID != "" && opts.ExternalServiceType != "" {
-		preds = append(preds,
-			sqlf.Sprintf("changesets.external_id = %s", opts.ExternalID),
-			sqlf.Sprintf("changesets.external_service_type = %s", opts.ExternalServiceType),
-		)
+		preds = append(preds, sqlf.Sprintf("changesets.external_id = %s", opts.ExternalID))
+	}
+	if opts.ExternalServiceType != "" {
+		preds = append(preds, sqlf.Sprintf("changesets.external_service_type = %s", opts.ExternalServiceType))
 	}
 	if opts.ExternalBranch != "" {
 		preds = append(preds, sqlf.Sprintf("changesets.external_branch = %s", opts.ExternalBranch))
@@ -880,8 +881,8 @@ func (s *Store) GetChangesetExternalIDs(ctx context.Context, spec api.ExternalRe
 // applying the new batch spec.
 var CanceledChangesetFailureMessage = "Canceled"
 
-// CancelQueuedBatchChangeChangesets cancels all scheduled, queued, or errored
-// changesets that are owned by the given batch change. It blocks until all
+// CancelQueuedBatchChangeChangesets cancels all scheduled, waiting, queued, or
+// errored changesets that are owned by the given batch change. It blocks until all
 // currently processing changesets have finished executing.
 func (s *Store) CancelQueuedBatchChangeChangesets(ctx context.Context, batchChangeID int64) (err error) {
 	var iterations int
@@ -904,6 +905,7 @@ func (s *Store) CancelQueuedBatchChangeChangesets(ctx context.Context, batchChan
 			cancelQueuedBatchChangeChangesetsFmtstr,
 			batchChangeID,
 			btypes.ReconcilerStateScheduled.ToDB(),
+			btypes.ReconcilerStateWaiting.ToDB(),
 			btypes.ReconcilerStateQueued.ToDB(),
 			btypes.ReconcilerStateErrored.ToDB(),
 			btypes.ReconcilerStateFailed.ToDB(),
@@ -932,7 +934,7 @@ WITH changeset_ids AS (
   WHERE
     owned_by_batch_change_id = %s
   AND
-    reconciler_state IN (%s, %s, %s)
+    reconciler_state IN (%s, %s, %s, %s)
 ),
 updated_records AS (
 	UPDATE
@@ -1172,6 +1174,8 @@ func scanChangeset(t *btypes.Changeset, s dbutil.Scanner) error {
 		t.Metadata = new(bitbucketserver.PullRequest)
 	case extsvc.TypeGitLab:
 		t.Metadata = new(gitlab.MergeRequest)
+	case extsvc.TypeBitbucketCloud:
+		t.Metadata = new(bitbucketcloud.PullRequest)
 	default:
 		return errors.New("unknown external service type")
 	}
@@ -1201,6 +1205,7 @@ func (s *Store) GetChangesetsStats(ctx context.Context, batchChangeID int64) (st
 			&stats.Retrying,
 			&stats.Failed,
 			&stats.Scheduled,
+			&stats.Waiting,
 			&stats.Processing,
 			&stats.Unpublished,
 			&stats.Closed,
@@ -1227,7 +1232,8 @@ SELECT
 	COUNT(*) FILTER (WHERE changesets.reconciler_state = 'errored') AS retrying,
 	COUNT(*) FILTER (WHERE changesets.reconciler_state = 'failed') AS failed,
 	COUNT(*) FILTER (WHERE changesets.reconciler_state = 'scheduled') AS scheduled,
-	COUNT(*) FILTER (WHERE changesets.reconciler_state NOT IN ('failed', 'errored', 'completed', 'scheduled')) AS processing,
+	COUNT(*) FILTER (WHERE changesets.reconciler_state = 'waiting') AS waiting,
+	COUNT(*) FILTER (WHERE changesets.reconciler_state NOT IN ('failed', 'errored', 'completed', 'scheduled', 'waiting')) AS processing,
 	COUNT(*) FILTER (WHERE changesets.publication_state = 'UNPUBLISHED' AND changesets.reconciler_state = 'completed') AS unpublished,
 	COUNT(*) FILTER (WHERE %s AND changesets.external_state = 'CLOSED'  AND NOT %s) AS closed,
 	COUNT(*) FILTER (WHERE %s AND changesets.external_state = 'DRAFT'   AND NOT %s) AS draft,
@@ -1357,6 +1363,75 @@ WHERE
 	id = %d
 `
 
+// IsWaitingForUpstreamChangesets returns true if the given changeset belongs
+// to a stage of the batch spec of the batch change that owns it, and at least
+// one changeset of the batch change in the repositories of the previous stages
+// is still attached to the batch change and open on the code host.
+func (s *Store) IsWaitingForUpstreamChangesets(ctx context.Context, c *btypes.Changeset) (waiting bool, err error) {
+	ctx, endObservation := s.operations.isWaitingForUpstreamChangesets.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(c.ID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if c.OwnedByBatchChangeID == 0 {
+		return false, nil
+	}
+
+	batchChange, err := s.GetBatchChange(ctx, GetBatchChangeOpts{ID: c.OwnedByBatchChangeID})
+	if err != nil {
+		return false, errors.Wrap(err, "getting owning batch change")
+	}
+	batchSpec, err := s.GetBatchSpec(ctx, GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return false, errors.Wrap(err, "getting batch spec")
+	}
+	if len(batchSpec.Spec.Stages) == 0 {
+		return false, nil
+	}
+
+	// Archived, detached, closed and never published changesets don't block
+	// the downstream stages.
+	cs, _, err := s.ListChangesets(ctx, ListChangesetsOpts{
+		BatchChangeID:        batchChange.ID,
+		OwnedByBatchChangeID: batchChange.ID,
+		ExternalStates: []btypes.ChangesetExternalState{
+			btypes.ChangesetExternalStateOpen,
+			btypes.ChangesetExternalStateDraft,
+		},
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "listing changesets of batch change")
+	}
+	repos, err := s.Repos().GetReposSetByIDs(ctx, append(cs.RepoIDs(), c.RepoID)...)
+	if err != nil {
+		return false, errors.Wrap(err, "loading repositories")
+	}
+
+	repo, ok := repos[c.RepoID]
+	if !ok {
+		return false, nil
+	}
+	stage := batchSpec.Spec.StageForRepository(string(repo.Name))
+	if stage <= 0 {
+		return false, nil
+	}
+
+	for _, upstream := range cs {
+		if upstream.ID == c.ID {
+			continue
+		}
+		r, ok := repos[upstream.RepoID]
+		if !ok {
+			continue
+		}
+		if upstreamStage := batchSpec.Spec.StageForRepository(string(r.Name)); upstreamStage >= 0 && upstreamStage < stage {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func archivedInBatchChange(batchChangeID string) *sqlf.Query {
 	return sqlf.Sprintf(
 		"(COALESCE((batch_change_ids->%s->>'isArchived')::bool, false) OR COALESCE((batch_change_ids->%s->>'archive')::bool, false))",
@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/search"
 	ct "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
@@ -936,6 +937,28 @@ func testStoreChangesets(t *testing.T, ctx context.Context, s *Store, clock ct.C
 			}
 		})
 
+		t.Run("ExternalBranchAndServiceType", func(t *testing.T) {
+			for _, c := range changesets {
+				opts := GetChangesetOpts{ExternalBranch: c.ExternalBranch, ExternalServiceType: c.ExternalServiceType}
+
+				have, err := s.GetChangeset(ctx, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want := c
+
+				if diff := cmp.Diff(have, want); diff != "" {
+					t.Fatal(diff)
+				}
+
+				opts.ExternalServiceType = "not-" + c.ExternalServiceType
+				_, err = s.GetChangeset(ctx, opts)
+				if err != ErrNoResults {
+					t.Fatalf("unexpected error, want=%q have=%q", ErrNoResults, err)
+				}
+			}
+		})
+
 		t.Run("ReconcilerState", func(t *testing.T) {
 			for _, c := range changesets {
 				opts := GetChangesetOpts{ID: c.ID, ReconcilerState: c.ReconcilerState}
@@ -2419,3 +2442,137 @@ func TestEnqueueChangesetsToClose(t *testing.T) {
 		ct.ReloadAndAssertChangeset(t, ctx, s, changeset, want)
 	}
 }
+
+func TestIsWaitingForUpstreamChangesets(t *testing.T) {
+	ctx := actor.WithInternalActor(context.Background())
+	db := dbtest.NewDB(t)
+
+	s := New(db, &observation.TestContext, nil)
+
+	user := ct.CreateTestUser(t, db, true)
+	repos, _ := ct.CreateTestRepos(t, ctx, db, 3)
+	upstreamRepo, downstreamRepo, unstagedRepo := repos[0], repos[1], repos[2]
+
+	spec := &btypes.BatchSpec{
+		UserID:          user.ID,
+		NamespaceUserID: user.ID,
+		Spec: &batcheslib.BatchSpec{
+			Name:              "test-batch-change",
+			ChangesetTemplate: &batcheslib.ChangesetTemplate{Branch: "branch-name"},
+			Stages: []batcheslib.Stage{
+				{Repositories: []string{string(upstreamRepo.Name)}},
+				{Repositories: []string{string(downstreamRepo.Name)}},
+			},
+		},
+	}
+	if err := s.CreateBatchSpec(ctx, spec); err != nil {
+		t.Fatal(err)
+	}
+	batchChange := ct.CreateBatchChange(t, ctx, s, "test-batch-change", user.ID, spec.ID)
+
+	createChangeset := func(repo *types.Repo, externalState btypes.ChangesetExternalState) *btypes.Changeset {
+		return ct.CreateChangeset(t, ctx, s, ct.TestChangesetOpts{
+			Repo:               repo.ID,
+			BatchChange:        batchChange.ID,
+			OwnedByBatchChange: batchChange.ID,
+			PublicationState:   btypes.ChangesetPublicationStatePublished,
+			ExternalState:      externalState,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+		})
+	}
+
+	upstream := createChangeset(upstreamRepo, btypes.ChangesetExternalStateOpen)
+	downstream := ct.CreateChangeset(t, ctx, s, ct.TestChangesetOpts{
+		Repo:               downstreamRepo.ID,
+		BatchChange:        batchChange.ID,
+		OwnedByBatchChange: batchChange.ID,
+		PublicationState:   btypes.ChangesetPublicationStateUnpublished,
+		ReconcilerState:    btypes.ReconcilerStateWaiting,
+	})
+	unstaged := createChangeset(unstagedRepo, btypes.ChangesetExternalStateOpen)
+
+	assertWaiting := func(t *testing.T, c *btypes.Changeset, want bool) {
+		t.Helper()
+
+		have, err := s.IsWaitingForUpstreamChangesets(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if have != want {
+			t.Errorf("unexpected waiting state for changeset %d: have=%t want=%t", c.ID, have, want)
+		}
+	}
+
+	deleteChangeset := func(t *testing.T, c *btypes.Changeset) {
+		t.Helper()
+
+		if err := s.DeleteChangeset(ctx, c.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertWaiting(t, upstream, false)
+	assertWaiting(t, downstream, true)
+	assertWaiting(t, unstaged, false)
+
+	upstream.ExternalState = btypes.ChangesetExternalStateMerged
+	if err := s.UpdateChangeset(ctx, upstream); err != nil {
+		t.Fatal(err)
+	}
+	assertWaiting(t, downstream, false)
+
+	t.Run("archived upstream", func(t *testing.T) {
+		archived := ct.CreateChangeset(t, ctx, s, ct.TestChangesetOpts{
+			Repo:               upstreamRepo.ID,
+			BatchChange:        batchChange.ID,
+			OwnedByBatchChange: batchChange.ID,
+			IsArchived:         true,
+			PublicationState:   btypes.ChangesetPublicationStatePublished,
+			ExternalState:      btypes.ChangesetExternalStateOpen,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+		})
+		defer deleteChangeset(t, archived)
+
+		assertWaiting(t, downstream, false)
+	})
+
+	t.Run("closed upstream", func(t *testing.T) {
+		closed := createChangeset(upstreamRepo, btypes.ChangesetExternalStateClosed)
+		defer deleteChangeset(t, closed)
+
+		assertWaiting(t, downstream, false)
+	})
+
+	t.Run("detached upstream", func(t *testing.T) {
+		detached := ct.CreateChangeset(t, ctx, s, ct.TestChangesetOpts{
+			Repo:               upstreamRepo.ID,
+			OwnedByBatchChange: batchChange.ID,
+			PublicationState:   btypes.ChangesetPublicationStatePublished,
+			ExternalState:      btypes.ChangesetExternalStateOpen,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+		})
+		defer deleteChangeset(t, detached)
+
+		assertWaiting(t, downstream, false)
+	})
+
+	t.Run("unpublished upstream", func(t *testing.T) {
+		unpublished := ct.CreateChangeset(t, ctx, s, ct.TestChangesetOpts{
+			Repo:               upstreamRepo.ID,
+			BatchChange:        batchChange.ID,
+			OwnedByBatchChange: batchChange.ID,
+			PublicationState:   btypes.ChangesetPublicationStateUnpublished,
+			ReconcilerState:    btypes.ReconcilerStateCompleted,
+		})
+		defer deleteChangeset(t, unpublished)
+
+		assertWaiting(t, downstream, false)
+	})
+
+	t.Run("draft upstream", func(t *testing.T) {
+		draft := createChangeset(upstreamRepo, btypes.ChangesetExternalStateDraft)
+		defer deleteChangeset(t, draft)
+
+		assertWaiting(t, downstream, true)
+	})
+}
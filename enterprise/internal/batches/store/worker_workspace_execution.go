@@ -13,6 +13,7 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/global"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/database"
@@ -238,7 +239,7 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		}
 	}
 
-	changesetSpecIDs := []int64{}
+	changesetSpecs := []*btypes.ChangesetSpec{}
 	for _, entry := range executionResults {
 		// Store the cache entry.
 		entry.UserID = batchSpec.UserID
@@ -284,12 +285,15 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 			if err := tx.CreateChangesetSpec(ctx, specs...); err != nil {
 				return rollbackAndMarkFailed(err, fmt.Sprintf("failed to store changeset specs: %s", err))
 			}
-			for _, spec := range specs {
-				changesetSpecIDs = append(changesetSpecIDs, spec.ID)
-			}
+			changesetSpecs = append(changesetSpecs, specs...)
 		}
 	}
 
+	changesetSpecIDs := make([]int64, 0, len(changesetSpecs))
+	for _, spec := range changesetSpecs {
+		changesetSpecIDs = append(changesetSpecIDs, spec.ID)
+	}
+
 	err = deleteAccessToken(ctx, tx, job.AccessTokenID)
 	if err != nil {
 		return rollbackAndMarkFailed(err, fmt.Sprintf("failed to delete internal access token: %s", err))
@@ -299,10 +303,53 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		return false, tx.Done(err)
 	}
 
+	if workspace.RebaseChangesetID != 0 {
+		if err := applyRebasedChangesetSpec(ctx, tx, workspace.RebaseChangesetID, changesetSpecs); err != nil {
+			return rollbackAndMarkFailed(err, fmt.Sprintf("failed to update rebased changeset: %s", err))
+		}
+	}
+
 	ok, err := s.Store.With(tx).MarkComplete(ctx, id, options)
 	return ok, tx.Done(err)
 }
 
+// applyRebasedChangesetSpec updates the changeset a workspace was rebased for
+// to the changeset spec with the same head ref that the execution produced,
+// and enqueues the changeset so that the reconciler pushes the new commit.
+func applyRebasedChangesetSpec(ctx context.Context, tx *Store, changesetID int64, specs []*btypes.ChangesetSpec) error {
+	changeset, err := tx.GetChangeset(ctx, GetChangesetOpts{ID: changesetID})
+	if err != nil {
+		return errors.Wrap(err, "loading changeset")
+	}
+
+	current, err := tx.GetChangesetSpecByID(ctx, changeset.CurrentSpecID)
+	if err != nil {
+		return errors.Wrap(err, "loading current changeset spec")
+	}
+
+	var rebased *btypes.ChangesetSpec
+	for _, spec := range specs {
+		if spec.Spec.HeadRef == current.Spec.HeadRef {
+			rebased = spec
+			break
+		}
+	}
+	if rebased == nil {
+		// The steps don't produce any changes for this branch on the new base
+		// revision, so there's nothing to push. Leave the changeset as is.
+		log15.Warn("rebase produced no changeset spec for changeset", "changeset", changesetID, "headRef", current.Spec.HeadRef)
+		return nil
+	}
+
+	if changeset.ReconcilerState == btypes.ReconcilerStateCompleted {
+		changeset.PreviousSpecID = changeset.CurrentSpecID
+	}
+	changeset.SetCurrentSpec(rebased)
+	changeset.ResetReconcilerState(global.DefaultReconcilerEnqueueState())
+
+	return tx.UpdateChangeset(ctx, changeset)
+}
+
 func (s *batchSpecWorkspaceExecutionWorkerStore) setChangesetSpecIDs(ctx context.Context, batchSpecWorkspaceID int64, changesetSpecIDs []int64) error {
 	if len(changesetSpecIDs) > 0 {
 		// Set the batch_spec_id on the changeset_specs that were created.
@@ -454,6 +454,8 @@ func (s *changesetSyncer) SyncChangeset(ctx context.Context, id int64) error {
 // SyncChangeset refreshes the metadata of the given changeset and
 // updates them in the database.
 func SyncChangeset(ctx context.Context, syncStore SyncStore, source sources.ChangesetSource, repo *types.Repo, c *btypes.Changeset) (err error) {
+	wasOutdated := c.IsOutdated()
+
 	repoChangeset := &sources.Changeset{Repo: repo, Changeset: c}
 	if err := source.LoadChangeset(ctx, repoChangeset); err != nil {
 		if !errors.HasType(err, sources.ChangesetNotFoundError{}) {
@@ -491,7 +493,48 @@ func SyncChangeset(ctx context.Context, syncStore SyncStore, source sources.Chan
 		return err
 	}
 
-	return tx.UpsertChangesetEvents(ctx, events...)
+	if err := tx.UpsertChangesetEvents(ctx, events...); err != nil {
+		return err
+	}
+
+	// Only enqueue a rebase when the changeset becomes outdated, so that we
+	// don't create a new job on every sync until the rebase is pushed.
+	if !wasOutdated && c.IsOutdated() {
+		return enqueueAutoRebase(ctx, tx, c)
+	}
+	return nil
+}
+
+// enqueueAutoRebase creates a rebase job for the given changeset if the batch
+// change that owns it has auto-rebasing enabled. The job is run on behalf of
+// the user that last applied the batch change.
+func enqueueAutoRebase(ctx context.Context, tx *store.Store, c *btypes.Changeset) error {
+	if c.OwnedByBatchChangeID == 0 || c.CurrentSpecID == 0 {
+		return nil
+	}
+
+	batchChange, err := tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: c.OwnedByBatchChangeID})
+	if err != nil {
+		return errors.Wrap(err, "loading batch change")
+	}
+	if !batchChange.AutoRebase || batchChange.Closed() {
+		return nil
+	}
+
+	bulkGroupID, err := store.RandomID()
+	if err != nil {
+		return errors.Wrap(err, "creating bulkGroupID failed")
+	}
+
+	return tx.CreateChangesetJob(ctx, &btypes.ChangesetJob{
+		BulkGroup:     bulkGroupID,
+		ChangesetID:   c.ID,
+		BatchChangeID: batchChange.ID,
+		UserID:        batchChange.LastApplierID,
+		State:         btypes.ChangesetJobStateQueued,
+		JobType:       btypes.ChangesetJobTypeRebase,
+		Payload:       &btypes.ChangesetJobRebasePayload{},
+	})
 }
 
 func loadChangesetSource(ctx context.Context, cf *httpcli.Factory, syncStore SyncStore, repo *types.Repo) (sources.ChangesetSource, error) {
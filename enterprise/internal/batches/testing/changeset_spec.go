@@ -40,6 +40,11 @@ type TestSpecOpts struct {
 
 	BaseRev string
 	BaseRef string
+
+	Labels    []string
+	Reviewers []string
+	Assignees []string
+	Milestone string
 }
 
 var TestChangsetSpecDiffStat = &diff.Stat{Added: 10, Changed: 5, Deleted: 2}
@@ -70,6 +75,11 @@ func BuildChangesetSpec(t *testing.T, opts TestSpecOpts) *btypes.ChangesetSpec {
 			Title: opts.Title,
 			Body:  opts.Body,
 
+			Labels:    opts.Labels,
+			Reviewers: opts.Reviewers,
+			Assignees: opts.Assignees,
+			Milestone: opts.Milestone,
+
 			Commits: []batcheslib.GitCommitDescription{
 				{
 					Message:     opts.CommitMessage,
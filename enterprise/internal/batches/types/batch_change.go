@@ -19,6 +19,10 @@ type BatchChange struct {
 
 	BatchSpecID int64
 
+	// AutoRebase is true if changesets that are conflicting with or behind
+	// their base branch should be rebased automatically.
+	AutoRebase bool
+
 	InitialApplierID int32
 	LastApplierID    int32
 	LastAppliedAt    time.Time
@@ -41,6 +41,11 @@ type BatchSpecWorkspace struct {
 	// and used for creating the attached changeset specs.
 	CachedResultFound bool
 
+	// RebaseChangesetID is the ID of the changeset this workspace was created
+	// for by a rebase. It is 0 for workspaces that were resolved from the batch
+	// spec.
+	RebaseChangesetID int64
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
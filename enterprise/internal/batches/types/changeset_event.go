@@ -9,6 +9,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/inconshreveable/log15"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
@@ -78,6 +79,17 @@ const (
 	ChangesetEventKindGitLabMarkWorkInProgress   ChangesetEventKind = "gitlab:mark_wip"
 	ChangesetEventKindGitLabUnmarkWorkInProgress ChangesetEventKind = "gitlab:unmark_wip"
 
+	ChangesetEventKindBitbucketCloudApproved         ChangesetEventKind = "bitbucketcloud:participant_status:approved"
+	ChangesetEventKindBitbucketCloudChangesRequested ChangesetEventKind = "bitbucketcloud:participant_status:changes_requested"
+	ChangesetEventKindBitbucketCloudCommitStatus     ChangesetEventKind = "bitbucketcloud:commit_status"
+
+	ChangesetEventKindBitbucketCloudPullRequestApproved              ChangesetEventKind = "bitbucketcloud:pullrequest:approved"
+	ChangesetEventKindBitbucketCloudPullRequestUnapproved            ChangesetEventKind = "bitbucketcloud:pullrequest:unapproved"
+	ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated ChangesetEventKind = "bitbucketcloud:pullrequest:changes_request_created"
+	ChangesetEventKindBitbucketCloudPullRequestChangesRequestRemoved ChangesetEventKind = "bitbucketcloud:pullrequest:changes_request_removed"
+	ChangesetEventKindBitbucketCloudPullRequestFulfilled             ChangesetEventKind = "bitbucketcloud:pullrequest:fulfilled"
+	ChangesetEventKindBitbucketCloudPullRequestRejected              ChangesetEventKind = "bitbucketcloud:pullrequest:rejected"
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -121,6 +133,21 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 	case *gitlab.ReviewUnapprovedEvent:
 		return meta.Author.Username
 
+	case *bitbucketcloud.Participant:
+		return meta.User.UUID
+
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		return meta.Approval.User.UUID
+
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		return meta.Approval.User.UUID
+
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		return meta.ChangesRequest.User.UUID
+
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		return meta.ChangesRequest.User.UUID
+
 	default:
 		return ""
 	}
@@ -130,9 +157,15 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	switch e.Kind {
 	case ChangesetEventKindBitbucketServerApproved,
-		ChangesetEventKindGitLabApproved:
+		ChangesetEventKindGitLabApproved,
+		ChangesetEventKindBitbucketCloudApproved,
+		ChangesetEventKindBitbucketCloudPullRequestApproved:
 		return ChangesetReviewStateApproved, nil
 
+	case ChangesetEventKindBitbucketCloudChangesRequested,
+		ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated:
+		return ChangesetReviewStateChangesRequested, nil
+
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
 	// the "Needs work" button in the UI, which is why we map it to "Changes Requested"
 	case ChangesetEventKindBitbucketServerReviewed:
@@ -155,7 +188,9 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 	case ChangesetEventKindGitHubReviewDismissed,
 		ChangesetEventKindBitbucketServerUnapproved,
 		ChangesetEventKindBitbucketServerDismissed,
-		ChangesetEventKindGitLabUnapproved:
+		ChangesetEventKindGitLabUnapproved,
+		ChangesetEventKindBitbucketCloudPullRequestUnapproved,
+		ChangesetEventKindBitbucketCloudPullRequestChangesRequestRemoved:
 		return ChangesetReviewStateDismissed, nil
 
 	default:
@@ -240,6 +275,22 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		// fall back to the event record we created when we received the
 		// webhook.
 		t = e.CreatedAt
+	case *bitbucketcloud.Participant:
+		t = ev.ParticipatedOn
+	case *bitbucketcloud.PullRequestStatus:
+		t = ev.UpdatedOn
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		t = ev.Approval.Date
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		t = ev.Approval.Date
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		t = ev.ChangesRequest.Date
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		t = ev.ChangesRequest.Date
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		t = ev.PullRequest.UpdatedOn
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		t = ev.PullRequest.UpdatedOn
 	}
 
 	return t
@@ -722,6 +773,32 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 		// We always get the full event, so safe to replace it
 		*e = *o
 
+	// Bitbucket Cloud always returns the full participant, status, or event,
+	// so it is safe to replace them.
+	case *bitbucketcloud.Participant:
+		*e = *o.Metadata.(*bitbucketcloud.Participant)
+
+	case *bitbucketcloud.PullRequestStatus:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestStatus)
+
+	case *bitbucketcloud.PullRequestApprovedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestApprovedEvent)
+
+	case *bitbucketcloud.PullRequestUnapprovedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestUnapprovedEvent)
+
+	case *bitbucketcloud.PullRequestChangesRequestCreatedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestChangesRequestCreatedEvent)
+
+	case *bitbucketcloud.PullRequestChangesRequestRemovedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestChangesRequestRemovedEvent)
+
+	case *bitbucketcloud.PullRequestFulfilledEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestFulfilledEvent)
+
+	case *bitbucketcloud.PullRequestRejectedEvent:
+		*e = *o.Metadata.(*bitbucketcloud.PullRequestRejectedEvent)
+
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
@@ -452,6 +452,49 @@ func TestChangeset_BaseRef(t *testing.T) {
 	})
 }
 
+func TestChangeset_IsOutdated(t *testing.T) {
+	for name, tc := range map[string]struct {
+		meta interface{}
+		want bool
+	}{
+		"GitHub mergeable": {
+			meta: &github.PullRequest{Mergeable: "MERGEABLE"},
+			want: false,
+		},
+		"GitHub conflicting": {
+			meta: &github.PullRequest{Mergeable: "CONFLICTING"},
+			want: true,
+		},
+		"GitHub behind": {
+			meta: &github.PullRequest{Mergeable: "MERGEABLE", MergeStateStatus: "BEHIND"},
+			want: true,
+		},
+		"GitLab up to date": {
+			meta: &gitlab.MergeRequest{},
+			want: false,
+		},
+		"GitLab conflicting": {
+			meta: &gitlab.MergeRequest{HasConflicts: true},
+			want: true,
+		},
+		"GitLab behind": {
+			meta: &gitlab.MergeRequest{DivergedCommitsCount: 2},
+			want: true,
+		},
+		"Bitbucket Server": {
+			meta: &bitbucketserver.PullRequest{},
+			want: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := &Changeset{Metadata: tc.meta}
+			if have := c.IsOutdated(); have != tc.want {
+				t.Errorf("unexpected result: have=%v want=%v", have, tc.want)
+			}
+		})
+	}
+}
+
 func TestChangeset_Labels(t *testing.T) {
 	for name, tc := range map[string]struct {
 		meta interface{}
@@ -0,0 +1,317 @@
+package uploadstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// filesystemExpireInterval is the minimum time between two scans for expired
+// objects.
+const filesystemExpireInterval = time.Hour
+
+// filesystemTempPrefix is the prefix of files which are still being written.
+// They are renamed to their final key once complete, so readers never observe
+// partial objects.
+const filesystemTempPrefix = ".tmp-"
+
+type filesystemStore struct {
+	dir        string
+	ttl        time.Duration
+	operations *operations
+	now        func() time.Time
+
+	m           sync.Mutex
+	lastExpired time.Time
+}
+
+var _ Store = &filesystemStore{}
+
+type FilesystemConfig struct {
+	Dir string
+}
+
+func (c *FilesystemConfig) load(parent *env.BaseConfig) {
+	c.Dir = parent.Get("PRECISE_CODE_INTEL_UPLOAD_FILESYSTEM_DIR", "/data/uploads", "The directory to store uploads in. Must be shared by the frontend and precise-code-intel-worker.")
+}
+
+// newFilesystemFromConfig creates a new store backed by a local directory. Each
+// bucket is a subdirectory of the configured directory.
+func newFilesystemFromConfig(ctx context.Context, config *Config, operations *operations) (Store, error) {
+	return newFilesystemWithClock(filepath.Join(config.Filesystem.Dir, config.Bucket), config.TTL, operations, time.Now), nil
+}
+
+func newFilesystemWithClock(dir string, ttl time.Duration, operations *operations, now func() time.Time) *filesystemStore {
+	return &filesystemStore{
+		dir:        dir,
+		ttl:        ttl,
+		operations: operations,
+		now:        now,
+	}
+}
+
+func (s *filesystemStore) Init(ctx context.Context) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return errors.Wrap(err, "failed to create directory")
+	}
+
+	s.m.Lock()
+	s.lastExpired = s.now()
+	s.m.Unlock()
+
+	return s.expire()
+}
+
+func (s *filesystemStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	_, endObservation := s.operations.get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	// Objects may outlive their TTL until the next scan. Treat them as
+	// deleted already, like S3 and GCS do once the lifecycle rule applies.
+	if fi, err := f.Stat(); err == nil && s.expired(fi) {
+		_ = f.Close()
+		return nil, errors.Wrap(&fs.PathError{Op: "open", Path: path, Err: fs.ErrNotExist}, "failed to get object")
+	}
+
+	return f, nil
+}
+
+func (s *filesystemStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	_, endObservation := s.operations.upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	s.maybeExpire()
+
+	n, err := s.write(path, func(w io.Writer) (int64, error) {
+		return io.Copy(w, r)
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	_, endObservation := s.operations.compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(destination)
+	if err != nil {
+		return 0, err
+	}
+	sourcePaths := make([]string, 0, len(sources))
+	for _, source := range sources {
+		sourcePath, err := s.path(source)
+		if err != nil {
+			return 0, err
+		}
+		sourcePaths = append(sourcePaths, sourcePath)
+	}
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(sourcePaths); err != nil {
+				log15.Error("Failed to delete source objects", "error", err)
+			}
+		}
+	}()
+
+	n, err := s.write(path, func(w io.Writer) (int64, error) {
+		var total int64
+		for _, sourcePath := range sourcePaths {
+			n, err := copyFile(w, sourcePath)
+			if err != nil {
+				return 0, err
+			}
+			total += n
+		}
+		return total, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) Delete(ctx context.Context, key string) (err error) {
+	_, endObservation := s.operations.delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete object")
+	}
+
+	return nil
+}
+
+// path returns the path of the file storing the object with the given key. Keys
+// must not refer to files outside of the store directory.
+func (s *filesystemStore) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(filepath.Base(key), filesystemTempPrefix) {
+		return "", errors.Errorf("invalid key %q", key)
+	}
+	for _, part := range strings.Split(filepath.ToSlash(key), "/") {
+		if part == ".." {
+			return "", errors.Errorf("invalid key %q", key)
+		}
+	}
+
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+// write writes the content produced by fn to a temporary file and moves it to
+// path once fn succeeds.
+func (s *filesystemStore) write(path string, fn func(w io.Writer) (int64, error)) (_ int64, err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filesystemTempPrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	n, err := fn(tmp)
+	if closeErr := tmp.Close(); closeErr != nil {
+		err = multierror.Append(err, errors.Wrap(closeErr, "failed to close writer"))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) deleteSources(paths []string) error {
+	var errs error
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			errs = multierror.Append(errs, errors.Wrap(err, "failed to delete source object"))
+		}
+	}
+
+	return errs
+}
+
+// maybeExpire removes expired objects in the background, at most once every
+// filesystemExpireInterval.
+func (s *filesystemStore) maybeExpire() {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastExpired) < filesystemExpireInterval {
+		return
+	}
+	s.lastExpired = now
+
+	go func() {
+		if err := s.expire(); err != nil {
+			log15.Error("Failed to remove expired objects", "error", err)
+		}
+	}()
+}
+
+// expire removes all objects which are older than the store's TTL, as well as
+// temporary files of writes which did not complete within the TTL.
+func (s *filesystemStore) expire() error {
+	if s.ttl <= 0 {
+		return nil
+	}
+
+	return filepath.WalkDir(s.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Removed concurrently
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		fi, err := d.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if s.expired(fi) {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *filesystemStore) expired(fi fs.FileInfo) bool {
+	return s.ttl > 0 && s.now().Sub(fi.ModTime()) > s.ttl
+}
+
+func copyFile(w io.Writer, path string) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return io.Copy(w, f)
+}
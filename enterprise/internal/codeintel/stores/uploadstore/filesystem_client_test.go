@@ -0,0 +1,204 @@
+package uploadstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestFilesystemInit(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "test-bucket")
+	client := testFilesystemClient(dir, time.Hour, time.Now)
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	if fi, err := os.Stat(dir); err != nil {
+		t.Fatalf("unexpected error statting directory: %s", err)
+	} else if !fi.IsDir() {
+		t.Fatalf("expected %s to be a directory", dir)
+	}
+}
+
+func TestFilesystemUploadGet(t *testing.T) {
+	client := testFilesystemClient(t.TempDir(), time.Hour, time.Now)
+
+	size, err := client.Upload(context.Background(), "test-key", bytes.NewReader([]byte("TEST PAYLOAD")))
+	if err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if size != 12 {
+		t.Errorf("unexpected size. want=%d have=%d", 12, size)
+	}
+
+	if contents := readFilesystemObject(t, client, "test-key"); contents != "TEST PAYLOAD" {
+		t.Fatalf("unexpected contents. want=%q have=%q", "TEST PAYLOAD", contents)
+	}
+
+	if _, err := client.Get(context.Background(), "missing-key"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("unexpected error getting missing object: %s", err)
+	}
+}
+
+func TestFilesystemUploadFailure(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, time.Hour, time.Now)
+
+	if _, err := client.Upload(context.Background(), "test-key", io.MultiReader(strings.NewReader("partial"), errReader{})); err == nil {
+		t.Fatalf("expected error uploading object")
+	}
+
+	// Neither the object nor the temporary file should exist.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("unexpected files after failed upload: %v", entries)
+	}
+}
+
+func TestFilesystemCompose(t *testing.T) {
+	client := testFilesystemClient(t.TempDir(), time.Hour, time.Now)
+
+	for i, payload := range []string{"foo", "bar", "baz"} {
+		key := "test-src" + string(rune('1'+i))
+		if _, err := client.Upload(context.Background(), key, strings.NewReader(payload)); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	size, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2", "test-src3")
+	if err != nil {
+		t.Fatalf("unexpected error composing objects: %s", err)
+	}
+	if size != 9 {
+		t.Errorf("unexpected size. want=%d have=%d", 9, size)
+	}
+
+	if contents := readFilesystemObject(t, client, "test-key"); contents != "foobarbaz" {
+		t.Fatalf("unexpected contents. want=%q have=%q", "foobarbaz", contents)
+	}
+
+	for _, key := range []string{"test-src1", "test-src2", "test-src3"} {
+		if _, err := client.Get(context.Background(), key); err == nil {
+			t.Errorf("expected source object %s to be deleted", key)
+		}
+	}
+}
+
+func TestFilesystemComposeMissingSource(t *testing.T) {
+	client := testFilesystemClient(t.TempDir(), time.Hour, time.Now)
+
+	if _, err := client.Upload(context.Background(), "test-src1", strings.NewReader("foo")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if _, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2"); err == nil {
+		t.Fatalf("expected error composing objects")
+	}
+
+	// Sources are kept if the compose fails.
+	if contents := readFilesystemObject(t, client, "test-src1"); contents != "foo" {
+		t.Fatalf("unexpected contents. want=%q have=%q", "foo", contents)
+	}
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Fatalf("expected destination object to not exist")
+	}
+}
+
+func TestFilesystemDelete(t *testing.T) {
+	client := testFilesystemClient(t.TempDir(), time.Hour, time.Now)
+
+	if _, err := client.Upload(context.Background(), "test-key", strings.NewReader("foo")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if err := client.Delete(context.Background(), "test-key"); err != nil {
+		t.Fatalf("unexpected error deleting object: %s", err)
+	}
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Fatalf("expected object to be deleted")
+	}
+
+	// Deleting a missing object is not an error.
+	if err := client.Delete(context.Background(), "test-key"); err != nil {
+		t.Fatalf("unexpected error deleting missing object: %s", err)
+	}
+}
+
+func TestFilesystemInvalidKeys(t *testing.T) {
+	client := testFilesystemClient(filepath.Join(t.TempDir(), "test-bucket"), time.Hour, time.Now)
+
+	for _, key := range []string{"", "../test-key", "a/../../test-key", ".tmp-123"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("foo")); err == nil {
+			t.Errorf("expected error uploading object with key %q", key)
+		}
+	}
+}
+
+func TestFilesystemExpire(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	client := testFilesystemClient(dir, time.Hour, func() time.Time { return now })
+
+	for _, key := range []string{"old-key", "new-key"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("foo")); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+	old := now.Add(-2 * time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "old-key"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired objects are not returned even before they're removed.
+	if _, err := client.Get(context.Background(), "old-key"); err == nil {
+		t.Fatalf("expected expired object to not be returned")
+	}
+
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "old-key")); !os.IsNotExist(err) {
+		t.Fatalf("expected expired object to be removed")
+	}
+	if contents := readFilesystemObject(t, client, "new-key"); contents != "foo" {
+		t.Fatalf("unexpected contents. want=%q have=%q", "foo", contents)
+	}
+}
+
+func testFilesystemClient(dir string, ttl time.Duration, now func() time.Time) Store {
+	return newFilesystemWithClock(dir, ttl, newOperations(&observation.TestContext), now)
+}
+
+func readFilesystemObject(t *testing.T, client Store, key string) string {
+	t.Helper()
+
+	rc, err := client.Get(context.Background(), key)
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	}
+
+	return string(contents)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("uh-oh")
+}
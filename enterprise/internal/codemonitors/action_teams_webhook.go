@@ -0,0 +1,193 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+type TeamsWebhookAction struct {
+	ID      int64
+	Monitor int64
+	Enabled bool
+	URL     string
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+const updateTeamsWebhookActionQuery = `
+UPDATE cm_teams_webhooks
+SET enabled = %s,
+	url = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE id = %s
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateTeamsWebhookAction(ctx context.Context, id int64, enabled bool, url string) (*TeamsWebhookAction, error) {
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		updateTeamsWebhookActionQuery,
+		enabled,
+		url,
+		a.UID,
+		s.Now(),
+		id,
+		sqlf.Join(teamsWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanTeamsWebhookAction(row)
+}
+
+const createTeamsWebhookActionQuery = `
+INSERT INTO cm_teams_webhooks
+(monitor, enabled, url, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateTeamsWebhookAction(ctx context.Context, monitorID int64, enabled bool, url string) (*TeamsWebhookAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createTeamsWebhookActionQuery,
+		monitorID,
+		enabled,
+		url,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(teamsWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanTeamsWebhookAction(row)
+}
+
+const deleteTeamsWebhookActionQuery = `
+DELETE FROM cm_teams_webhooks
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteTeamsWebhookActions(ctx context.Context, monitorID int64, webhookIDs ...int64) error {
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(webhookIDs))
+	for _, ids := range webhookIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteTeamsWebhookActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countTeamsWebhookActionsQuery = `
+SELECT COUNT(*)
+FROM cm_teams_webhooks
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountTeamsWebhookActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countTeamsWebhookActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getTeamsWebhookActionQuery = `
+SELECT %s -- TeamsWebhookActionColumns
+FROM cm_teams_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetTeamsWebhookAction(ctx context.Context, id int64) (*TeamsWebhookAction, error) {
+	q := sqlf.Sprintf(
+		getTeamsWebhookActionQuery,
+		sqlf.Join(teamsWebhookActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanTeamsWebhookAction(row)
+}
+
+const listTeamsWebhookActionsQuery = `
+SELECT %s -- TeamsWebhookActionColumns
+FROM cm_teams_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListTeamsWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*TeamsWebhookAction, error) {
+	q := sqlf.Sprintf(
+		listTeamsWebhookActionsQuery,
+		sqlf.Join(teamsWebhookActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanTeamsWebhookActions(rows)
+}
+
+// teamsWebhookActionColumns is the set of columns in the cm_teams_webhooks table
+// This must be kept in sync with scanTeamsWebhook
+var teamsWebhookActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_teams_webhooks.id"),
+	sqlf.Sprintf("cm_teams_webhooks.monitor"),
+	sqlf.Sprintf("cm_teams_webhooks.enabled"),
+	sqlf.Sprintf("cm_teams_webhooks.url"),
+	sqlf.Sprintf("cm_teams_webhooks.created_by"),
+	sqlf.Sprintf("cm_teams_webhooks.created_at"),
+	sqlf.Sprintf("cm_teams_webhooks.changed_by"),
+	sqlf.Sprintf("cm_teams_webhooks.changed_at"),
+}
+
+func scanTeamsWebhookActions(rows *sql.Rows) ([]*TeamsWebhookAction, error) {
+	var ws []*TeamsWebhookAction
+	for rows.Next() {
+		w, err := scanTeamsWebhookAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// scanTeamsWebhookAction scans a TeamsWebhookAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with teamsWebhookActionColumns.
+func scanTeamsWebhookAction(scanner dbutil.Scanner) (*TeamsWebhookAction, error) {
+	var w TeamsWebhookAction
+	err := scanner.Scan(
+		&w.ID,
+		&w.Monitor,
+		&w.Enabled,
+		&w.URL,
+		&w.CreatedBy,
+		&w.CreatedAt,
+		&w.ChangedBy,
+		&w.ChangedAt,
+	)
+	return &w, err
+}
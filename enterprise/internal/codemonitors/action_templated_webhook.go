@@ -0,0 +1,213 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+type TemplatedWebhookAction struct {
+	ID              int64
+	Monitor         int64
+	Enabled         bool
+	URL             string
+	ContentType     string
+	PayloadTemplate string
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+type TemplatedWebhookActionArgs struct {
+	Enabled         bool
+	URL             string
+	ContentType     string
+	PayloadTemplate string
+}
+
+const updateTemplatedWebhookActionQuery = `
+UPDATE cm_templated_webhooks
+SET enabled = %s,
+	url = %s,
+	content_type = %s,
+	payload_template = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE id = %s
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateTemplatedWebhookAction(ctx context.Context, id int64, args *TemplatedWebhookActionArgs) (*TemplatedWebhookAction, error) {
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		updateTemplatedWebhookActionQuery,
+		args.Enabled,
+		args.URL,
+		args.ContentType,
+		args.PayloadTemplate,
+		a.UID,
+		s.Now(),
+		id,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanTemplatedWebhookAction(row)
+}
+
+const createTemplatedWebhookActionQuery = `
+INSERT INTO cm_templated_webhooks
+(monitor, enabled, url, content_type, payload_template, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateTemplatedWebhookAction(ctx context.Context, monitorID int64, args *TemplatedWebhookActionArgs) (*TemplatedWebhookAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createTemplatedWebhookActionQuery,
+		monitorID,
+		args.Enabled,
+		args.URL,
+		args.ContentType,
+		args.PayloadTemplate,
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanTemplatedWebhookAction(row)
+}
+
+const deleteTemplatedWebhookActionQuery = `
+DELETE FROM cm_templated_webhooks
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteTemplatedWebhookActions(ctx context.Context, monitorID int64, webhookIDs ...int64) error {
+	if len(webhookIDs) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(webhookIDs))
+	for _, ids := range webhookIDs {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", ids))
+	}
+	q := sqlf.Sprintf(
+		deleteTemplatedWebhookActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countTemplatedWebhookActionsQuery = `
+SELECT COUNT(*)
+FROM cm_templated_webhooks
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountTemplatedWebhookActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countTemplatedWebhookActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getTemplatedWebhookActionQuery = `
+SELECT %s -- TemplatedWebhookActionColumns
+FROM cm_templated_webhooks
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetTemplatedWebhookAction(ctx context.Context, id int64) (*TemplatedWebhookAction, error) {
+	q := sqlf.Sprintf(
+		getTemplatedWebhookActionQuery,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanTemplatedWebhookAction(row)
+}
+
+const listTemplatedWebhookActionsQuery = `
+SELECT %s -- TemplatedWebhookActionColumns
+FROM cm_templated_webhooks
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListTemplatedWebhookActions(ctx context.Context, opts ListActionsOpts) ([]*TemplatedWebhookAction, error) {
+	q := sqlf.Sprintf(
+		listTemplatedWebhookActionsQuery,
+		sqlf.Join(templatedWebhookActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanTemplatedWebhookActions(rows)
+}
+
+// templatedWebhookActionColumns is the set of columns in the
+// cm_templated_webhooks table. This must be kept in sync with
+// scanTemplatedWebhookAction.
+var templatedWebhookActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_templated_webhooks.id"),
+	sqlf.Sprintf("cm_templated_webhooks.monitor"),
+	sqlf.Sprintf("cm_templated_webhooks.enabled"),
+	sqlf.Sprintf("cm_templated_webhooks.url"),
+	sqlf.Sprintf("cm_templated_webhooks.content_type"),
+	sqlf.Sprintf("cm_templated_webhooks.payload_template"),
+	sqlf.Sprintf("cm_templated_webhooks.created_by"),
+	sqlf.Sprintf("cm_templated_webhooks.created_at"),
+	sqlf.Sprintf("cm_templated_webhooks.changed_by"),
+	sqlf.Sprintf("cm_templated_webhooks.changed_at"),
+}
+
+func scanTemplatedWebhookActions(rows *sql.Rows) ([]*TemplatedWebhookAction, error) {
+	var ws []*TemplatedWebhookAction
+	for rows.Next() {
+		w, err := scanTemplatedWebhookAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		ws = append(ws, w)
+	}
+	return ws, rows.Err()
+}
+
+// scanTemplatedWebhookAction scans a TemplatedWebhookAction from a *sql.Row or
+// *sql.Rows. It must be kept in sync with templatedWebhookActionColumns.
+func scanTemplatedWebhookAction(scanner dbutil.Scanner) (*TemplatedWebhookAction, error) {
+	var w TemplatedWebhookAction
+	err := scanner.Scan(
+		&w.ID,
+		&w.Monitor,
+		&w.Enabled,
+		&w.URL,
+		&w.ContentType,
+		&w.PayloadTemplate,
+		&w.CreatedBy,
+		&w.CreatedAt,
+		&w.ChangedBy,
+		&w.ChangedAt,
+	)
+	return &w, err
+}
@@ -0,0 +1,155 @@
+package codemonitors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreTemplatedWebhooks(t *testing.T) {
+	ctx := context.Background()
+	args1 := &TemplatedWebhookActionArgs{
+		Enabled:         true,
+		URL:             "https://icanhazcheezburger.com/templated_webhook",
+		ContentType:     "application/json",
+		PayloadTemplate: `{"summary": {{ json .Monitor.Description }}}`,
+	}
+	args2 := &TemplatedWebhookActionArgs{
+		Enabled:         false,
+		URL:             "https://icanthazcheezburger.com/templated_webhook",
+		ContentType:     "text/plain",
+		PayloadTemplate: "{{ .Results.Count }} new results",
+	}
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(dbtest.NewDB(t))
+		_, _, _, ctx := newTestUser(ctx, t, db)
+		s := NewStore(db)
+		fixtures, err := s.insertTestMonitor(ctx, t)
+		require.NoError(t, err)
+
+		action, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		got, err := s.GetTemplatedWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+	})
+
+	t.Run("CreateUpdateGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(dbtest.NewDB(t))
+		_, _, _, ctx := newTestUser(ctx, t, db)
+		s := NewStore(db)
+		fixtures, err := s.insertTestMonitor(ctx, t)
+		require.NoError(t, err)
+
+		action, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		updated, err := s.UpdateTemplatedWebhookAction(ctx, action.ID, args2)
+		require.NoError(t, err)
+		require.Equal(t, false, updated.Enabled)
+		require.Equal(t, args2.URL, updated.URL)
+		require.Equal(t, args2.ContentType, updated.ContentType)
+		require.Equal(t, args2.PayloadTemplate, updated.PayloadTemplate)
+
+		got, err := s.GetTemplatedWebhookAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+	})
+
+	t.Run("ErrorOnUpdateNonexistent", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(dbtest.NewDB(t))
+		_, _, _, ctx := newTestUser(ctx, t, db)
+		s := NewStore(db)
+
+		_, err := s.UpdateTemplatedWebhookAction(ctx, 383838, args2)
+		require.Error(t, err)
+	})
+
+	t.Run("CreateDeleteGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(dbtest.NewDB(t))
+		_, _, _, ctx := newTestUser(ctx, t, db)
+		s := NewStore(db)
+		fixtures, err := s.insertTestMonitor(ctx, t)
+		require.NoError(t, err)
+
+		action1, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		action2, err := s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		err = s.DeleteTemplatedWebhookActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetTemplatedWebhookAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		_, err = s.GetTemplatedWebhookAction(ctx, action2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("CountCreateCount", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(dbtest.NewDB(t))
+		_, _, _, ctx := newTestUser(ctx, t, db)
+		s := NewStore(db)
+		fixtures, err := s.insertTestMonitor(ctx, t)
+		require.NoError(t, err)
+
+		count, err := s.CountTemplatedWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		_, err = s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		count, err = s.CountTemplatedWebhookActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 1, count)
+	})
+
+	t.Run("ListCreateList", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(dbtest.NewDB(t))
+		_, _, _, ctx := newTestUser(ctx, t, db)
+		s := NewStore(db)
+		fixtures, err := s.insertTestMonitor(ctx, t)
+		require.NoError(t, err)
+
+		actions, err := s.ListTemplatedWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions, 0)
+
+		_, err = s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		_, err = s.CreateTemplatedWebhookAction(ctx, fixtures.monitor.ID, args2)
+		require.NoError(t, err)
+
+		actions2, err := s.ListTemplatedWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID})
+		require.NoError(t, err)
+		require.Len(t, actions2, 2)
+
+		first := 1
+		actions3, err := s.ListTemplatedWebhookActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID, First: &first})
+		require.NoError(t, err)
+		require.Len(t, actions3, 1)
+	})
+}
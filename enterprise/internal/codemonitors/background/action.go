@@ -1,5 +1,11 @@
 package background
 
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+)
+
 // actionArgs is the shared set of arguments needed to execute any
 // action for code monitors.
 type actionArgs struct {
@@ -9,3 +15,20 @@ type actionArgs struct {
 	QueryURL           string
 	NumResults         int
 }
+
+// newTestActionArgs returns the arguments used to send a test notification
+// for a code monitor that may not have been created yet. Since there is no
+// monitor or search to link to, both URLs point to the code monitoring page.
+func newTestActionArgs(ctx context.Context, monitorDescription, utmSource string) (actionArgs, error) {
+	codeMonitorsURL, err := sourcegraphURL(ctx, "code-monitoring", "", utmSource)
+	if err != nil {
+		return actionArgs{}, errors.Wrap(err, "GetCodeMonitorURL")
+	}
+
+	return actionArgs{
+		MonitorDescription: monitorDescription,
+		MonitorURL:         codeMonitorsURL,
+		QueryURL:           codeMonitorsURL,
+		NumResults:         1,
+	}, nil
+}
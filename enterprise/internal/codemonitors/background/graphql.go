@@ -8,6 +8,8 @@ import (
 	"net/http"
 	"net/url"
 	"runtime"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -34,6 +36,12 @@ const gqlSearchQuery = `query CodeMonitorSearch(
 			results {
 				__typename
 				... on FileMatch {
+					repository {
+						name
+					}
+					file {
+						path
+					}
 					limitHit
 					lineMatches {
 						preview
@@ -90,6 +98,9 @@ const gqlSearchQuery = `query CodeMonitorSearch(
 						message
 					}
 				}
+				... on Repository {
+					name
+				}
 			}
 			alert {
 				title
@@ -112,6 +123,7 @@ type gqlSearchResponse struct {
 		Search struct {
 			Results struct {
 				ApproximateResultCount string
+				LimitHit               bool
 				Cloning                []*api.Repo
 				Timedout               []*api.Repo
 				Results                []interface{}
@@ -202,3 +214,60 @@ func extractTime(result interface{}) (t *time.Time, err error) {
 		return nil, errors.Errorf("unexpected result __typename %q", typeName)
 	}
 }
+
+// resultKeys returns a sorted list of keys identifying the given search
+// results, which we use to diff the result sets of consecutive runs of a
+// trigger with TriggerTypeContentDelta.
+//
+// Line matches are identified by their content rather than their line
+// number, so that edits elsewhere in a file don't show up as changes.
+func resultKeys(results []interface{}) (keys []string, err error) {
+	// Use recover because we assume the data structure here a lot, for less
+	// error checking.
+	defer func() {
+		if r := recover(); r != nil {
+			// Same as net/http
+			const size = 64 << 10
+			buf := make([]byte, size)
+			buf = buf[:runtime.Stack(buf, false)]
+			log.Printf("failed to extract keys from search results: %v\n%s", r, buf)
+			err = errors.Errorf("failed to extract keys from search results")
+		}
+	}()
+
+	set := make(map[string]struct{}, len(results))
+	add := func(parts ...string) {
+		set[strings.Join(parts, "\x00")] = struct{}{}
+	}
+	for _, result := range results {
+		m := result.(map[string]interface{})
+		typeName := m["__typename"].(string)
+		switch typeName {
+		case "FileMatch":
+			repo := m["repository"].(map[string]interface{})["name"].(string)
+			path := m["file"].(map[string]interface{})["path"].(string)
+			lineMatches, _ := m["lineMatches"].([]interface{})
+			if len(lineMatches) == 0 {
+				add(repo, path)
+			}
+			for _, lm := range lineMatches {
+				add(repo, path, lm.(map[string]interface{})["preview"].(string))
+			}
+		case "CommitSearchResult":
+			commit := m["commit"].(map[string]interface{})
+			repo := commit["repository"].(map[string]interface{})["name"].(string)
+			add(repo, commit["oid"].(string))
+		case "Repository":
+			add(m["name"].(string))
+		default:
+			return nil, errors.Errorf("unexpected result __typename %q", typeName)
+		}
+	}
+
+	keys = make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
@@ -0,0 +1,72 @@
+package background
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResultKeys(t *testing.T) {
+	var results []interface{}
+	err := json.Unmarshal([]byte(`[
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "github.com/sourcegraph/sourcegraph"},
+			"file": {"path": "main.go"},
+			"lineMatches": [
+				{"preview": "deprecated()", "lineNumber": 1},
+				{"preview": "deprecated()", "lineNumber": 7},
+				{"preview": "x := deprecated()", "lineNumber": 9}
+			]
+		},
+		{
+			"__typename": "FileMatch",
+			"repository": {"name": "github.com/sourcegraph/sourcegraph"},
+			"file": {"path": "deprecated.go"},
+			"lineMatches": []
+		},
+		{
+			"__typename": "CommitSearchResult",
+			"commit": {"repository": {"name": "github.com/sourcegraph/zoekt"}, "oid": "deadbeef"}
+		},
+		{
+			"__typename": "Repository",
+			"name": "github.com/sourcegraph/about"
+		}
+	]`), &results)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := resultKeys(results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"github.com/sourcegraph/about",
+		"github.com/sourcegraph/sourcegraph\x00deprecated.go",
+		"github.com/sourcegraph/sourcegraph\x00main.go\x00deprecated()",
+		"github.com/sourcegraph/sourcegraph\x00main.go\x00x := deprecated()",
+		"github.com/sourcegraph/zoekt\x00deadbeef",
+	}
+	if d := cmp.Diff(want, got); d != "" {
+		t.Fatalf("mismatch (-want +got):\n%s", d)
+	}
+
+	if _, err := resultKeys([]interface{}{map[string]interface{}{"__typename": "FileMatch"}}); err == nil {
+		t.Fatal("expected error for malformed result")
+	}
+}
+
+func TestNewQueryWithCount(t *testing.T) {
+	cases := map[string]string{
+		"deprecated()":          "deprecated() count:10000",
+		"deprecated() count:50": "deprecated() count:50",
+	}
+	for in, want := range cases {
+		if got := newQueryWithCount(in); got != want {
+			t.Errorf("newQueryWithCount(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+func sendTeamsNotification(ctx context.Context, url string, args actionArgs) error {
+	return postTeamsWebhook(ctx, httpcli.ExternalDoer, url, teamsPayload(args))
+}
+
+// teamsMessageCard is a legacy actionable message card, which is the format
+// accepted by Microsoft Teams incoming webhooks.
+//
+// See https://docs.microsoft.com/en-us/outlook/actionable-messages/message-card-reference
+type teamsMessageCard struct {
+	Type            string               `json:"@type"`
+	Context         string               `json:"@context"`
+	Summary         string               `json:"summary"`
+	ThemeColor      string               `json:"themeColor,omitempty"`
+	Title           string               `json:"title"`
+	Text            string               `json:"text"`
+	PotentialAction []teamsOpenURIAction `json:"potentialAction,omitempty"`
+}
+
+type teamsOpenURIAction struct {
+	Type    string           `json:"@type"`
+	Name    string           `json:"name"`
+	Targets []teamsURITarget `json:"targets"`
+}
+
+type teamsURITarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+func teamsPayload(args actionArgs) *teamsMessageCard {
+	openURI := func(name, uri string) teamsOpenURIAction {
+		return teamsOpenURIAction{
+			Type:    "OpenUri",
+			Name:    name,
+			Targets: []teamsURITarget{{OS: "default", URI: uri}},
+		}
+	}
+
+	title := fmt.Sprintf("New results for Code Monitor \"%s\"", args.MonitorDescription)
+	return &teamsMessageCard{
+		Type:       "MessageCard",
+		Context:    "https://schema.org/extensions",
+		Summary:    title,
+		ThemeColor: "0078D7",
+		Title:      title,
+		Text:       fmt.Sprintf("%d new results for query: `%s`", args.NumResults, args.Query),
+		PotentialAction: []teamsOpenURIAction{
+			openURI("View search on Sourcegraph", args.QueryURL),
+			openURI("View code monitor", args.MonitorURL),
+		},
+	}
+}
+
+func postTeamsWebhook(ctx context.Context, doer httpcli.Doer, url string, card *teamsMessageCard) error {
+	raw, err := json.Marshal(card)
+	if err != nil {
+		return errors.Wrap(err, "marshal failed")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(raw))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
+// SendTestTeamsWebhook sends a test notification for a code monitor with the
+// given description to a Microsoft Teams incoming webhook.
+func SendTestTeamsWebhook(ctx context.Context, description, url string) error {
+	args, err := newTestActionArgs(ctx, description, "code-monitor-teams-webhook")
+	if err != nil {
+		return err
+	}
+	return sendTeamsNotification(ctx, url, args)
+}
@@ -0,0 +1,130 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"text/template"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+)
+
+// DefaultTemplatedWebhookContentType is the Content-Type of templated webhook
+// requests if the action doesn't specify one.
+const DefaultTemplatedWebhookContentType = "application/json"
+
+// templatedWebhookData is the data the payload template of a templated
+// webhook action is executed with.
+type templatedWebhookData struct {
+	Monitor struct {
+		Description string
+		URL         string
+	}
+	Query struct {
+		Text string
+		URL  string
+	}
+	Results struct {
+		Count int
+	}
+	// IsTest is true if the payload is sent by a test action.
+	IsTest bool
+}
+
+func newTemplatedWebhookData(args actionArgs, isTest bool) *templatedWebhookData {
+	var d templatedWebhookData
+	d.Monitor.Description = args.MonitorDescription
+	d.Monitor.URL = args.MonitorURL
+	d.Query.Text = args.Query
+	d.Query.URL = args.QueryURL
+	d.Results.Count = args.NumResults
+	d.IsTest = isTest
+	return &d
+}
+
+var templatedWebhookFuncs = template.FuncMap{
+	// json encodes a value as JSON, so that values can safely be embedded in
+	// JSON payloads, e.g. {"text": {{json .Monitor.Description}}}.
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// ValidateTemplatedWebhookPayloadTemplate returns an error if payloadTemplate
+// is not a valid template, or fails to execute with sample data.
+func ValidateTemplatedWebhookPayloadTemplate(payloadTemplate string) error {
+	_, err := renderTemplatedWebhookPayload(payloadTemplate, newTemplatedWebhookData(actionArgs{
+		MonitorDescription: "My code monitor",
+		MonitorURL:         "https://sourcegraph.example.com/code-monitoring",
+		Query:              "repo:^github\\.com/sourcegraph/sourcegraph$ type:diff TODO",
+		QueryURL:           "https://sourcegraph.example.com/search",
+		NumResults:         1,
+	}, true))
+	return err
+}
+
+func renderTemplatedWebhookPayload(payloadTemplate string, data *templatedWebhookData) ([]byte, error) {
+	t, err := template.New("payload").Funcs(templatedWebhookFuncs).Option("missingkey=error").Parse(payloadTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing payload template")
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, "executing payload template")
+	}
+	return buf.Bytes(), nil
+}
+
+func sendTemplatedWebhookNotification(ctx context.Context, url, contentType, payloadTemplate string, args actionArgs, isTest bool) error {
+	payload, err := renderTemplatedWebhookPayload(payloadTemplate, newTemplatedWebhookData(args, isTest))
+	if err != nil {
+		return err
+	}
+	return postTemplatedWebhook(ctx, httpcli.ExternalDoer, url, contentType, payload)
+}
+
+func postTemplatedWebhook(ctx context.Context, doer httpcli.Doer, url, contentType string, payload []byte) error {
+	if contentType == "" {
+		contentType = DefaultTemplatedWebhookContentType
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post webhook")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
+// SendTestTemplatedWebhook sends a test notification for a code monitor with
+// the given description to a templated webhook.
+func SendTestTemplatedWebhook(ctx context.Context, description string, action *codemonitors.TemplatedWebhookActionArgs) error {
+	args, err := newTestActionArgs(ctx, description, "code-monitor-templated-webhook")
+	if err != nil {
+		return err
+	}
+	return sendTemplatedWebhookNotification(ctx, action.URL, action.ContentType, action.PayloadTemplate, args, true)
+}
@@ -0,0 +1,86 @@
+package background
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplatedWebhook(t *testing.T) {
+	t.Parallel()
+
+	action := actionArgs{
+		MonitorDescription: `My "test" monitor`,
+		MonitorURL:         "https://google.com",
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		QueryURL:           "https://youtube.com",
+		NumResults:         31313,
+	}
+
+	t.Run("no error", func(t *testing.T) {
+		payload, err := renderTemplatedWebhookPayload(
+			`{"title": {{json .Monitor.Description}}, "details": "{{.Results.Count}} results for {{.Query.Text}}", "link": {{json .Query.URL}}, "test": {{.IsTest}}}`,
+			newTemplatedWebhookData(action, false),
+		)
+		require.NoError(t, err)
+
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			require.Equal(t, `{"title": "My \"test\" monitor", "details": "31313 results for repo:camdentest -file:id_rsa.pub BEGIN", "link": "https://youtube.com", "test": false}`, string(b))
+			w.WriteHeader(202)
+		}))
+		defer s.Close()
+
+		err = postTemplatedWebhook(context.Background(), s.Client(), s.URL, "", payload)
+		require.NoError(t, err)
+	})
+
+	t.Run("custom content type", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+			w.WriteHeader(200)
+		}))
+		defer s.Close()
+
+		err := postTemplatedWebhook(context.Background(), s.Client(), s.URL, "text/plain", []byte("hello"))
+		require.NoError(t, err)
+	})
+
+	t.Run("error is returned", func(t *testing.T) {
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		}))
+		defer s.Close()
+
+		err := postTemplatedWebhook(context.Background(), s.Client(), s.URL, "", []byte("{}"))
+		require.Error(t, err)
+	})
+}
+
+func TestValidateTemplatedWebhookPayloadTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  bool
+	}{
+		{template: `{"text": {{json .Monitor.Description}}}`},
+		{template: `{{if .IsTest}}test{{end}} {{.Results.Count}} {{.Query.URL}} {{.Monitor.URL}}`},
+		{template: `{{.Monitor.Description`, wantErr: true},
+		{template: `{{.Monitor.Name}}`, wantErr: true},
+		{template: `{{unknown .Query.Text}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateTemplatedWebhookPayloadTemplate(tt.template)
+		if tt.wantErr && err == nil {
+			t.Errorf("expected error for template %q", tt.template)
+		} else if !tt.wantErr && err != nil {
+			t.Errorf("unexpected error for template %q: %s", tt.template, err)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	cm "github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors"
 	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -162,6 +163,10 @@ func (r *queryRunner) Handle(ctx context.Context, record workerutil.Record) (err
 		return err
 	}
 
+	if q.Type == cm.TriggerTypeContentDelta {
+		return r.handleContentDelta(ctx, s, triggerJob, q, m)
+	}
+
 	newQuery := newQueryWithAfterFilter(q)
 
 	// Search.
@@ -193,6 +198,59 @@ func (r *queryRunner) Handle(ctx context.Context, record workerutil.Record) (err
 	return nil
 }
 
+// handleContentDelta runs a trigger with TriggerTypeContentDelta. It diffs the
+// results of the query against the snapshot of the previous run and enqueues
+// actions if there are new results. The first run only takes a snapshot.
+func (r *queryRunner) handleContentDelta(ctx context.Context, s cm.CodeMonitorStore, triggerJob *cm.TriggerJob, q *cm.QueryTrigger, m *cm.Monitor) error {
+	newQuery := newQueryWithCount(q.QueryString)
+
+	results, err := search(ctx, newQuery, m.UserID)
+	if err != nil {
+		return err
+	}
+
+	var numAdded, numRemoved int
+	if complete(results) {
+		current, err := resultKeys(results.Data.Search.Results.Results)
+		if err != nil {
+			return err
+		}
+		snapshot, err := s.GetResultSnapshot(ctx, q.ID)
+		if err != nil {
+			return errors.Wrap(err, "GetResultSnapshot")
+		}
+		if snapshot != nil {
+			added, removed := cm.DiffResultKeys(snapshot.Keys, current)
+			numAdded, numRemoved = len(added), len(removed)
+		}
+		if numAdded > 0 {
+			_, err := s.EnqueueActionJobsForMonitor(ctx, m.ID, triggerJob.ID)
+			if err != nil {
+				return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+			}
+		}
+		if err := s.UpsertResultSnapshot(ctx, q.ID, current); err != nil {
+			return errors.Wrap(err, "UpsertResultSnapshot")
+		}
+	} else {
+		// Diffing an incomplete result set against the snapshot would report
+		// results we didn't get as removed, and report them as added once we
+		// get them again. Keep the snapshot and try again next run.
+		log15.Warn("code monitor search results are incomplete, skipping diff", "query", q.ID)
+	}
+
+	now := s.Clock()()
+	err = s.SetQueryTriggerNextRun(ctx, q.ID, now.Add(5*time.Minute), now.UTC())
+	if err != nil {
+		return err
+	}
+	err = s.UpdateTriggerJobWithDelta(ctx, triggerJob.ID, newQuery, numAdded, numRemoved)
+	if err != nil {
+		return errors.Wrap(err, "UpdateTriggerJobWithDelta")
+	}
+	return nil
+}
+
 type actionRunner struct {
 	cm.CodeMonitorStore
 }
@@ -217,8 +275,12 @@ func (r *actionRunner) Handle(ctx context.Context, record workerutil.Record) (er
 		return r.handleWebhook(ctx, j)
 	case j.SlackWebhook != nil:
 		return r.handleSlackWebhook(ctx, j)
+	case j.TeamsWebhook != nil:
+		return r.handleTeamsWebhook(ctx, j)
+	case j.TemplatedWebhook != nil:
+		return r.handleTemplatedWebhook(ctx, j)
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, teams webhook, or templated webhook")
 	}
 }
 
@@ -276,34 +338,70 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *cm.ActionJob) error
 		return errors.Wrap(err, "GetActionJobMetadata")
 	}
 
+	w, err := s.GetWebhookAction(ctx, *j.Webhook)
+	if err != nil {
+		return errors.Wrap(err, "GetWebhookAction")
+	}
+
+	args, err := newActionArgs(ctx, m, w.Monitor, "code-monitor-webhook")
+	if err != nil {
+		return err
+	}
+
+	return sendWebhookNotification(ctx, w.URL, args)
+}
+
+func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *cm.ActionJob) error {
+	s, err := r.CodeMonitorStore.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = s.Done(err) }()
+
+	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
+	}
+
 	w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
 	if err != nil {
 		return errors.Wrap(err, "GetSlackWebhookAction")
 	}
 
-	utmSource := "code-monitor-slack-webhook"
-	searchURL, err := getSearchURL(ctx, m.Query, utmSource)
+	args, err := newActionArgs(ctx, m, w.Monitor, "code-monitor-slack-webhook")
 	if err != nil {
-		return errors.Wrap(err, "GetSearchURL")
+		return err
 	}
 
-	codeMonitorURL, err := getCodeMonitorURL(ctx, w.Monitor, utmSource)
+	return sendSlackNotification(ctx, w.URL, args)
+}
+
+func (r *actionRunner) handleTeamsWebhook(ctx context.Context, j *cm.ActionJob) error {
+	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
-		return errors.Wrap(err, "GetCodeMonitorURL")
+		return err
 	}
+	defer func() { err = s.Done(err) }()
 
-	args := actionArgs{
-		MonitorDescription: m.Description,
-		MonitorURL:         codeMonitorURL,
-		Query:              m.Query,
-		QueryURL:           searchURL,
-		NumResults:         zeroOrVal(m.NumResults),
+	m, err := s.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
 	}
 
-	return sendWebhookNotification(ctx, w.URL, args)
+	w, err := s.GetTeamsWebhookAction(ctx, *j.TeamsWebhook)
+	if err != nil {
+		return errors.Wrap(err, "GetTeamsWebhookAction")
+	}
+
+	args, err := newActionArgs(ctx, m, w.Monitor, "code-monitor-teams-webhook")
+	if err != nil {
+		return err
+	}
+
+	return sendTeamsNotification(ctx, w.URL, args)
 }
 
-func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *cm.ActionJob) error {
+func (r *actionRunner) handleTemplatedWebhook(ctx context.Context, j *cm.ActionJob) error {
 	s, err := r.CodeMonitorStore.Transact(ctx)
 	if err != nil {
 		return err
@@ -315,31 +413,39 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *cm.ActionJob)
 		return errors.Wrap(err, "GetActionJobMetadata")
 	}
 
-	w, err := s.GetSlackWebhookAction(ctx, *j.SlackWebhook)
+	w, err := s.GetTemplatedWebhookAction(ctx, *j.TemplatedWebhook)
 	if err != nil {
-		return errors.Wrap(err, "GetSlackWebhookAction")
+		return errors.Wrap(err, "GetTemplatedWebhookAction")
 	}
 
-	utmSource := "code-monitor-slack-webhook"
+	args, err := newActionArgs(ctx, m, w.Monitor, "code-monitor-templated-webhook")
+	if err != nil {
+		return err
+	}
+
+	return sendTemplatedWebhookNotification(ctx, w.URL, w.ContentType, w.PayloadTemplate, args, false)
+}
+
+// newActionArgs returns the arguments for executing an action of the given
+// monitor for the action job described by m.
+func newActionArgs(ctx context.Context, m *cm.ActionJobMetadata, monitorID int64, utmSource string) (actionArgs, error) {
 	searchURL, err := getSearchURL(ctx, m.Query, utmSource)
 	if err != nil {
-		return errors.Wrap(err, "GetSearchURL")
+		return actionArgs{}, errors.Wrap(err, "GetSearchURL")
 	}
 
-	codeMonitorURL, err := getCodeMonitorURL(ctx, w.Monitor, utmSource)
+	codeMonitorURL, err := getCodeMonitorURL(ctx, monitorID, utmSource)
 	if err != nil {
-		return errors.Wrap(err, "GetCodeMonitorURL")
+		return actionArgs{}, errors.Wrap(err, "GetCodeMonitorURL")
 	}
 
-	args := actionArgs{
+	return actionArgs{
 		MonitorDescription: m.Description,
 		MonitorURL:         codeMonitorURL,
 		Query:              m.Query,
 		QueryURL:           searchURL,
 		NumResults:         zeroOrVal(m.NumResults),
-	}
-
-	return sendSlackNotification(ctx, w.URL, args)
+	}, nil
 }
 
 type StatusCodeError struct {
@@ -371,6 +477,34 @@ func newQueryWithAfterFilter(q *cm.QueryTrigger) string {
 	return strings.Join([]string{q.QueryString, fmt.Sprintf(`after:"%s"`, afterTime)}, " ")
 }
 
+// contentDeltaResultLimit is the count: we add to queries of triggers with
+// TriggerTypeContentDelta which don't specify one. We need all results of the
+// query to diff them, but the default count: only returns the first page.
+const contentDeltaResultLimit = 10000
+
+// newQueryWithCount returns the query with a count: of contentDeltaResultLimit,
+// unless it already specifies a count.
+func newQueryWithCount(q string) string {
+	plan, err := query.Pipeline(query.Init(q, query.SearchTypeLiteral))
+	if err == nil {
+		for _, basic := range plan {
+			if basic.GetCount() != "" {
+				return q
+			}
+		}
+	}
+	return fmt.Sprintf("%s count:%d", q, contentDeltaResultLimit)
+}
+
+// complete returns true if v contains every result of the query.
+func complete(v *gqlSearchResponse) bool {
+	if v == nil {
+		return false
+	}
+	r := v.Data.Search.Results
+	return !r.LimitHit && len(r.Cloning) == 0 && len(r.Timedout) == 0
+}
+
 func latestResultTime(previousLastResult *time.Time, v *gqlSearchResponse, searchErr error) time.Time {
 	if searchErr != nil || len(v.Data.Search.Results.Results) == 0 {
 		// Error performing the search, or there were no results. Assume the
@@ -55,7 +55,7 @@ func (s *codeMonitorStore) insertTestMonitor(ctx context.Context, t *testing.T)
 	require.NoError(t, err)
 
 	// Create trigger.
-	fixtures.query, err = s.CreateQueryTrigger(ctx, fixtures.monitor.ID, testQuery)
+	fixtures.query, err = s.CreateQueryTrigger(ctx, fixtures.monitor.ID, testQuery, TriggerTypeCommits)
 	require.NoError(t, err)
 
 	for i, a := range actions {
@@ -10,10 +10,24 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 )
 
+// TriggerType decides which results of a query trigger fire its monitor.
+type TriggerType string
+
+const (
+	// TriggerTypeCommits fires for commit and diff results newer than the
+	// latest result of the previous run.
+	TriggerTypeCommits TriggerType = "COMMITS"
+
+	// TriggerTypeContentDelta fires for results which were not part of the
+	// result snapshot of the previous run. It works with any kind of search.
+	TriggerTypeContentDelta TriggerType = "CONTENT_DELTA"
+)
+
 type QueryTrigger struct {
 	ID           int64
 	Monitor      int64
 	QueryString  string
+	Type         TriggerType
 	NextRun      time.Time
 	LatestResult *time.Time
 	CreatedBy    int32
@@ -28,6 +42,7 @@ var queryColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_queries.id"),
 	sqlf.Sprintf("cm_queries.monitor"),
 	sqlf.Sprintf("cm_queries.query"),
+	sqlf.Sprintf("cm_queries.trigger_type"),
 	sqlf.Sprintf("cm_queries.next_run"),
 	sqlf.Sprintf("cm_queries.latest_result"),
 	sqlf.Sprintf("cm_queries.created_by"),
@@ -38,18 +53,19 @@ var queryColumns = []*sqlf.Query{
 
 const createTriggerQueryFmtStr = `
 INSERT INTO cm_queries
-(monitor, query, created_by, created_at, changed_by, changed_at, next_run, latest_result)
-VALUES (%s,%s,%s,%s,%s,%s,%s,%s)
+(monitor, query, trigger_type, created_by, created_at, changed_by, changed_at, next_run, latest_result)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s)
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error) {
+func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int64, query string, triggerType TriggerType) (*QueryTrigger, error) {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
 		createTriggerQueryFmtStr,
 		monitorID,
 		query,
+		triggerType,
 		a.UID,
 		now,
 		a.UID,
@@ -62,9 +78,19 @@ func (s *codeMonitorStore) CreateQueryTrigger(ctx context.Context, monitorID int
 	return scanTriggerQuery(row)
 }
 
+// updateTriggerQueryFmtStr drops the result snapshot if the query or its
+// type changes, because it no longer describes the results of the trigger.
 const updateTriggerQueryFmtStr = `
+WITH stale_snapshot AS (
+	DELETE FROM cm_result_snapshots
+	USING cm_queries
+	WHERE cm_result_snapshots.query = cm_queries.id
+	AND cm_queries.id = %s
+	AND (cm_queries.query <> %s OR cm_queries.trigger_type <> %s)
+)
 UPDATE cm_queries
 SET query = %s,
+	trigger_type = %s,
 	changed_by = %s,
 	changed_at = %s,
 	latest_result = %s
@@ -72,12 +98,16 @@ WHERE id = %s
 RETURNING %s;
 `
 
-func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string) error {
+func (s *codeMonitorStore) UpdateQueryTrigger(ctx context.Context, id int64, query string, triggerType TriggerType) error {
 	now := s.Now()
 	a := actor.FromContext(ctx)
 	q := sqlf.Sprintf(
 		updateTriggerQueryFmtStr,
+		id,
+		query,
+		triggerType,
 		query,
+		triggerType,
 		a.UID,
 		now,
 		now,
@@ -156,6 +186,7 @@ func scanTriggerQuery(scanner dbutil.Scanner) (*QueryTrigger, error) {
 		&m.ID,
 		&m.Monitor,
 		&m.QueryString,
+		&m.Type,
 		&m.NextRun,
 		&m.LatestResult,
 		&m.CreatedBy,
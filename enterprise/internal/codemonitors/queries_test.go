@@ -48,6 +48,7 @@ func TestSetQueryTriggerNextRun(t *testing.T) {
 		ID:           fixtures.query.ID,
 		Monitor:      fixtures.monitor.ID,
 		QueryString:  fixtures.query.QueryString,
+		Type:         TriggerTypeCommits,
 		CreatedBy:    fixtures.query.CreatedBy,
 		CreatedAt:    fixtures.query.CreatedAt,
 		NextRun:      wantNextRun,
@@ -74,6 +75,7 @@ func TestResetTriggerQueryTimestamps(t *testing.T) {
 		ID:           fixtures.query.ID,
 		Monitor:      fixtures.monitor.ID,
 		QueryString:  fixtures.query.QueryString,
+		Type:         TriggerTypeCommits,
 		NextRun:      s.Now(),
 		LatestResult: nil,
 		CreatedBy:    fixtures.query.CreatedBy,
@@ -0,0 +1,93 @@
+package codemonitors
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// ResultSnapshot is the result set of the previous run of a trigger with
+// TriggerTypeContentDelta. Each result is identified by a key, so that we can
+// diff the results of consecutive runs.
+type ResultSnapshot struct {
+	Query     int64
+	Keys      []string
+	UpdatedAt time.Time
+}
+
+const getResultSnapshotFmtStr = `
+SELECT query, result_keys, updated_at
+FROM cm_result_snapshots
+WHERE query = %s
+`
+
+// GetResultSnapshot returns the result snapshot of the trigger query, or nil
+// if the trigger has not taken a snapshot yet.
+func (s *codeMonitorStore) GetResultSnapshot(ctx context.Context, queryID int64) (*ResultSnapshot, error) {
+	row := s.QueryRow(ctx, sqlf.Sprintf(getResultSnapshotFmtStr, queryID))
+	snapshot, err := scanResultSnapshot(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return snapshot, err
+}
+
+const upsertResultSnapshotFmtStr = `
+INSERT INTO cm_result_snapshots (query, result_keys, updated_at)
+VALUES (%s, %s, %s)
+ON CONFLICT (query) DO UPDATE
+SET result_keys = EXCLUDED.result_keys,
+    updated_at = EXCLUDED.updated_at
+`
+
+// UpsertResultSnapshot replaces the result snapshot of the trigger query with
+// keys.
+func (s *codeMonitorStore) UpsertResultSnapshot(ctx context.Context, queryID int64, keys []string) error {
+	if keys == nil {
+		// result_keys is NOT NULL, so store an empty result set as an empty
+		// array.
+		keys = []string{}
+	}
+	return s.Exec(ctx, sqlf.Sprintf(upsertResultSnapshotFmtStr, queryID, pq.Array(keys), s.Now()))
+}
+
+func scanResultSnapshot(scanner dbutil.Scanner) (*ResultSnapshot, error) {
+	m := &ResultSnapshot{}
+	err := scanner.Scan(
+		&m.Query,
+		pq.Array(&m.Keys),
+		&m.UpdatedAt,
+	)
+	return m, err
+}
+
+// DiffResultKeys returns the keys of current which are not in previous, and
+// the keys of previous which are not in current. Both are sorted.
+func DiffResultKeys(previous, current []string) (added, removed []string) {
+	prev := make(map[string]struct{}, len(previous))
+	for _, k := range previous {
+		prev[k] = struct{}{}
+	}
+	cur := make(map[string]struct{}, len(current))
+	for _, k := range current {
+		cur[k] = struct{}{}
+		if _, ok := prev[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	for k := range prev {
+		if _, ok := cur[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
@@ -0,0 +1,82 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResultSnapshot(t *testing.T) {
+	ctx, db, s := newTestStore(t)
+	_, _, _, userCTX := newTestUser(ctx, t, db)
+	fixtures, err := s.insertTestMonitor(userCTX, t)
+	require.NoError(t, err)
+
+	got, err := s.GetResultSnapshot(ctx, fixtures.query.ID)
+	require.NoError(t, err)
+	require.Nil(t, got)
+
+	err = s.UpsertResultSnapshot(ctx, fixtures.query.ID, []string{"a", "b"})
+	require.NoError(t, err)
+	got, err = s.GetResultSnapshot(ctx, fixtures.query.ID)
+	require.NoError(t, err)
+	require.Equal(t, &ResultSnapshot{Query: fixtures.query.ID, Keys: []string{"a", "b"}, UpdatedAt: s.Now()}, got)
+
+	// An empty result set is a snapshot, too.
+	err = s.UpsertResultSnapshot(ctx, fixtures.query.ID, nil)
+	require.NoError(t, err)
+	got, err = s.GetResultSnapshot(ctx, fixtures.query.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	require.Empty(t, got.Keys)
+
+	// Changing the query drops the snapshot.
+	err = s.UpsertResultSnapshot(ctx, fixtures.query.ID, []string{"a"})
+	require.NoError(t, err)
+	err = s.UpdateQueryTrigger(userCTX, fixtures.query.ID, fixtures.query.QueryString, TriggerTypeCommits)
+	require.NoError(t, err)
+	got, err = s.GetResultSnapshot(ctx, fixtures.query.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	err = s.UpdateQueryTrigger(userCTX, fixtures.query.ID, fixtures.query.QueryString, TriggerTypeContentDelta)
+	require.NoError(t, err)
+	got, err = s.GetResultSnapshot(ctx, fixtures.query.ID)
+	require.NoError(t, err)
+	require.Nil(t, got)
+}
+
+func TestDiffResultKeys(t *testing.T) {
+	cases := []struct {
+		name              string
+		previous, current []string
+		added, removed    []string
+	}{{
+		name:    "empty",
+		current: []string{"a"},
+		added:   []string{"a"},
+	}, {
+		name:     "unchanged",
+		previous: []string{"a", "b"},
+		current:  []string{"b", "a"},
+	}, {
+		name:     "added and removed",
+		previous: []string{"a", "b", "c"},
+		current:  []string{"d", "b", "a", "e"},
+		added:    []string{"d", "e"},
+		removed:  []string{"c"},
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			added, removed := DiffResultKeys(tc.previous, tc.current)
+			if d := cmp.Diff(tc.added, added); d != "" {
+				t.Errorf("added mismatch (-want +got):\n%s", d)
+			}
+			if d := cmp.Diff(tc.removed, removed); d != "" {
+				t.Errorf("removed mismatch (-want +got):\n%s", d)
+			}
+		})
+	}
+}
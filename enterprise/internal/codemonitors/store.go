@@ -30,8 +30,8 @@ type CodeMonitorStore interface {
 	ListMonitors(context.Context, ListMonitorsOpts) ([]*Monitor, error)
 	CountMonitors(ctx context.Context, userID int32) (int32, error)
 
-	CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error)
-	UpdateQueryTrigger(ctx context.Context, id int64, query string) error
+	CreateQueryTrigger(ctx context.Context, monitorID int64, query string, triggerType TriggerType) (*QueryTrigger, error)
+	UpdateQueryTrigger(ctx context.Context, id int64, query string, triggerType TriggerType) error
 	GetQueryTriggerForMonitor(ctx context.Context, monitorID int64) (*QueryTrigger, error)
 	ResetQueryTriggerTimestamps(ctx context.Context, queryID int64) error
 	SetQueryTriggerNextRun(ctx context.Context, triggerQueryID int64, next time.Time, latestResults time.Time) error
@@ -42,8 +42,12 @@ type CodeMonitorStore interface {
 
 	DeleteObsoleteTriggerJobs(ctx context.Context) error
 	UpdateTriggerJobWithResults(ctx context.Context, triggerJobID int32, queryString string, numResults int) error
+	UpdateTriggerJobWithDelta(ctx context.Context, triggerJobID int32, queryString string, numAdded, numRemoved int) error
 	DeleteOldTriggerJobs(ctx context.Context, retentionInDays int) error
 
+	GetResultSnapshot(ctx context.Context, queryID int64) (*ResultSnapshot, error)
+	UpsertResultSnapshot(ctx context.Context, queryID int64, keys []string) error
+
 	UpdateEmailAction(_ context.Context, id int64, _ *EmailActionArgs) (*EmailAction, error)
 	CreateEmailAction(ctx context.Context, monitorID int64, _ *EmailActionArgs) (*EmailAction, error)
 	DeleteEmailActions(ctx context.Context, actionIDs []int64, monitorID int64) error
@@ -65,6 +69,20 @@ type CodeMonitorStore interface {
 	GetSlackWebhookAction(ctx context.Context, id int64) (*SlackWebhookAction, error)
 	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
 
+	UpdateTeamsWebhookAction(_ context.Context, id int64, enabled bool, url string) (*TeamsWebhookAction, error)
+	CreateTeamsWebhookAction(ctx context.Context, monitorID int64, enabled bool, url string) (*TeamsWebhookAction, error)
+	DeleteTeamsWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountTeamsWebhookActions(ctx context.Context, monitorID int64) (int, error)
+	GetTeamsWebhookAction(ctx context.Context, id int64) (*TeamsWebhookAction, error)
+	ListTeamsWebhookActions(context.Context, ListActionsOpts) ([]*TeamsWebhookAction, error)
+
+	UpdateTemplatedWebhookAction(_ context.Context, id int64, _ *TemplatedWebhookActionArgs) (*TemplatedWebhookAction, error)
+	CreateTemplatedWebhookAction(ctx context.Context, monitorID int64, _ *TemplatedWebhookActionArgs) (*TemplatedWebhookAction, error)
+	DeleteTemplatedWebhookActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountTemplatedWebhookActions(ctx context.Context, monitorID int64) (int, error)
+	GetTemplatedWebhookAction(ctx context.Context, id int64) (*TemplatedWebhookAction, error)
+	ListTemplatedWebhookActions(context.Context, ListActionsOpts) ([]*TemplatedWebhookAction, error)
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
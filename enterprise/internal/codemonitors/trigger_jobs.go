@@ -22,6 +22,10 @@ type TriggerJob struct {
 	Results    *bool
 	NumResults *int32
 
+	// The number of results which disappeared since the previous run. Only
+	// set for TriggerTypeContentDelta.
+	NumRemoved *int32
+
 	// Fields demanded for any dbworker.
 	State          string
 	FailureMessage *string
@@ -75,6 +79,21 @@ func (s *codeMonitorStore) UpdateTriggerJobWithResults(ctx context.Context, trig
 	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchFmtStr, queryString, numResults > 0, numResults, triggerJobID))
 }
 
+const logSearchDeltaFmtStr = `
+UPDATE cm_trigger_jobs
+SET query_string = %s,
+    results = %s,
+    num_results = %s,
+    num_removed = %s
+WHERE id = %s
+`
+
+// UpdateTriggerJobWithDelta is like UpdateTriggerJobWithResults for
+// TriggerTypeContentDelta. Only added results count as results of the job.
+func (s *codeMonitorStore) UpdateTriggerJobWithDelta(ctx context.Context, triggerJobID int32, queryString string, numAdded, numRemoved int) error {
+	return s.Store.Exec(ctx, sqlf.Sprintf(logSearchDeltaFmtStr, queryString, numAdded > 0, numAdded, numRemoved, triggerJobID))
+}
+
 const deleteObsoleteJobLogsFmtStr = `
 DELETE FROM cm_trigger_jobs
 WHERE results IS NOT TRUE
@@ -193,6 +212,7 @@ func scanTriggerJob(scanner dbutil.Scanner) (*TriggerJob, error) {
 		&m.QueryString,
 		&m.Results,
 		&m.NumResults,
+		&m.NumRemoved,
 		&m.State,
 		&m.FailureMessage,
 		&m.StartedAt,
@@ -211,6 +231,7 @@ var TriggerJobsColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_trigger_jobs.query_string"),
 	sqlf.Sprintf("cm_trigger_jobs.results"),
 	sqlf.Sprintf("cm_trigger_jobs.num_results"),
+	sqlf.Sprintf("cm_trigger_jobs.num_removed"),
 	sqlf.Sprintf("cm_trigger_jobs.state"),
 	sqlf.Sprintf("cm_trigger_jobs.failure_message"),
 	sqlf.Sprintf("cm_trigger_jobs.started_at"),
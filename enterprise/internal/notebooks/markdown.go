@@ -0,0 +1,503 @@
+package notebooks
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+)
+
+// The Markdown dialect used to export and import notebooks is plain Markdown
+// with two additions:
+//
+// - Every block is preceded by a `<!-- notebook-block: <id> -->` comment,
+//   which preserves the block ID and the boundaries between consecutive
+//   Markdown blocks. The comment is optional on import: blocks without it are
+//   assigned a new ID.
+// - Non-Markdown blocks are fenced code blocks with a `sourcegraph` info
+//   string. Query blocks use `sourcegraph` on its own, all other block types
+//   append the block type, e.g. `sourcegraph:file`. The contents of query and
+//   compute blocks are the query text, the contents of file, symbol and
+//   references blocks are `key: value` lines.
+//
+// Blocks are separated by a blank line. Lines of Markdown blocks that would be
+// read as a block marker or a `sourcegraph` fence are indented by one more
+// space, which CommonMark renders the same way. ExportMarkdown followed by
+// ImportMarkdown returns the original blocks.
+
+const markdownBlockMarkerFmtStr = "<!-- notebook-block: %s -->"
+
+var markdownBlockMarkerPattern = regexp.MustCompile(`^<!-- notebook-block: (\S+) -->$`)
+
+var markdownFencePattern = regexp.MustCompile("^(`{3,})sourcegraph(?::(\\w+))?\\s*$")
+
+const (
+	markdownKeyRepository = "repository"
+	markdownKeyPath       = "path"
+	markdownKeyRevision   = "revision"
+	markdownKeyLines      = "lines"
+	markdownKeySymbol     = "symbol"
+	markdownKeyContainer  = "container"
+	markdownKeyKind       = "kind"
+	markdownKeyContext    = "context"
+	markdownKeyPosition   = "position"
+)
+
+// ExportMarkdown converts the blocks to the notebook Markdown dialect.
+func ExportMarkdown(blocks NotebookBlocks) (string, error) {
+	var sb strings.Builder
+	for i, block := range blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, markdownBlockMarkerFmtStr+"\n", block.ID)
+
+		if block.Type == NotebookMarkdownBlockType {
+			if block.MarkdownInput == nil {
+				return "", errors.Errorf("invalid markdown block with id: %s", block.ID)
+			}
+			sb.WriteString(escapeMarkdownText(block.MarkdownInput.Text))
+			sb.WriteString("\n")
+			continue
+		}
+
+		info, contents, err := markdownFenceContents(block)
+		if err != nil {
+			return "", err
+		}
+		fence := markdownFence(contents)
+		fmt.Fprintf(&sb, "%s%s\n%s\n%s\n", fence, info, contents, fence)
+	}
+	return sb.String(), nil
+}
+
+// escapeMarkdownText indents every line of the text of a Markdown block that
+// would otherwise be imported as a block marker or a `sourcegraph` fence, or
+// that was indented by escapeMarkdownText itself, by one space.
+func escapeMarkdownText(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if isMarkdownStructureLine(strings.TrimLeft(line, " ")) {
+			lines[i] = " " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// unescapeMarkdownLine reverts escapeMarkdownText for a single line.
+func unescapeMarkdownLine(line string) string {
+	if strings.HasPrefix(line, " ") && isMarkdownStructureLine(strings.TrimLeft(line, " ")) {
+		return line[1:]
+	}
+	return line
+}
+
+// isMarkdownStructureLine returns whether ImportMarkdown reads the line as a
+// block marker or the start of a `sourcegraph` fence.
+func isMarkdownStructureLine(line string) bool {
+	return markdownBlockMarkerPattern.MatchString(line) || markdownFencePattern.MatchString(line)
+}
+
+// markdownFenceContents returns the info string and contents of the fenced code
+// block that represents a non-Markdown block.
+func markdownFenceContents(block NotebookBlock) (info string, contents string, err error) {
+	var kv markdownKeyValues
+	switch block.Type {
+	case NotebookQueryBlockType:
+		if block.QueryInput == nil {
+			return "", "", errors.Errorf("invalid query block with id: %s", block.ID)
+		}
+		return "sourcegraph", block.QueryInput.Text, nil
+	case NotebookComputeBlockType:
+		if block.ComputeInput == nil {
+			return "", "", errors.Errorf("invalid compute block with id: %s", block.ID)
+		}
+		return "sourcegraph:" + string(block.Type), block.ComputeInput.Text, nil
+	case NotebookFileBlockType:
+		input := block.FileInput
+		if input == nil {
+			return "", "", errors.Errorf("invalid file block with id: %s", block.ID)
+		}
+		kv.add(markdownKeyRepository, input.RepositoryName)
+		kv.add(markdownKeyPath, input.FilePath)
+		kv.addOptional(markdownKeyRevision, input.Revision)
+		if input.LineRange != nil {
+			kv.add(markdownKeyLines, fmt.Sprintf("%d-%d", input.LineRange.StartLine, input.LineRange.EndLine))
+		}
+	case NotebookSymbolBlockType:
+		input := block.SymbolInput
+		if input == nil {
+			return "", "", errors.Errorf("invalid symbol block with id: %s", block.ID)
+		}
+		kv.add(markdownKeyRepository, input.RepositoryName)
+		kv.add(markdownKeyPath, input.FilePath)
+		kv.addOptional(markdownKeyRevision, input.Revision)
+		kv.add(markdownKeySymbol, input.SymbolName)
+		kv.add(markdownKeyContainer, input.SymbolContainerName)
+		kv.add(markdownKeyKind, input.SymbolKind)
+		kv.add(markdownKeyContext, strconv.Itoa(int(input.LineContext)))
+	case NotebookReferencesBlockType:
+		input := block.ReferencesInput
+		if input == nil {
+			return "", "", errors.Errorf("invalid references block with id: %s", block.ID)
+		}
+		kv.add(markdownKeyRepository, input.RepositoryName)
+		kv.add(markdownKeyPath, input.FilePath)
+		kv.addOptional(markdownKeyRevision, input.Revision)
+		kv.add(markdownKeyPosition, fmt.Sprintf("%d:%d", input.Position.Line, input.Position.Character))
+	default:
+		return "", "", errors.Errorf("invalid block type: %s", string(block.Type))
+	}
+	return "sourcegraph:" + string(block.Type), kv.String(), nil
+}
+
+// markdownFence returns a code fence that is longer than any run of backticks
+// starting a line in contents.
+func markdownFence(contents string) string {
+	n := 3
+	for _, line := range strings.Split(contents, "\n") {
+		run := len(line) - len(strings.TrimLeft(line, "`"))
+		if run >= n {
+			n = run + 1
+		}
+	}
+	return strings.Repeat("`", n)
+}
+
+// ImportMarkdown converts Markdown in the notebook Markdown dialect to blocks.
+// Fenced `sourcegraph` code blocks become query blocks (or the block type named
+// in the info string), everything in between becomes Markdown blocks. The
+// resulting blocks are validated.
+func ImportMarkdown(markdown string) (NotebookBlocks, error) {
+	lines := strings.Split(strings.TrimSuffix(markdown, "\n"), "\n")
+	if markdown == "" {
+		lines = nil
+	}
+
+	var (
+		blocks      NotebookBlocks
+		nextID      string
+		mdID        string
+		mdLines     []string
+		mdHasMarker bool
+	)
+
+	newID := func() string {
+		id := nextID
+		nextID = ""
+		if id == "" {
+			id = uuid.New().String()
+		}
+		return id
+	}
+
+	// flushMarkdown adds the Markdown lines collected so far as a block. If the
+	// block is followed by another block, its last line is the blank separator
+	// line and is dropped.
+	flushMarkdown := func(followedByBlock bool) {
+		if followedByBlock && len(mdLines) > 0 && mdLines[len(mdLines)-1] == "" {
+			mdLines = mdLines[:len(mdLines)-1]
+		}
+		if len(mdLines) > 0 || mdHasMarker {
+			blocks = append(blocks, NotebookBlock{
+				ID:            mdID,
+				Type:          NotebookMarkdownBlockType,
+				MarkdownInput: &NotebookMarkdownBlockInput{Text: strings.Join(mdLines, "\n")},
+			})
+		}
+		mdID, mdLines, mdHasMarker = "", nil, false
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if m := markdownBlockMarkerPattern.FindStringSubmatch(line); m != nil {
+			flushMarkdown(true)
+			if nextID != "" {
+				// A marker directly followed by another marker denotes an
+				// empty Markdown block.
+				mdID, mdHasMarker = newID(), true
+				flushMarkdown(true)
+			}
+			nextID = m[1]
+			continue
+		}
+
+		if m := markdownFencePattern.FindStringSubmatch(line); m != nil {
+			flushMarkdown(true)
+
+			fence, blockType := m[1], NotebookBlockType(m[2])
+			if blockType == "" {
+				blockType = NotebookQueryBlockType
+			}
+
+			var contents []string
+			closed := false
+			for i++; i < len(lines); i++ {
+				if lines[i] == fence {
+					closed = true
+					break
+				}
+				contents = append(contents, lines[i])
+			}
+			if !closed {
+				return nil, errors.Errorf("unterminated %s code block", m[0])
+			}
+
+			block, err := parseMarkdownFence(newID(), blockType, strings.Join(contents, "\n"))
+			if err != nil {
+				return nil, err
+			}
+			blocks = append(blocks, block)
+
+			// Skip the blank line separating this block from the next one.
+			if i+1 < len(lines) && lines[i+1] == "" {
+				i++
+			}
+			continue
+		}
+
+		if len(mdLines) == 0 && !mdHasMarker {
+			mdHasMarker = nextID != ""
+			mdID = newID()
+		}
+		mdLines = append(mdLines, unescapeMarkdownLine(line))
+	}
+	flushMarkdown(false)
+	if nextID != "" {
+		mdID, mdHasMarker = newID(), true
+		flushMarkdown(false)
+	}
+
+	if err := validateNotebookBlocks(blocks); err != nil {
+		return nil, err
+	}
+	return blocks, nil
+}
+
+// parseMarkdownFence converts the contents of a fenced `sourcegraph` code block
+// to a block of the given type.
+func parseMarkdownFence(id string, blockType NotebookBlockType, contents string) (NotebookBlock, error) {
+	block := NotebookBlock{ID: id, Type: blockType}
+	switch blockType {
+	case NotebookQueryBlockType:
+		block.QueryInput = &NotebookQueryBlockInput{Text: contents}
+		return block, nil
+	case NotebookComputeBlockType:
+		block.ComputeInput = &NotebookComputeBlockInput{Text: contents}
+		return block, nil
+	case NotebookFileBlockType, NotebookSymbolBlockType, NotebookReferencesBlockType:
+	default:
+		return block, errors.Errorf("invalid block type: %s", string(blockType))
+	}
+
+	kv, err := parseMarkdownKeyValues(contents)
+	if err != nil {
+		return block, errors.Wrapf(err, "invalid %s block with id: %s", blockType, id)
+	}
+
+	switch blockType {
+	case NotebookFileBlockType:
+		input := &NotebookFileBlockInput{}
+		err = kv.parse(map[string]func(string) error{
+			markdownKeyRepository: setString(&input.RepositoryName),
+			markdownKeyPath:       setString(&input.FilePath),
+			markdownKeyRevision:   setOptionalString(&input.Revision),
+			markdownKeyLines: func(v string) error {
+				input.LineRange = &LineRange{}
+				return parseInt32Pair(v, "-", &input.LineRange.StartLine, &input.LineRange.EndLine)
+			},
+		})
+		block.FileInput = input
+	case NotebookSymbolBlockType:
+		input := &NotebookSymbolBlockInput{}
+		err = kv.parse(map[string]func(string) error{
+			markdownKeyRepository: setString(&input.RepositoryName),
+			markdownKeyPath:       setString(&input.FilePath),
+			markdownKeyRevision:   setOptionalString(&input.Revision),
+			markdownKeySymbol:     setString(&input.SymbolName),
+			markdownKeyContainer:  setString(&input.SymbolContainerName),
+			markdownKeyKind:       setString(&input.SymbolKind),
+			markdownKeyContext:    setInt32(&input.LineContext),
+		})
+		block.SymbolInput = input
+	case NotebookReferencesBlockType:
+		input := &NotebookReferencesBlockInput{}
+		err = kv.parse(map[string]func(string) error{
+			markdownKeyRepository: setString(&input.RepositoryName),
+			markdownKeyPath:       setString(&input.FilePath),
+			markdownKeyRevision:   setOptionalString(&input.Revision),
+			markdownKeyPosition: func(v string) error {
+				return parseInt32Pair(v, ":", &input.Position.Line, &input.Position.Character)
+			},
+		})
+		block.ReferencesInput = input
+	}
+	if err != nil {
+		return block, errors.Wrapf(err, "invalid %s block with id: %s", blockType, id)
+	}
+	return block, nil
+}
+
+// markdownKeyValues are the ordered `key: value` lines of a fenced block.
+type markdownKeyValues [][2]string
+
+func (kv *markdownKeyValues) add(key, value string) {
+	*kv = append(*kv, [2]string{key, value})
+}
+
+func (kv *markdownKeyValues) addOptional(key string, value *string) {
+	if value != nil {
+		kv.add(key, *value)
+	}
+}
+
+func (kv markdownKeyValues) String() string {
+	lines := make([]string, 0, len(kv))
+	for _, pair := range kv {
+		lines = append(lines, pair[0]+": "+pair[1])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parse calls the setter of each key. Unknown and duplicate keys are an error.
+func (kv markdownKeyValues) parse(setters map[string]func(string) error) error {
+	seen := map[string]struct{}{}
+	for _, pair := range kv {
+		set, ok := setters[pair[0]]
+		if !ok {
+			return errors.Errorf("unknown key %q", pair[0])
+		}
+		if _, ok := seen[pair[0]]; ok {
+			return errors.Errorf("duplicate key %q", pair[0])
+		}
+		seen[pair[0]] = struct{}{}
+		if err := set(pair[1]); err != nil {
+			return errors.Wrapf(err, "invalid value for key %q", pair[0])
+		}
+	}
+	return nil
+}
+
+func parseMarkdownKeyValues(contents string) (markdownKeyValues, error) {
+	var kv markdownKeyValues
+	for _, line := range strings.Split(contents, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		i := strings.Index(line, ":")
+		if i < 0 {
+			return nil, errors.Errorf("expected key: value, got %q", line)
+		}
+		kv.add(strings.TrimSpace(line[:i]), strings.TrimPrefix(line[i+1:], " "))
+	}
+	return kv, nil
+}
+
+func setString(s *string) func(string) error {
+	return func(v string) error {
+		*s = v
+		return nil
+	}
+}
+
+func setOptionalString(s **string) func(string) error {
+	return func(v string) error {
+		*s = &v
+		return nil
+	}
+}
+
+func setInt32(n *int32) func(string) error {
+	return func(v string) error {
+		parsed, err := strconv.ParseInt(strings.TrimSpace(v), 10, 32)
+		if err != nil {
+			return err
+		}
+		*n = int32(parsed)
+		return nil
+	}
+}
+
+func parseInt32Pair(v, sep string, a, b *int32) error {
+	parts := strings.Split(v, sep)
+	if len(parts) != 2 {
+		return errors.Errorf("expected two numbers separated by %q, got %q", sep, v)
+	}
+	if err := setInt32(a)(parts[0]); err != nil {
+		return err
+	}
+	return setInt32(b)(parts[1])
+}
+
+// DiffMarkdown returns a line-based diff between the Markdown exports of two
+// versions of a notebook's blocks. Removed lines are prefixed with "-", added
+// lines with "+" and unchanged lines with " ".
+func DiffMarkdown(oldBlocks, newBlocks NotebookBlocks) (string, error) {
+	oldMarkdown, err := ExportMarkdown(oldBlocks)
+	if err != nil {
+		return "", err
+	}
+	newMarkdown, err := ExportMarkdown(newBlocks)
+	if err != nil {
+		return "", err
+	}
+
+	oldLines := strings.SplitAfter(oldMarkdown, "\n")
+	newLines := strings.SplitAfter(newMarkdown, "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// oldLines[i:] and newLines[j:].
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var sb strings.Builder
+	writeLine := func(prefix, line string) {
+		if line == "" {
+			return
+		}
+		sb.WriteString(prefix)
+		sb.WriteString(line)
+		if !strings.HasSuffix(line, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	i, j := 0, 0
+	for i < len(oldLines) && j < len(newLines) {
+		switch {
+		case oldLines[i] == newLines[j]:
+			writeLine(" ", oldLines[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			writeLine("-", oldLines[i])
+			i++
+		default:
+			writeLine("+", newLines[j])
+			j++
+		}
+	}
+	for ; i < len(oldLines); i++ {
+		writeLine("-", oldLines[i])
+	}
+	for ; j < len(newLines); j++ {
+		writeLine("+", newLines[j])
+	}
+	return sb.String(), nil
+}
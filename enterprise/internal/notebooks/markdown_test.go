@@ -0,0 +1,130 @@
+package notebooks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	revision := "main"
+	emptyRevision := ""
+	blocks := NotebookBlocks{
+		{ID: "1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"# Title\n\nSome text"}},
+		{ID: "2", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"Trailing newline\n"}},
+		{ID: "3", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"repo:a b"}},
+		{ID: "4", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{""}},
+		{ID: "5", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"```\nnested fence\n```"}},
+		{ID: "6", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &revision, LineRange: &LineRange{1, 10}},
+		},
+		{ID: "7", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &emptyRevision},
+		},
+		{ID: "8", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "a/b.go", LineContext: 3, SymbolName: "Foo", SymbolContainerName: "b", SymbolKind: "FUNCTION"},
+		},
+		{ID: "9", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{"content:output(a -> b)"}},
+		{ID: "10", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, Position: Position{Line: 4, Character: 5}},
+		},
+		{ID: "11", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"Run this:\n```sourcegraph\nrepo:foo\n```\nthen"}},
+		{ID: "12", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"<!-- notebook-block: 13 -->\n  ````sourcegraph:file \n <!-- notebook-block: 14 -->"}},
+		{ID: "13", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"Last"}},
+	}
+
+	markdown, err := ExportMarkdown(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"<!-- notebook-block: 3 -->\n```sourcegraph\nrepo:a b\n```\n",
+		"````sourcegraph\n```\nnested fence\n```\n````\n",
+		"```sourcegraph:file\nrepository: github.com/sourcegraph/sourcegraph\npath: a/b.ts\nrevision: main\nlines: 1-10\n```\n",
+		"```sourcegraph:references\nrepository: github.com/sourcegraph/sourcegraph\npath: a/b.go\nrevision: main\nposition: 4:5\n```\n",
+		"Run this:\n ```sourcegraph\nrepo:foo\n```\nthen\n",
+		" <!-- notebook-block: 13 -->\n   ````sourcegraph:file \n  <!-- notebook-block: 14 -->\n",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("exported markdown does not contain %q:\n%s", want, markdown)
+		}
+	}
+
+	imported, err := ImportMarkdown(markdown)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(blocks, imported); diff != "" {
+		t.Fatalf("unexpected blocks (-want +got):\n%s", diff)
+	}
+}
+
+func TestImportMarkdownWithoutMarkers(t *testing.T) {
+	markdown := "# Runbook\n\nFind the callers:\n\n```sourcegraph\nrepo:a b\n```\n\nThen check the file:\n\n```sourcegraph:file\nrepository: a\npath: b.go\n```\n"
+
+	blocks, err := ImportMarkdown(markdown)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, block := range blocks {
+		if block.ID == "" {
+			t.Fatalf("block without ID: %+v", block)
+		}
+	}
+	want := NotebookBlocks{
+		{ID: blocks[0].ID, Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"# Runbook\n\nFind the callers:"}},
+		{ID: blocks[1].ID, Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"repo:a b"}},
+		{ID: blocks[2].ID, Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"Then check the file:"}},
+		{ID: blocks[3].ID, Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{RepositoryName: "a", FilePath: "b.go"}},
+	}
+	if diff := cmp.Diff(want, blocks); diff != "" {
+		t.Fatalf("unexpected blocks (-want +got):\n%s", diff)
+	}
+}
+
+func TestImportMarkdownErrors(t *testing.T) {
+	tests := []struct {
+		markdown string
+		wantErr  string
+	}{
+		{markdown: "```sourcegraph\nrepo:a b\n", wantErr: "unterminated ```sourcegraph code block"},
+		{markdown: "```sourcegraph:unknown\nx\n```", wantErr: "invalid block type: unknown"},
+		{markdown: "<!-- notebook-block: 1 -->\n```sourcegraph:file\nrepository: a\nfoo: b\n```", wantErr: `invalid file block with id: 1: unknown key "foo"`},
+		{markdown: "<!-- notebook-block: 1 -->\n```sourcegraph:symbol\nrepository: a\npath: b.go\n```", wantErr: "invalid symbol block with id: 1"},
+		{markdown: "<!-- notebook-block: 1 -->\n```sourcegraph:references\nrepository: a\npath: b\nposition: 4\n```", wantErr: `invalid references block with id: 1: invalid value for key "position": expected two numbers separated by ":", got "4"`},
+		{markdown: "<!-- notebook-block: 1 -->\na\n\n<!-- notebook-block: 1 -->\nb", wantErr: "duplicate block id found: 1"},
+	}
+
+	for _, tt := range tests {
+		_, err := ImportMarkdown(tt.markdown)
+		if err == nil {
+			t.Fatalf("expected error for %q, got nil", tt.markdown)
+		} else if err.Error() != tt.wantErr {
+			t.Fatalf("wanted '%s' error, got '%s'", tt.wantErr, err.Error())
+		}
+	}
+}
+
+func TestDiffMarkdown(t *testing.T) {
+	oldBlocks := NotebookBlocks{
+		{ID: "1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"# Title"}},
+		{ID: "2", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"repo:a b"}},
+	}
+	newBlocks := NotebookBlocks{
+		{ID: "1", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"# Title"}},
+		{ID: "2", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"repo:a c"}},
+	}
+
+	diff, err := DiffMarkdown(oldBlocks, newBlocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := " <!-- notebook-block: 1 -->\n # Title\n \n <!-- notebook-block: 2 -->\n ```sourcegraph\n-repo:a b\n+repo:a c\n ```\n"
+	if diff != want {
+		t.Fatalf("unexpected diff:\n%s", diff)
+	}
+}
@@ -16,6 +16,8 @@ import (
 
 var ErrNotebookNotFound = errors.New("notebook not found")
 
+var ErrNotebookRevisionNotFound = errors.New("notebook revision not found")
+
 type NotebooksOrderByOption uint8
 
 const (
@@ -58,6 +60,9 @@ type NotebooksStore interface {
 	CreateNotebook(context.Context, *Notebook) (*Notebook, error)
 	UpdateNotebook(context.Context, *Notebook) (*Notebook, error)
 	DeleteNotebook(context.Context, int64) error
+	ListNotebookRevisions(context.Context, int64) ([]*NotebookRevision, error)
+	GetNotebookRevision(context.Context, int64) (*NotebookRevision, error)
+	RestoreNotebookRevision(context.Context, int64) (*Notebook, error)
 	// TODO
 	// ListNotebooks(context.Context, ListNotebooksPageOptions, ListNotebooksOptions) ([]*Notebook, error)
 	// CountNotebooks(context.Context, ListNotebooksOptions) (int, error)
@@ -172,16 +177,35 @@ INSERT INTO notebooks (title, blocks, public, creator_user_id) VALUES (%s, %s, %
 RETURNING %s
 `
 
-func (s *notebooksStore) CreateNotebook(ctx context.Context, n *Notebook) (*Notebook, error) {
-	err := validateNotebookBlocks(n.Blocks)
+func (s *notebooksStore) CreateNotebook(ctx context.Context, n *Notebook) (_ *Notebook, err error) {
+	err = validateNotebookBlocks(n.Blocks)
 	if err != nil {
 		return nil, err
 	}
-	row := s.QueryRow(
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	row := tx.QueryRow(
 		ctx,
 		sqlf.Sprintf(insertNotebookFmtStr, n.Title, n.Blocks, n.Public, nullInt32Column(n.CreatorUserID), sqlf.Join(notebookColumns, ",")),
 	)
-	return scanNotebook(row)
+	created, err := scanNotebook(row)
+	if err != nil {
+		return nil, err
+	}
+
+	authorUserID := n.CreatorUserID
+	if a := actor.FromContext(ctx); a.IsAuthenticated() {
+		authorUserID = a.UID
+	}
+	if err := tx.createNotebookRevision(ctx, created, authorUserID); err != nil {
+		return nil, err
+	}
+	return created, nil
 }
 
 const deleteNotebookFmtStr = `DELETE FROM notebooks WHERE id = %d`
@@ -203,16 +227,143 @@ RETURNING %s
 `
 
 // 🚨 SECURITY: The caller must ensure that the actor has permission to update the notebook.
-func (s *notebooksStore) UpdateNotebook(ctx context.Context, n *Notebook) (*Notebook, error) {
-	err := validateNotebookBlocks(n.Blocks)
+func (s *notebooksStore) UpdateNotebook(ctx context.Context, n *Notebook) (_ *Notebook, err error) {
+	err = validateNotebookBlocks(n.Blocks)
 	if err != nil {
 		return nil, err
 	}
-	row := s.QueryRow(
+
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	row := tx.QueryRow(
 		ctx,
 		sqlf.Sprintf(updateNotebookFmtStr, n.Title, n.Blocks, n.Public, n.ID, sqlf.Join(notebookColumns, ",")),
 	)
-	return scanNotebook(row)
+	updated, err := scanNotebook(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.createNotebookRevision(ctx, updated, actor.FromContext(ctx).UID); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+var notebookRevisionColumns = []*sqlf.Query{
+	sqlf.Sprintf("notebook_revisions.id"),
+	sqlf.Sprintf("notebook_revisions.notebook_id"),
+	sqlf.Sprintf("notebook_revisions.title"),
+	sqlf.Sprintf("notebook_revisions.blocks"),
+	sqlf.Sprintf("notebook_revisions.author_user_id"),
+	sqlf.Sprintf("notebook_revisions.created_at"),
+}
+
+func scanNotebookRevision(sc dbutil.Scanner) (*NotebookRevision, error) {
+	r := &NotebookRevision{}
+	err := sc.Scan(
+		&r.ID,
+		&r.NotebookID,
+		&r.Title,
+		&r.Blocks,
+		&dbutil.NullInt32{N: &r.AuthorUserID},
+		&r.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotebookRevisionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+const insertNotebookRevisionFmtStr = `
+INSERT INTO notebook_revisions (notebook_id, title, blocks, author_user_id) VALUES (%s, %s, %s, %s)
+`
+
+// createNotebookRevision records the current title and blocks of the notebook
+// in its revision history.
+func (s *notebooksStore) createNotebookRevision(ctx context.Context, n *Notebook, authorUserID int32) error {
+	return s.Exec(ctx, sqlf.Sprintf(insertNotebookRevisionFmtStr, n.ID, n.Title, n.Blocks, nullInt32Column(authorUserID)))
+}
+
+const listNotebookRevisionsFmtStr = `
+SELECT %s
+FROM notebook_revisions
+JOIN notebooks ON notebooks.id = notebook_revisions.notebook_id
+WHERE
+	(%s) -- permission conditions
+	AND (%s) -- query conditions
+ORDER BY notebook_revisions.id DESC
+`
+
+// ListNotebookRevisions returns the revisions of a notebook, newest first.
+func (s *notebooksStore) ListNotebookRevisions(ctx context.Context, notebookID int64) (_ []*NotebookRevision, err error) {
+	rows, err := s.Query(
+		ctx,
+		sqlf.Sprintf(
+			listNotebookRevisionsFmtStr,
+			sqlf.Join(notebookRevisionColumns, ","),
+			notebooksPermissionsCondition(ctx, s.Handle().DB()),
+			sqlf.Sprintf("notebook_revisions.notebook_id = %d", notebookID),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	revisions := []*NotebookRevision{}
+	for rows.Next() {
+		r, err := scanNotebookRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, r)
+	}
+	return revisions, nil
+}
+
+func (s *notebooksStore) GetNotebookRevision(ctx context.Context, id int64) (*NotebookRevision, error) {
+	row := s.QueryRow(
+		ctx,
+		sqlf.Sprintf(
+			listNotebookRevisionsFmtStr,
+			sqlf.Join(notebookRevisionColumns, ","),
+			notebooksPermissionsCondition(ctx, s.Handle().DB()),
+			sqlf.Sprintf("notebook_revisions.id = %d", id),
+		),
+	)
+	return scanNotebookRevision(row)
+}
+
+// RestoreNotebookRevision sets the title and blocks of a notebook to the ones
+// of the given revision. The restore is recorded as a new revision.
+//
+// 🚨 SECURITY: The caller must ensure that the actor has permission to update the notebook.
+func (s *notebooksStore) RestoreNotebookRevision(ctx context.Context, id int64) (_ *Notebook, err error) {
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	revision, err := tx.GetNotebookRevision(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	notebook, err := tx.GetNotebook(ctx, revision.NotebookID)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook.Title = revision.Title
+	notebook.Blocks = revision.Blocks
+	return tx.UpdateNotebook(ctx, notebook)
 }
 
 func nullInt32Column(n int32) *int32 {
@@ -42,6 +42,13 @@ func TestCreateAndGetNotebook(t *testing.T) {
 		{ID: "3", Type: NotebookFileBlockType, FileInput: &NotebookFileBlockInput{
 			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "client/web/file.tsx"},
 		},
+		{ID: "4", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "cmd/main.go", SymbolName: "main", SymbolKind: "FUNCTION"},
+		},
+		{ID: "5", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{"content:output(a -> b)"}},
+		{ID: "6", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{
+			RepositoryName: "github.com/sourcegraph/sourcegraph", FilePath: "cmd/main.go", Position: Position{Line: 10, Character: 5}},
+		},
 	}
 	notebook := &Notebook{Title: "Notebook Title", Blocks: blocks, Public: true, CreatorUserID: user.ID}
 	createdNotebook, err := n.CreateNotebook(ctx, notebook)
@@ -90,6 +97,91 @@ func TestUpdateNotebook(t *testing.T) {
 	}
 }
 
+func TestNotebookRevisions(t *testing.T) {
+	t.Parallel()
+	db := dbtest.NewDB(t)
+	internalCtx := actor.WithInternalActor(context.Background())
+	u := database.Users(db)
+	n := Notebooks(db)
+
+	user, err := u.Create(internalCtx, database.NewUser{Username: "u", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	ctx := actor.WithActor(context.Background(), actor.FromUser(user.ID))
+
+	blocks := NotebookBlocks{{ID: "1", Type: NotebookQueryBlockType, QueryInput: &NotebookQueryBlockInput{"repo:a b"}}}
+	createdNotebook, err := n.CreateNotebook(ctx, &Notebook{Title: "Notebook Title", Blocks: blocks, Public: true, CreatorUserID: user.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updatedNotebook := *createdNotebook
+	updatedNotebook.Title = "Notebook Title 1"
+	updatedNotebook.Blocks = NotebookBlocks{{ID: "2", Type: NotebookMarkdownBlockType, MarkdownInput: &NotebookMarkdownBlockInput{"# Title"}}}
+	if _, err := n.UpdateNotebook(ctx, &updatedNotebook); err != nil {
+		t.Fatal(err)
+	}
+
+	revisions, err := n.ListNotebookRevisions(ctx, createdNotebook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("wanted 2 revisions, got %d", len(revisions))
+	}
+	if revisions[0].Title != "Notebook Title 1" || !reflect.DeepEqual(revisions[0].Blocks, updatedNotebook.Blocks) {
+		t.Fatalf("unexpected latest revision %+v", revisions[0])
+	}
+	if revisions[1].Title != "Notebook Title" || !reflect.DeepEqual(revisions[1].Blocks, blocks) {
+		t.Fatalf("unexpected first revision %+v", revisions[1])
+	}
+	for _, r := range revisions {
+		if r.NotebookID != createdNotebook.ID || r.AuthorUserID != user.ID {
+			t.Fatalf("unexpected revision %+v", r)
+		}
+	}
+
+	restoredNotebook, err := n.RestoreNotebookRevision(ctx, revisions[1].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoredNotebook.Title != "Notebook Title" || !reflect.DeepEqual(restoredNotebook.Blocks, blocks) {
+		t.Fatalf("unexpected restored notebook %+v", restoredNotebook)
+	}
+
+	revisions, err = n.ListNotebookRevisions(ctx, createdNotebook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 3 {
+		t.Fatalf("wanted 3 revisions, got %d", len(revisions))
+	}
+	revisionID := revisions[0].ID
+
+	// Revisions of a private notebook are only visible to its creator.
+	updatedNotebook.Public = false
+	if _, err := n.UpdateNotebook(ctx, &updatedNotebook); err != nil {
+		t.Fatal(err)
+	}
+	otherUser, err := u.Create(internalCtx, database.NewUser{Username: "u2", Password: "p"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	otherCtx := actor.WithActor(context.Background(), actor.FromUser(otherUser.ID))
+	revisions, err = n.ListNotebookRevisions(otherCtx, createdNotebook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 0 {
+		t.Fatalf("wanted no revisions, got %d", len(revisions))
+	}
+	_, err = n.GetNotebookRevision(otherCtx, revisionID)
+	if !errors.Is(err, ErrNotebookRevisionNotFound) {
+		t.Fatalf("want ErrNotebookRevisionNotFound error, got %+v", err)
+	}
+}
+
 func TestDeleteNotebook(t *testing.T) {
 	t.Parallel()
 	db := dbtest.NewDB(t)
@@ -7,9 +7,12 @@ import (
 type NotebookBlockType string
 
 const (
-	NotebookQueryBlockType    NotebookBlockType = "query"
-	NotebookMarkdownBlockType NotebookBlockType = "md"
-	NotebookFileBlockType     NotebookBlockType = "file"
+	NotebookQueryBlockType      NotebookBlockType = "query"
+	NotebookMarkdownBlockType   NotebookBlockType = "md"
+	NotebookFileBlockType       NotebookBlockType = "file"
+	NotebookSymbolBlockType     NotebookBlockType = "symbol"
+	NotebookComputeBlockType    NotebookBlockType = "compute"
+	NotebookReferencesBlockType NotebookBlockType = "references"
 )
 
 type NotebookQueryBlockInput struct {
@@ -35,12 +38,44 @@ type NotebookFileBlockInput struct {
 	LineRange      *LineRange `json:"lineRange,omitempty"`
 }
 
+type NotebookSymbolBlockInput struct {
+	RepositoryName      string  `json:"repositoryName"`
+	FilePath            string  `json:"filePath"`
+	Revision            *string `json:"revision,omitempty"`
+	LineContext         int32   `json:"lineContext"`
+	SymbolName          string  `json:"symbolName"`
+	SymbolContainerName string  `json:"symbolContainerName"`
+	SymbolKind          string  `json:"symbolKind"`
+}
+
+type NotebookComputeBlockInput struct {
+	Text string `json:"text"`
+}
+
+type Position struct {
+	// Line is the 0-based line number of the position.
+	Line int32 `json:"line"`
+
+	// Character is the 0-based character offset of the position within the line.
+	Character int32 `json:"character"`
+}
+
+type NotebookReferencesBlockInput struct {
+	RepositoryName string   `json:"repositoryName"`
+	FilePath       string   `json:"filePath"`
+	Revision       *string  `json:"revision,omitempty"`
+	Position       Position `json:"position"`
+}
+
 type NotebookBlock struct {
-	ID            string                      `json:"id"`
-	Type          NotebookBlockType           `json:"type"`
-	QueryInput    *NotebookQueryBlockInput    `json:"queryInput,omitempty"`
-	MarkdownInput *NotebookMarkdownBlockInput `json:"markdownInput,omitempty"`
-	FileInput     *NotebookFileBlockInput     `json:"fileInput,omitempty"`
+	ID              string                        `json:"id"`
+	Type            NotebookBlockType             `json:"type"`
+	QueryInput      *NotebookQueryBlockInput      `json:"queryInput,omitempty"`
+	MarkdownInput   *NotebookMarkdownBlockInput   `json:"markdownInput,omitempty"`
+	FileInput       *NotebookFileBlockInput       `json:"fileInput,omitempty"`
+	SymbolInput     *NotebookSymbolBlockInput     `json:"symbolInput,omitempty"`
+	ComputeInput    *NotebookComputeBlockInput    `json:"computeInput,omitempty"`
+	ReferencesInput *NotebookReferencesBlockInput `json:"referencesInput,omitempty"`
 }
 
 type NotebookBlocks []NotebookBlock
@@ -54,3 +89,12 @@ type Notebook struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+type NotebookRevision struct {
+	ID           int64
+	NotebookID   int64
+	Title        string
+	Blocks       NotebookBlocks
+	AuthorUserID int32
+	CreatedAt    time.Time
+}
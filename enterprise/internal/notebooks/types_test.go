@@ -12,6 +12,9 @@ func TestNotebookBlockMarshalling(t *testing.T) {
 	markdownBlockInput := NotebookMarkdownBlockInput{Text: "# Title"}
 	revision := "main"
 	fileBlockInput := NotebookFileBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &revision, LineRange: &LineRange{1, 10}}
+	symbolBlockInput := NotebookSymbolBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, LineContext: 3, SymbolName: "Foo", SymbolContainerName: "b", SymbolKind: "FUNCTION"}
+	computeBlockInput := NotebookComputeBlockInput{Text: "content:output(a -> b)"}
+	referencesBlockInput := NotebookReferencesBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, Position: Position{Line: 4, Character: 5}}
 
 	tests := []struct {
 		block NotebookBlock
@@ -29,6 +32,18 @@ func TestNotebookBlockMarshalling(t *testing.T) {
 			block: NotebookBlock{ID: "id1", Type: NotebookFileBlockType, FileInput: &fileBlockInput},
 			want:  autogold.Want("marshals file block", `{"id":"id1","type":"file","fileInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.ts","revision":"main","lineRange":{"startLine":1,"endLine":10}}}`),
 		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &symbolBlockInput},
+			want:  autogold.Want("marshals symbol block", `{"id":"id1","type":"symbol","symbolInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","revision":"main","lineContext":3,"symbolName":"Foo","symbolContainerName":"b","symbolKind":"FUNCTION"}}`),
+		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, ComputeInput: &computeBlockInput},
+			want:  autogold.Want("marshals compute block", `{"id":"id1","type":"compute","computeInput":{"text":"content:output(a -\u003e b)"}}`),
+		},
+		{
+			block: NotebookBlock{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &referencesBlockInput},
+			want:  autogold.Want("marshals references block", `{"id":"id1","type":"references","referencesInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","revision":"main","position":{"line":4,"character":5}}}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -45,6 +60,9 @@ func TestNotebookBlockUnmarshalling(t *testing.T) {
 	markdownBlockInput := NotebookMarkdownBlockInput{Text: "# Title"}
 	revision := "main"
 	fileBlockInput := NotebookFileBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.ts", Revision: &revision, LineRange: &LineRange{1, 10}}
+	symbolBlockInput := NotebookSymbolBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, LineContext: 3, SymbolName: "Foo", SymbolContainerName: "b", SymbolKind: "FUNCTION"}
+	computeBlockInput := NotebookComputeBlockInput{Text: "content:output(a -> b)"}
+	referencesBlockInput := NotebookReferencesBlockInput{RepositoryName: "sourcegraph/sourcegraph", FilePath: "a/b.go", Revision: &revision, Position: Position{Line: 4, Character: 5}}
 
 	tests := []struct {
 		json string
@@ -62,6 +80,18 @@ func TestNotebookBlockUnmarshalling(t *testing.T) {
 			json: `{"id":"id1","type":"file","fileInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.ts","revision":"main","lineRange":{"startLine":1,"endLine":10}}}`,
 			want: autogold.Want("marshals file block", NotebookBlock{ID: "id1", Type: NotebookFileBlockType, FileInput: &fileBlockInput}),
 		},
+		{
+			json: `{"id":"id1","type":"symbol","symbolInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","revision":"main","lineContext":3,"symbolName":"Foo","symbolContainerName":"b","symbolKind":"FUNCTION"}}`,
+			want: autogold.Want("marshals symbol block", NotebookBlock{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &symbolBlockInput}),
+		},
+		{
+			json: `{"id":"id1","type":"compute","computeInput":{"text":"content:output(a -\u003e b)"}}`,
+			want: autogold.Want("marshals compute block", NotebookBlock{ID: "id1", Type: NotebookComputeBlockType, ComputeInput: &computeBlockInput}),
+		},
+		{
+			json: `{"id":"id1","type":"references","referencesInput":{"repositoryName":"sourcegraph/sourcegraph","filePath":"a/b.go","revision":"main","position":{"line":4,"character":5}}}`,
+			want: autogold.Want("marshals references block", NotebookBlock{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &referencesBlockInput}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,18 +3,41 @@ package notebooks
 import "github.com/cockroachdb/errors"
 
 func validateNotebookBlock(block NotebookBlock) error {
-	if block.Type != NotebookQueryBlockType && block.Type != NotebookMarkdownBlockType && block.Type != NotebookFileBlockType {
+	switch block.Type {
+	case NotebookQueryBlockType:
+		if block.QueryInput == nil {
+			return errors.Errorf("invalid query block with id: %s", block.ID)
+		}
+	case NotebookMarkdownBlockType:
+		if block.MarkdownInput == nil {
+			return errors.Errorf("invalid markdown block with id: %s", block.ID)
+		}
+	case NotebookFileBlockType:
+		if block.FileInput == nil {
+			return errors.Errorf("invalid file block with id: %s", block.ID)
+		}
+	case NotebookSymbolBlockType:
+		if block.SymbolInput == nil || block.SymbolInput.RepositoryName == "" || block.SymbolInput.FilePath == "" || block.SymbolInput.SymbolName == "" {
+			return errors.Errorf("invalid symbol block with id: %s", block.ID)
+		}
+		if block.SymbolInput.LineContext < 0 {
+			return errors.Errorf("invalid symbol block line context with id: %s", block.ID)
+		}
+	case NotebookComputeBlockType:
+		if block.ComputeInput == nil {
+			return errors.Errorf("invalid compute block with id: %s", block.ID)
+		}
+	case NotebookReferencesBlockType:
+		if block.ReferencesInput == nil || block.ReferencesInput.RepositoryName == "" || block.ReferencesInput.FilePath == "" {
+			return errors.Errorf("invalid references block with id: %s", block.ID)
+		}
+		if block.ReferencesInput.Position.Line < 0 || block.ReferencesInput.Position.Character < 0 {
+			return errors.Errorf("invalid references block position with id: %s", block.ID)
+		}
+	default:
 		return errors.Errorf("invalid block type: %s", string(block.Type))
 	}
 
-	if block.Type == NotebookQueryBlockType && block.QueryInput == nil {
-		return errors.Errorf("invalid query block with id: %s", block.ID)
-	} else if block.Type == NotebookMarkdownBlockType && block.MarkdownInput == nil {
-		return errors.Errorf("invalid markdown block with id: %s", block.ID)
-	} else if block.Type == NotebookFileBlockType && block.FileInput == nil {
-		return errors.Errorf("invalid file block with id: %s", block.ID)
-	}
-
 	return nil
 }
 
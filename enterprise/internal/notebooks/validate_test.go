@@ -19,6 +19,18 @@ func TestNotebookBlocksValidation(t *testing.T) {
 		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookQueryBlockType}}, wantErr: "invalid query block with id: id1"},
 		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookMarkdownBlockType}}, wantErr: "invalid markdown block with id: id1"},
 		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookFileBlockType}}, wantErr: "invalid file block with id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookSymbolBlockType}}, wantErr: "invalid symbol block with id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "a", FilePath: "b.go"}},
+		}, wantErr: "invalid symbol block with id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", Type: NotebookSymbolBlockType, SymbolInput: &NotebookSymbolBlockInput{RepositoryName: "a", FilePath: "b.go", SymbolName: "c", LineContext: -1}},
+		}, wantErr: "invalid symbol block line context with id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookComputeBlockType}}, wantErr: "invalid compute block with id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookReferencesBlockType}}, wantErr: "invalid references block with id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", Type: NotebookReferencesBlockType, ReferencesInput: &NotebookReferencesBlockInput{RepositoryName: "a", FilePath: "b.go", Position: Position{Line: -1}}},
+		}, wantErr: "invalid references block position with id: id1"},
 	}
 
 	for _, tt := range tests {
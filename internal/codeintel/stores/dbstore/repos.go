@@ -101,3 +101,83 @@ const getLSIFDependencyReposQuery = `
 SELECT id, name, version FROM lsif_dependency_repos
 WHERE %s ORDER BY id %s
 `
+
+// GetDependencyReposOpts filters the dependency repos returned by GetDependencyRepos.
+type GetDependencyReposOpts struct {
+	// Scheme is the package scheme of the dependencies, such as "npm" or "gomod".
+	Scheme string
+	// Name, if set, only matches dependencies of the package with this name.
+	Name  string
+	After int
+	Limit int
+}
+
+// DependencyRepo is a single version of a package that a precise code
+// intelligence upload depends on.
+type DependencyRepo struct {
+	ID      int
+	Scheme  string
+	Name    string
+	Version string
+}
+
+// GetDependencyRepos returns the dependency repos of the given scheme, ordered by ID.
+func (s *Store) GetDependencyRepos(ctx context.Context, filter GetDependencyReposOpts) (repos []DependencyRepo, err error) {
+	ctx, endObservation := s.operations.getDependencyRepos.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("scheme", filter.Scheme),
+		log.String("name", filter.Name),
+		log.Int("after", filter.After),
+		log.Int("limit", filter.Limit),
+		log.Lazy(func(l log.Encoder) {
+			l.EmitInt("results", len(repos))
+		}),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	conds := make([]*sqlf.Query, 0, 3)
+	conds = append(conds, sqlf.Sprintf("scheme = %s", filter.Scheme))
+
+	if filter.After > 0 {
+		conds = append(conds, sqlf.Sprintf("id > %d", filter.After))
+	}
+
+	if filter.Name != "" {
+		conds = append(conds, sqlf.Sprintf("name = %s", filter.Name))
+	}
+
+	limit := sqlf.Sprintf("")
+	if filter.Limit != 0 {
+		limit = sqlf.Sprintf("LIMIT %s", filter.Limit)
+	}
+
+	return scanDependencyRepos(s.Query(ctx, sqlf.Sprintf(getDependencyReposQuery, sqlf.Join(conds, "AND"), limit)))
+}
+
+func scanDependencyRepos(rows *sql.Rows, queryErr error) (dependencies []DependencyRepo, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	for rows.Next() {
+		var dep DependencyRepo
+		if err = rows.Scan(
+			&dep.ID,
+			&dep.Scheme,
+			&dep.Name,
+			&dep.Version,
+		); err != nil {
+			return nil, err
+		}
+
+		dependencies = append(dependencies, dep)
+	}
+
+	return dependencies, nil
+}
+
+const getDependencyReposQuery = `
+-- source: internal/codeintel/stores/dbstore/repos.go:GetDependencyRepos
+SELECT id, scheme, name, version FROM lsif_dependency_repos
+WHERE %s ORDER BY id %s
+`
@@ -4,6 +4,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
 )
 
@@ -23,3 +25,39 @@ func TestRepoName(t *testing.T) {
 		t.Errorf("unexpected repo name. want=%s have=%s", "github.com/foo/bar", name)
 	}
 }
+
+func TestGetDependencyRepos(t *testing.T) {
+	db := dbtest.NewDB(t)
+	store := testStore(db)
+
+	if _, err := db.Exec(`
+		INSERT INTO lsif_dependency_repos (id, scheme, name, version) VALUES
+			(1, 'npm', 'react', '17.0.2'),
+			(2, 'gomod', 'github.com/foo/bar', 'v1.0.0'),
+			(3, 'npm', '@types/node', '16.0.0'),
+			(4, 'npm', 'react', '16.14.0')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting dependency repos: %s", err)
+	}
+
+	repos, err := store.GetDependencyRepos(context.Background(), GetDependencyReposOpts{Scheme: "npm", After: 1})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependency repos: %s", err)
+	}
+	want := []DependencyRepo{
+		{ID: 3, Scheme: "npm", Name: "@types/node", Version: "16.0.0"},
+		{ID: 4, Scheme: "npm", Name: "react", Version: "16.14.0"},
+	}
+	if diff := cmp.Diff(want, repos); diff != "" {
+		t.Errorf("unexpected dependency repos (-want +got):\n%s", diff)
+	}
+
+	repos, err = store.GetDependencyRepos(context.Background(), GetDependencyReposOpts{Scheme: "npm", Name: "react", Limit: 1})
+	if err != nil {
+		t.Fatalf("unexpected error getting dependency repos: %s", err)
+	}
+	want = []DependencyRepo{{ID: 1, Scheme: "npm", Name: "react", Version: "17.0.2"}}
+	if diff := cmp.Diff(want, repos); diff != "" {
+		t.Errorf("unexpected dependency repos (-want +got):\n%s", diff)
+	}
+}
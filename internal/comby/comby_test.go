@@ -205,3 +205,27 @@ func TestReplacements(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffs(t *testing.T) {
+	// If we are not on CI skip the test if comby is not installed.
+	if os.Getenv("CI") == "" && !Exists() {
+		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
+	}
+
+	diffs, err := Diffs(context.Background(), Args{
+		Input:           FileContent("yes\n"),
+		MatchTemplate:   "yes",
+		RewriteTemplate: "no",
+		Matcher:         ".generic",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) != 1 {
+		t.Fatalf("got %d diffs, want 1", len(diffs))
+	}
+	want := "--- /dev/null\n+++ /dev/null\n@@ -1,1 +1,1 @@\n-yes\n+no"
+	if got := diffs[0].Diff; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
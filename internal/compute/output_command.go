@@ -21,22 +21,132 @@ func (c *Output) String() string {
 	return fmt.Sprintf("Output with separator: (%s) -> (%s) separator: %s", c.MatchPattern.String(), c.OutputPattern, c.Separator)
 }
 
-func substituteRegexp(content string, match *regexp.Regexp, replacePattern, separator string) string {
+// substituteRegexp evaluates the template replacePattern for every match of
+// match in content. References to capture groups are expanded first, like
+// regexp.Expand does, and capture groups with attributes, builtin variables
+// and functions are then evaluated in the expanded template.
+func substituteRegexp(content string, match *regexp.Regexp, replacePattern, separator string, env *MetaEnvironment) (string, error) {
+	lines := lineOffsets(content)
 	var b strings.Builder
 	for _, submatches := range match.FindAllStringSubmatchIndex(content, -1) {
-		b.Write(match.ExpandString([]byte{}, replacePattern, content, submatches))
+		t, err := scanTemplate([]byte(expandSubmatches(replacePattern, match, content, submatches)))
+		if err != nil {
+			return "", err
+		}
+		value, err := t.evaluate(env, regexpCaptures(content, lines, match, submatches))
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(value)
 		b.WriteString(separator)
 	}
+	return b.String(), nil
+}
+
+// expandSubmatches expands the references to capture groups in pattern, like
+// `$1`, `${1}` or `${name}`, to their values in the match submatches of
+// match, and `$$` to a literal `$`. References followed by an attribute, like
+// `$1.length`, builtin variables and calls to builtin functions are left to
+// be evaluated as a template. Expanded values are escaped so that the
+// template outputs them unchanged.
+func expandSubmatches(pattern string, match *regexp.Regexp, content string, submatches []int) string {
+	expand := func(name string) string {
+		return escapeTemplate(string(match.ExpandString(nil, "${"+name+"}", content, submatches)))
+	}
+
+	var b strings.Builder
+	for len(pattern) > 0 {
+		i := strings.IndexAny(pattern, `$\`)
+		if i < 0 {
+			b.WriteString(pattern)
+			break
+		}
+		b.WriteString(pattern[:i])
+		pattern = pattern[i:]
+
+		if pattern[0] == '\\' {
+			// Keep escape sequences, like `\$`, for the template.
+			n := 2
+			if len(pattern) < n {
+				n = len(pattern)
+			}
+			b.WriteString(pattern[:n])
+			pattern = pattern[n:]
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(pattern, "$$"):
+			b.WriteString(`\$`)
+			pattern = pattern[2:]
+		case strings.HasPrefix(pattern, "${"):
+			end := strings.IndexByte(pattern, '}')
+			name := ""
+			if end > 0 {
+				name = pattern[2:end]
+			}
+			if name == "" || strings.Trim(name, varAllowed) != "" {
+				// Malformed, like regexp.Expand we output the '$' as is.
+				b.WriteString(`\$`)
+				pattern = pattern[1:]
+				continue
+			}
+			b.WriteString(expand(name))
+			pattern = pattern[end+1:]
+		default:
+			end := 1
+			for end < len(pattern) && strings.IndexByte(varAllowed, pattern[end]) >= 0 {
+				end++
+			}
+			name, rest := pattern[1:end], pattern[end:]
+			_, isBuiltinVariable := (&MetaEnvironment{}).lookup(name)
+			_, isBuiltinFunction := builtinFunctions[name]
+			_, attrLen := scanAttribute([]byte(strings.TrimPrefix(rest, ".")))
+			switch {
+			case name == "":
+				b.WriteString("$")
+			case isBuiltinVariable,
+				isBuiltinFunction && strings.HasPrefix(rest, "("),
+				strings.HasPrefix(rest, ".") && attrLen > 0:
+				b.WriteString("$" + name)
+			default:
+				b.WriteString(expand(name))
+			}
+			pattern = rest
+		}
+	}
 	return b.String()
 }
 
-func output(ctx context.Context, fragment string, matchPattern MatchPattern, replacePattern string, separator string) (*Text, error) {
+// escapeTemplate escapes the characters in s that have a meaning in a
+// template.
+func escapeTemplate(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(`\$ .,()`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func output(ctx context.Context, fragment string, matchPattern MatchPattern, replacePattern string, separator string, env *MetaEnvironment) (*Text, error) {
 	var newContent string
 	var err error
 	switch match := matchPattern.(type) {
 	case *Regexp:
-		newContent = substituteRegexp(fragment, match.Value, replacePattern, separator)
+		newContent, err = substituteRegexp(fragment, match.Value, replacePattern, separator, env)
+		if err != nil {
+			return nil, err
+		}
 	case *Comby:
+		// Comby substitutes its own variables, so we only substitute
+		// builtin variables.
+		replacePattern, err = substituteMetaVariables(replacePattern, env)
+		if err != nil {
+			return nil, err
+		}
 		newContent, err = comby.Outputs(ctx, comby.Args{
 			Input:           comby.FileContent(fragment),
 			MatchTemplate:   match.Value,
@@ -83,9 +193,5 @@ func (c *Output) Run(ctx context.Context, r result.Match) (Result, error) {
 		return nil, nil
 	}
 	env := NewMetaEnvironment(r, content)
-	outputPattern, err := substituteMetaVariables(c.OutputPattern, env)
-	if err != nil {
-		return nil, err
-	}
-	return output(ctx, content, c.MatchPattern, outputPattern, c.Separator)
+	return output(ctx, content, c.MatchPattern, c.OutputPattern, c.Separator, env)
 }
@@ -17,7 +17,7 @@ import (
 
 func Test_output(t *testing.T) {
 	test := func(input string, cmd *Output) string {
-		result, err := output(context.Background(), input, cmd.MatchPattern, cmd.OutputPattern, cmd.Separator)
+		result, err := output(context.Background(), input, cmd.MatchPattern, cmd.OutputPattern, cmd.Separator, &MetaEnvironment{})
 		if err != nil {
 			return err.Error()
 		}
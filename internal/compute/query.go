@@ -32,16 +32,42 @@ func (q Query) ToSearchQuery() (string, error) {
 		searchPattern = c.MatchPattern.String()
 	case *Output:
 		searchPattern = c.MatchPattern.String()
+	case *Rewrite:
+		searchPattern = c.MatchPattern.String()
 	default:
 		return "", errors.Errorf("unsupported query conversion for compute command %T", c)
 	}
+	parameters := q.Parameters
+	if isStructural(q.Command) {
+		// Only search files that the structural pattern can match.
+		parameters = make([]query.Parameter, 0, len(q.Parameters)+1)
+		for _, p := range q.Parameters {
+			if p.Field != query.FieldPatternType {
+				parameters = append(parameters, p)
+			}
+		}
+		parameters = append(parameters, query.Parameter{Field: query.FieldPatternType, Value: "structural"})
+	}
 	basic := query.Basic{
-		Parameters: q.Parameters,
+		Parameters: parameters,
 		Pattern:    query.Pattern{Value: searchPattern},
 	}
 	return basic.StringHuman(), nil
 }
 
+// isStructural returns whether command matches with a structural pattern,
+// which requires a structural search.
+func isStructural(command Command) bool {
+	switch c := command.(type) {
+	case *Rewrite:
+		return true
+	case *Output:
+		_, ok := c.MatchPattern.(*Comby)
+		return ok
+	}
+	return false
+}
+
 type MatchPattern interface {
 	pattern()
 	String() string
@@ -156,7 +182,7 @@ func parseReplace(pattern *query.Pattern) (Command, bool, error) {
 		}
 	case "replace.structural":
 		// structural search doesn't do any match pattern validation
-		matchPattern = &Comby{Value: left}
+		return &Rewrite{MatchPattern: &Comby{Value: left}, RewritePattern: right}, true, nil
 	default:
 		// unrecognized name
 		return nil, false, nil
@@ -191,6 +217,10 @@ func parseOutput(pattern *query.Pattern) (Command, bool, error) {
 		return nil, false, nil
 	}
 
+	if _, err := scanTemplate([]byte(right)); err != nil {
+		return nil, false, errors.Wrap(err, "output command")
+	}
+
 	// The default separator is newline and cannot be changed currently.
 	return &Output{MatchPattern: matchPattern, OutputPattern: right, Separator: "\n"}, true, nil
 }
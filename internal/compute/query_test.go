@@ -46,6 +46,10 @@ func TestParse(t *testing.T) {
 	autogold.Want("replace no left hand side",
 		"Command: `Replace in place: () -> (b)`").
 		Equal(t, test("content:replace(->b)"))
+
+	autogold.Want("replace structural",
+		"Command: `Rewrite structural: (foo(:[x], :[y])) -> (foo(:[y], :[x]))`").
+		Equal(t, test("content:replace.structural(foo(:[x], :[y]) -> foo(:[y], :[x]))"))
 }
 
 func TestToSearchQuery(t *testing.T) {
@@ -65,4 +69,20 @@ func TestToSearchQuery(t *testing.T) {
 	autogold.Want("convert replace-in-place to search query",
 		"repo:foo file:bar colarado").
 		Equal(t, test("content:replace(colarado -> colorodo) repo:foo file:bar"))
+
+	autogold.Want("convert structural rewrite to search query",
+		"repo:foo patterntype:structural foo(:[x])").
+		Equal(t, test("content:replace.structural(foo(:[x]) -> bar(:[x])) repo:foo"))
+
+	autogold.Want("convert structural output to search query",
+		"repo:foo patterntype:structural foo(:[x])").
+		Equal(t, test("content:output.structural(foo(:[x]) -> :[x]) repo:foo patterntype:literal"))
+
+	autogold.Want("convert regexp output to search query",
+		"repo:foo (\\w+)").
+		Equal(t, test("content:output((\\w+) -> $1) repo:foo"))
+
+	autogold.Want("convert literal replace-in-place to search query",
+		"repo:foo patterntype:literal colarado").
+		Equal(t, test("content:replace(colarado -> colorodo) repo:foo patterntype:literal"))
 }
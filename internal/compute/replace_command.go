@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/errors"
-	"github.com/sourcegraph/sourcegraph/internal/comby"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
 )
@@ -24,20 +23,6 @@ func replace(ctx context.Context, content []byte, matchPattern MatchPattern, rep
 	switch match := matchPattern.(type) {
 	case *Regexp:
 		newContent = match.Value.ReplaceAllString(string(content), replacePattern)
-	case *Comby:
-		replacements, err := comby.Replacements(ctx, comby.Args{
-			Input:           comby.FileContent(content),
-			MatchTemplate:   match.Value,
-			RewriteTemplate: replacePattern,
-			Matcher:         ".generic", // TODO(rvantonder): use language or file filter
-			ResultKind:      comby.Replacement,
-			NumWorkers:      0, // Just a single file's content.
-		})
-		if err != nil {
-			return nil, err
-		}
-		// There is only one replacement value since we passed in comby.FileContent.
-		newContent = replacements[0].Content
 	default:
 		return nil, errors.Errorf("unsupported replacement operation for match pattern %T", match)
 	}
@@ -2,12 +2,10 @@ package compute
 
 import (
 	"context"
-	"os"
 	"regexp"
 	"testing"
 
 	"github.com/hexops/autogold"
-	"github.com/sourcegraph/sourcegraph/internal/comby"
 )
 
 func Test_replace(t *testing.T) {
@@ -26,17 +24,4 @@ func Test_replace(t *testing.T) {
 			MatchPattern:   &Regexp{Value: regexp.MustCompile(`more (\w+)`)},
 			ReplacePattern: "a bit more $1",
 		}))
-
-	// If we are not on CI skip the test if comby is not installed.
-	if os.Getenv("CI") == "" && !comby.Exists() {
-		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
-	}
-
-	autogold.Want(
-		"structural search replace",
-		"foo(baz, bar)").
-		Equal(t, test("foo(bar, baz)", &Replace{
-			MatchPattern:   &Comby{Value: `foo(:[x], :[y])`},
-			ReplacePattern: "foo(:[y], :[x])",
-		}))
 }
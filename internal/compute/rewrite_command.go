@@ -0,0 +1,73 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/comby"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/vcs/git"
+)
+
+// Rewrite applies a structural rewrite template to the content of matched
+// files and outputs the changes as a unified diff per file.
+type Rewrite struct {
+	MatchPattern   *Comby
+	RewritePattern string
+}
+
+func (c *Rewrite) String() string {
+	return fmt.Sprintf("Rewrite structural: (%s) -> (%s)", c.MatchPattern.String(), c.RewritePattern)
+}
+
+// toUnifiedDiff replaces the file headers comby emits for stdin input with
+// headers for path, so that the diff can be applied to the repository.
+func toUnifiedDiff(path, diff string) string {
+	var b strings.Builder
+	b.WriteString("--- a/" + path + "\n")
+	b.WriteString("+++ b/" + path + "\n")
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		if i < 2 && (strings.HasPrefix(line, "--- ") || strings.HasPrefix(line, "+++ ")) {
+			continue
+		}
+		b.WriteString(line)
+	}
+	if !strings.HasSuffix(diff, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func rewrite(ctx context.Context, content []byte, path string, matchPattern *Comby, rewritePattern string) (*Text, error) {
+	diffs, err := comby.Diffs(ctx, comby.Args{
+		Input:           comby.FileContent(content),
+		MatchTemplate:   matchPattern.Value,
+		RewriteTemplate: rewritePattern,
+		Matcher:         ".generic",
+		NumWorkers:      0, // Just a single file's content.
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(diffs) == 0 {
+		// The rewrite did not change the file.
+		return &Text{Value: "", Kind: "diff"}, nil
+	}
+	// There is at most one diff since we passed in comby.FileContent.
+	return &Text{Value: toUnifiedDiff(path, diffs[0].Diff), Kind: "diff"}, nil
+}
+
+func (c *Rewrite) Run(ctx context.Context, r result.Match) (Result, error) {
+	switch m := r.(type) {
+	case *result.FileMatch:
+		content, err := git.ReadFile(ctx, m.Repo.Name, m.CommitID, m.Path, 0)
+		if err != nil {
+			return nil, err
+		}
+		return rewrite(ctx, content, m.Path, c.MatchPattern, c.RewritePattern)
+	}
+	// Only file contents can be rewritten.
+	return &Text{Value: "", Kind: "diff"}, nil
+}
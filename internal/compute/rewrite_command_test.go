@@ -0,0 +1,56 @@
+package compute
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hexops/autogold"
+	"github.com/sourcegraph/sourcegraph/internal/comby"
+)
+
+func Test_toUnifiedDiff(t *testing.T) {
+	autogold.Want(
+		"comby stdin headers replaced",
+		"--- a/main.go\n+++ b/main.go\n@@ -1,1 +1,1 @@\n-yes\n+no\n").
+		Equal(t, toUnifiedDiff("main.go", "--- /dev/null\n+++ /dev/null\n@@ -1,1 +1,1 @@\n-yes\n+no"))
+
+	autogold.Want(
+		"removed lines starting with header prefix are kept",
+		"--- a/a.txt\n+++ b/a.txt\n@@ -1,2 +1,1 @@\n--- x\n ok\n").
+		Equal(t, toUnifiedDiff("a.txt", "--- /dev/null\n+++ /dev/null\n@@ -1,2 +1,1 @@\n--- x\n ok\n"))
+}
+
+func Test_rewrite(t *testing.T) {
+	// If we are not on CI skip the test if comby is not installed.
+	if os.Getenv("CI") == "" && !comby.Exists() {
+		t.Skip("comby is not installed on the PATH. Try running 'bash <(curl -sL get.comby.dev)'.")
+	}
+
+	test := func(input string, cmd *Rewrite) string {
+		result, err := rewrite(context.Background(), []byte(input), "main.go", cmd.MatchPattern, cmd.RewritePattern)
+		if err != nil {
+			return err.Error()
+		}
+		if result.Value == "" {
+			return "<no change>"
+		}
+		return result.Value
+	}
+
+	autogold.Want(
+		"structural rewrite diff",
+		"--- a/main.go\n+++ b/main.go\n@@ -1,1 +1,1 @@\n-foo(bar, baz)\n+foo(baz, bar)\n").
+		Equal(t, test("foo(bar, baz)\n", &Rewrite{
+			MatchPattern:   &Comby{Value: `foo(:[x], :[y])`},
+			RewritePattern: "foo(:[y], :[x])",
+		}))
+
+	autogold.Want(
+		"structural rewrite no match",
+		"<no change>").
+		Equal(t, test("bar(baz)\n", &Rewrite{
+			MatchPattern:   &Comby{Value: `foo(:[x], :[y])`},
+			RewritePattern: "foo(:[y], :[x])",
+		}))
+}
@@ -2,14 +2,22 @@ package compute
 
 import (
 	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"text/template"
 	"unicode/utf8"
 
+	"github.com/cockroachdb/errors"
+	"github.com/go-enry/go-enry/v2"
+
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
-// Template is just a list of Atom, where an Atom is either a Variable or a Constant string.
+// Template is just a list of Atom, where an Atom is either a Variable, a
+// Function call or a Constant string.
 type Template []Atom
 
 type Atom interface {
@@ -24,18 +32,28 @@ const (
 	RangeAttr  Attribute = "range"
 )
 
+var attributes = []Attribute{LengthAttr, RangeAttr}
+
 // Variable represents a variable in the template that may be substituted for. A
 // variable is optionally qualified by an attribute, which is data associated
-// with a variable (e.g., length, range). Attributes are currently unused, and
-// exist for future expansion.
+// with a variable (e.g., length, range). For example, `$1.length` is the
+// number of characters in the value of `$1`.
 type Variable struct {
 	Name      string
 	Attribute Attribute
 }
 
+// Function represents a call to a builtin function in the template, like
+// `$upper($1)`. Every argument is itself a template.
+type Function struct {
+	Name string
+	Args []Template
+}
+
 type Constant string
 
 func (Variable) atom() {}
+func (Function) atom() {}
 func (Constant) atom() {}
 
 func (v Variable) String() string {
@@ -44,12 +62,138 @@ func (v Variable) String() string {
 	}
 	return v.Name
 }
+
+func (f Function) String() string {
+	args := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		args = append(args, arg.String())
+	}
+	return "$" + f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
 func (c Constant) String() string { return string(c) }
 
+func (t Template) String() string {
+	var b strings.Builder
+	for _, atom := range t {
+		b.WriteString(atom.String())
+	}
+	return b.String()
+}
+
+type builtinFunction struct {
+	arity int
+	apply func(args []string) string
+}
+
+var builtinFunctions = map[string]builtinFunction{
+	"lower": {arity: 1, apply: func(args []string) string { return strings.ToLower(args[0]) }},
+	"upper": {arity: 1, apply: func(args []string) string { return strings.ToUpper(args[0]) }},
+	"trim":  {arity: 1, apply: func(args []string) string { return strings.TrimSpace(args[0]) }},
+	"replace": {arity: 3, apply: func(args []string) string {
+		return strings.ReplaceAll(args[0], args[1], args[2])
+	}},
+	"json": {arity: 1, apply: func(args []string) string {
+		b, _ := json.Marshal(args[0])
+		return string(b)
+	}},
+}
+
+// scanAttribute returns the attribute at the start of buf, which follows a
+// '.' after a variable, and the number of bytes it spans.
+func scanAttribute(buf []byte) (Attribute, int) {
+	for _, attr := range attributes {
+		if !strings.HasPrefix(string(buf), string(attr)) {
+			continue
+		}
+		rest := buf[len(attr):]
+		if r, _ := utf8.DecodeRune(rest); len(rest) > 0 && strings.ContainsRune(varAllowed, r) {
+			// Something like `$1.lengthy`, which is not an attribute.
+			continue
+		}
+		return attr, len(attr)
+	}
+	return "", 0
+}
+
+// trimArgument removes leading and trailing spaces from a function
+// argument. An escaped trailing space, `\ `, is kept.
+func trimArgument(arg string) string {
+	arg = strings.TrimLeft(arg, " ")
+	for strings.HasSuffix(arg, " ") && !strings.HasSuffix(arg, "\\ ") {
+		arg = arg[:len(arg)-1]
+	}
+	return arg
+}
+
+// scanArguments scans the comma-separated arguments of a function call in
+// buf, which starts after the opening parenthesis. It returns the raw
+// arguments and the number of bytes consumed, including the closing
+// parenthesis. Balanced parentheses may appear in arguments, and ',' or ')'
+// can be escaped with '\'.
+func scanArguments(buf []byte) ([]string, int, bool) {
+	var args []string
+	var arg []byte
+	depth := 0
+	for i := 0; i < len(buf); i++ {
+		switch c := buf[i]; c {
+		case '\\':
+			arg = append(arg, c)
+			if i+1 < len(buf) {
+				i++
+				arg = append(arg, buf[i])
+			}
+		case '(':
+			depth++
+			arg = append(arg, c)
+		case ')':
+			if depth == 0 {
+				args = append(args, trimArgument(string(arg)))
+				return args, i + 1, true
+			}
+			depth--
+			arg = append(arg, c)
+		case ',':
+			if depth == 0 {
+				args = append(args, trimArgument(string(arg)))
+				arg = arg[:0]
+				continue
+			}
+			arg = append(arg, c)
+		default:
+			arg = append(arg, c)
+		}
+	}
+	return nil, 0, false
+}
+
+// scanFunction scans the arguments of a call to the builtin function name in
+// buf, which starts after the opening parenthesis.
+func scanFunction(name string, buf []byte) (Function, int, error) {
+	rawArgs, n, ok := scanArguments(buf)
+	if !ok {
+		return Function{}, 0, errors.Errorf("unterminated call to function %s, expected ')'", name)
+	}
+	if want := builtinFunctions[name].arity; len(rawArgs) != want {
+		return Function{}, 0, errors.Errorf("function %s expects %d argument(s), got %d", name, want, len(rawArgs))
+	}
+	f := Function{Name: name}
+	for _, rawArg := range rawArgs {
+		arg, err := scanTemplate([]byte(rawArg))
+		if err != nil {
+			return Function{}, 0, err
+		}
+		f.Args = append(f.Args, *arg)
+	}
+	return f, n, nil
+}
+
 const varAllowed = "abcdefghijklmnopqrstuvwxyzABCEDEFGHIJKLMNOPQRSTUVWXYZ1234567890_"
 
 // scanTemplate scans an input string to produce a Template. Recognized
-// metavariable syntax is `$(varAllowed+)`.
+// metavariable syntax is `$(varAllowed+)`, optionally followed by an
+// attribute like `.length`. A metavariable that names a builtin function and
+// is followed by '(' is a function call, like `$lower($1)`.
 func scanTemplate(buf []byte) (*Template, error) {
 	// Tracks whether the current token is a variable.
 	var isVariable bool
@@ -123,7 +267,7 @@ func scanTemplate(buf []byte) (*Template, error) {
 					token = append(token, '\r')
 				case 't':
 					token = append(token, '\t')
-				case '\\', '$', ' ', '.':
+				case '\\', '$', ' ', '.', ',', '(', ')':
 					token = append(token, r)
 				default:
 					token = append(token, '\\', r)
@@ -133,6 +277,26 @@ func scanTemplate(buf []byte) (*Template, error) {
 			// Trailing '\'
 			token = append(token, '\\')
 		default:
+			if isVariable && r == '.' {
+				if attr, n := scanAttribute(buf); n > 0 {
+					appendAtom(Variable{Name: string(token), Attribute: attr})
+					buf = buf[n:]
+					isVariable = false
+					continue
+				}
+			}
+			if isVariable && r == '(' {
+				if _, ok := builtinFunctions[string(token[1:])]; ok {
+					f, n, err := scanFunction(string(token[1:]), buf)
+					if err != nil {
+						return nil, err
+					}
+					appendAtom(f)
+					buf = buf[n:]
+					isVariable = false
+					continue
+				}
+			}
 			if isVariable && !strings.ContainsRune(varAllowed, r) {
 				appendAtom(Variable{Name: string(token)}) // Push variable.
 				isVariable = false
@@ -167,6 +331,22 @@ func toJSON(atom Atom) interface{} {
 			Name:      a.Name,
 			Attribute: string(a.Attribute),
 		}
+	case Function:
+		args := make([][]interface{}, 0, len(a.Args))
+		for _, arg := range a.Args {
+			jsons := []interface{}{}
+			for _, atom := range arg {
+				jsons = append(jsons, toJSON(atom))
+			}
+			args = append(args, jsons)
+		}
+		return struct {
+			Name string          `json:"function"`
+			Args [][]interface{} `json:"arguments"`
+		}{
+			Name: a.Name,
+			Args: args,
+		}
 	}
 	panic("unreachable")
 }
@@ -188,58 +368,171 @@ type MetaEnvironment struct {
 	Author  string
 	Date    string
 	Email   string
+	Lang    string
 }
 
-var empty = struct{}{}
+// lookup returns the value of the builtin variable name.
+func (env *MetaEnvironment) lookup(name string) (string, bool) {
+	switch name {
+	case "repo":
+		return env.Repo, true
+	case "path":
+		return env.Path, true
+	case "content":
+		return env.Content, true
+	case "commit":
+		return env.Commit, true
+	case "author":
+		return env.Author, true
+	case "date":
+		return env.Date, true
+	case "email":
+		return env.Email, true
+	case "lang":
+		return env.Lang, true
+	}
+	return "", false
+}
 
-var builtinVariables = map[string]struct{}{
-	"repo":    empty,
-	"path":    empty,
-	"content": empty,
-	"commit":  empty,
-	"author":  empty,
-	"date":    empty,
-	"email":   empty,
+// resolvable returns whether all variables in atom can be substituted for. If
+// captures is nil, only builtin variables can be substituted for.
+func resolvable(atom Atom, env *MetaEnvironment, captures Environment) bool {
+	switch a := atom.(type) {
+	case Variable:
+		_, ok := env.lookup(a.Name[1:])
+		return ok || captures != nil
+	case Function:
+		for _, arg := range a.Args {
+			for _, atom := range arg {
+				if !resolvable(atom, env, captures) {
+					return false
+				}
+			}
+		}
+	}
+	return true
 }
 
-func templatize(pattern string) (string, error) {
-	t, err := scanTemplate([]byte(pattern))
-	if err != nil {
-		return "", err
+func formatRange(r Range) string {
+	return fmt.Sprintf("%d:%d-%d:%d", r.Start.Line, r.Start.Column, r.End.Line, r.End.Column)
+}
+
+func evaluateVariable(v Variable, env *MetaEnvironment, captures Environment) (string, error) {
+	var data Data
+	if value, ok := env.lookup(v.Name[1:]); ok {
+		if v.Attribute == RangeAttr {
+			return "", errors.Errorf("attribute %s is not supported for builtin variable %s", v.Attribute, v.Name)
+		}
+		data = Data{Value: value}
+	} else {
+		// Like regular expression expansion, a variable that does not
+		// correspond to a capture group is empty.
+		data = captures[v.Name[1:]]
 	}
-	var templatized []string
-	for _, atom := range *t {
-		switch a := atom.(type) {
-		case Constant:
-			templatized = append(templatized, string(a))
-		case Variable:
-			if _, ok := builtinVariables[a.Name[1:]]; ok {
-				templateVar := strings.Title(a.Name[1:])
-				templatized = append(templatized, `{{.`+templateVar+`}}`)
-				continue
+
+	switch v.Attribute {
+	case LengthAttr:
+		return strconv.Itoa(utf8.RuneCountInString(data.Value)), nil
+	case RangeAttr:
+		if data.Value == "" && data.Range == (Range{}) {
+			return "", nil
+		}
+		return formatRange(data.Range), nil
+	}
+	return data.Value, nil
+}
+
+func evaluateAtom(atom Atom, env *MetaEnvironment, captures Environment) (string, error) {
+	if !resolvable(atom, env, captures) {
+		// Leave alone atoms that refer to variables that don't correspond
+		// to builtins, so that they can be substituted later (e.g., by
+		// comby).
+		return atom.String(), nil
+	}
+	switch a := atom.(type) {
+	case Constant:
+		return string(a), nil
+	case Variable:
+		return evaluateVariable(a, env, captures)
+	case Function:
+		args := make([]string, 0, len(a.Args))
+		for _, arg := range a.Args {
+			value, err := arg.evaluate(env, captures)
+			if err != nil {
+				return "", err
 			}
-			// Leave alone other variables that don't correspond to
-			// builtins (e.g., regex capture groups)
-			templatized = append(templatized, a.Name)
+			args = append(args, value)
+		}
+		return builtinFunctions[a.Name].apply(args), nil
+	}
+	panic("unreachable")
+}
+
+// evaluate substitutes values for the variables and function calls in the
+// template. Builtin variables are looked up in env, and all other variables
+// in captures. If captures is nil, variables that are not builtins are output
+// unchanged.
+func (t *Template) evaluate(env *MetaEnvironment, captures Environment) (string, error) {
+	var b strings.Builder
+	for _, atom := range *t {
+		value, err := evaluateAtom(atom, env, captures)
+		if err != nil {
+			return "", err
 		}
+		b.WriteString(value)
 	}
-	return strings.Join(templatized, ""), nil
+	return b.String(), nil
 }
 
+// substituteMetaVariables substitutes values in env for builtin variables in
+// pattern, leaving other variables unchanged.
 func substituteMetaVariables(pattern string, env *MetaEnvironment) (string, error) {
-	templated, err := templatize(pattern)
+	t, err := scanTemplate([]byte(pattern))
 	if err != nil {
 		return "", err
 	}
-	t, err := template.New("").Parse(templated)
-	if err != nil {
-		return "", err
+	return t.evaluate(env, nil)
+}
+
+// lineOffsets returns the offsets at which lines start in content.
+func lineOffsets(content string) []int {
+	offsets := []int{0}
+	for i, c := range content {
+		if c == '\n' {
+			offsets = append(offsets, i+1)
+		}
 	}
-	var result strings.Builder
-	if err := t.Execute(&result, env); err != nil {
-		return "", err
+	return offsets
+}
+
+// toLocation converts an offset in the content with line offsets lines to a
+// location.
+func toLocation(lines []int, offset int) Location {
+	line := sort.SearchInts(lines, offset+1) - 1
+	return newLocation(line, offset-lines[line], offset)
+}
+
+// regexpCaptures returns the values of the capture groups of a single match
+// of r in content, by index and by name. Index 0 is the entire match.
+func regexpCaptures(content string, lines []int, r *regexp.Regexp, submatches []int) Environment {
+	env := make(Environment)
+	names := r.SubexpNames()
+	for j := 0; j < len(submatches); j += 2 {
+		start, end := submatches[j], submatches[j+1]
+		if start == -1 || end == -1 {
+			// The capture group did not participate in the match.
+			continue
+		}
+		data := Data{
+			Value: content[start:end],
+			Range: Range{Start: toLocation(lines, start), End: toLocation(lines, end)},
+		}
+		env[strconv.Itoa(j/2)] = data
+		if names[j/2] != "" {
+			env[names[j/2]] = data
+		}
 	}
-	return result.String(), nil
+	return env
 }
 
 // NewMetaEnvironment maps results to a metavariable:value environment where
@@ -252,6 +545,7 @@ func NewMetaEnvironment(r result.Match, content string) *MetaEnvironment {
 			Path:    m.Path,
 			Commit:  string(m.CommitID),
 			Content: content,
+			Lang:    enry.GetLanguage(filepath.Base(m.Path), []byte(content)),
 		}
 	case *result.CommitMatch:
 		return &MetaEnvironment{
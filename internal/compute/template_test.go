@@ -2,6 +2,7 @@ package compute
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hexops/autogold"
@@ -60,26 +61,41 @@ func Test_scanTemplate(t *testing.T) {
 		"metachar escaping",
 		`[{"constant":"$repo "}]`).
 		Equal(t, test(`\$repo `))
-}
 
-func Test_templatize(t *testing.T) {
-	test := func(input string) string {
-		t, err := templatize(input)
-		if err != nil {
-			return fmt.Sprintf("Error: %s", err)
-		}
-		return t
-	}
+	autogold.Want(
+		"attributes",
+		`[{"variable":"$1","attribute":"length"},{"constant":" "},{"variable":"$name","attribute":"range"},{"constant":"."}]`).
+		Equal(t, test(`$1.length $name.range.`))
 
 	autogold.Want(
-		"basic templatize",
-		"artifcats: {{.Repo}}").
-		Equal(t, test("artifcats: $repo"))
+		"not an attribute",
+		`[{"variable":"$1"},{"constant":".lengthy "},{"variable":"$1"},{"constant":".foo"}]`).
+		Equal(t, test(`$1.lengthy $1.foo`))
+
+	autogold.Want(
+		"function call",
+		`[{"constant":"x "},{"function":"upper","arguments":[[{"variable":"$1"}]]},{"constant":" y"}]`).
+		Equal(t, test(`x $upper($1) y`))
+
+	autogold.Want(
+		"function call with multiple and nested arguments",
+		`[{"function":"replace","arguments":[[{"function":"lower","arguments":[[{"variable":"$path"}]]}],[{"constant":"/"}],[{"constant":", "}]]}]`).
+		Equal(t, test(`$replace($lower($path), /, \,\ )`))
+
+	autogold.Want(
+		"unknown function is a variable",
+		`[{"variable":"$foo"},{"constant":"("},{"variable":"$1"},{"constant":")"}]`).
+		Equal(t, test(`$foo($1)`))
 
 	autogold.Want(
-		"exclude regex var in templatize",
-		"artifcats: {{.Repo}} $1").
-		Equal(t, test("artifcats: $repo $1"))
+		"unterminated function call",
+		"Error: unterminated call to function upper, expected ')'").
+		Equal(t, test(`$upper($1`))
+
+	autogold.Want(
+		"function arity",
+		"Error: function replace expects 3 argument(s), got 1").
+		Equal(t, test(`$replace($1)`))
 }
 
 func Test_substituteMetaVariables(t *testing.T) {
@@ -98,4 +114,76 @@ func Test_substituteMetaVariables(t *testing.T) {
 			"artifcats: $1 $foo $author",
 			&MetaEnvironment{Author: "hi"},
 		))
+
+	autogold.Want(
+		"substitute for meta values",
+		"artifcats: github.com/foo/bar").
+		Equal(t, test("artifcats: $repo", &MetaEnvironment{Repo: "github.com/foo/bar"}))
+
+	autogold.Want(
+		"functions and attributes on meta values",
+		`"MAIN.GO" 7 $upper($1) $1.length`).
+		Equal(t, test(
+			"$json($upper($path)) $path.length $upper($1) $1.length",
+			&MetaEnvironment{Path: "main.go"},
+		))
+
+	autogold.Want(
+		"range of meta value",
+		"Error: attribute range is not supported for builtin variable $path").
+		Equal(t, test("$path.range", &MetaEnvironment{Path: "main.go"}))
+}
+
+func Test_substituteRegexp(t *testing.T) {
+	test := func(content, pattern, template string, env *MetaEnvironment) string {
+		t, err := substituteRegexp(content, regexp.MustCompile(pattern), template, "\n", env)
+		if err != nil {
+			return fmt.Sprintf("Error: %s", err)
+		}
+		return t
+	}
+
+	autogold.Want(
+		"capture group attributes",
+		"foo 3 0:5-0:8\nbar 3 1:5-1:8\n").
+		Equal(t, test("func foo()\nfunc bar()", `func (\w+)`, "$1 $1.length $1.range", &MetaEnvironment{}))
+
+	autogold.Want(
+		"named capture group",
+		"foo\n").
+		Equal(t, test("func foo()", `func (?P<name>\w+)`, "$name", &MetaEnvironment{}))
+
+	autogold.Want(
+		"functions",
+		"FOO,foo,\"foo\",f00\n").
+		Equal(t, test("func  Foo ()", `func( +Foo +)`, "$upper($trim($1)),$lower($trim($1)),$json($lower($trim($1))),$replace($lower($trim($1)), o, 0)", &MetaEnvironment{}))
+
+	autogold.Want(
+		"csv report with metadata",
+		"github.com/foo/bar,main.go,Go,Foo\n").
+		Equal(t, test("func Foo()", `func (\w+)`, "$repo,$path,$lang,$1", &MetaEnvironment{
+			Repo: "github.com/foo/bar",
+			Path: "main.go",
+			Lang: "Go",
+		}))
+
+	autogold.Want(
+		"unmatched capture group is empty",
+		"[] 0\n").
+		Equal(t, test("ab", `a(x)?b`, "[$1] $1.length", &MetaEnvironment{}))
+
+	autogold.Want(
+		"braced capture group references",
+		"foo-bar foo_bar\n").
+		Equal(t, test("foo bar", `(\w+) (?P<name>\w+)`, "${1}-${name} ${1}_${2}", &MetaEnvironment{}))
+
+	autogold.Want(
+		"escaped dollar",
+		"$1 costs $foo\n").
+		Equal(t, test("foo", `(\w+)`, "$$1 costs $$$1", &MetaEnvironment{}))
+
+	autogold.Want(
+		"captured values are not evaluated",
+		"$PATH.LENGTH, (X) main.go\n").
+		Equal(t, test("$path.length, (x)", `(.*)`, "$upper($1) $path", &MetaEnvironment{Path: "main.go"}))
 }
@@ -369,6 +369,16 @@ func GitMaxConcurrentClones() int {
 	return v
 }
 
+// GitReplicationFactor returns the number of gitservers each repository is
+// stored on, which is at least 1.
+func GitReplicationFactor() int {
+	v := Get().GitReplicationFactor
+	if v < 1 {
+		return 1
+	}
+	return v
+}
+
 func UserReposMaxPerUser() int {
 	v := Get().UserReposMaxPerUser
 	if v == 0 {
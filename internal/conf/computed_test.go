@@ -212,6 +212,47 @@ func TestGitMaxConcurrentClones(t *testing.T) {
 	}
 }
 
+func TestGitReplicationFactor(t *testing.T) {
+	tests := []struct {
+		name string
+		sc   *Unified
+		want int
+	}{
+		{
+			name: "not set should return default",
+			sc:   &Unified{SiteConfiguration: schema.SiteConfiguration{}},
+			want: 1,
+		},
+		{
+			name: "bad value should return default",
+			sc: &Unified{
+				SiteConfiguration: schema.SiteConfiguration{
+					GitReplicationFactor: -1,
+				},
+			},
+			want: 1,
+		},
+		{
+			name: "set value should be returned",
+			sc: &Unified{
+				SiteConfiguration: schema.SiteConfiguration{
+					GitReplicationFactor: 3,
+				},
+			},
+			want: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			Mock(test.sc)
+			if got, want := GitReplicationFactor(), test.want; got != want {
+				t.Fatalf("GitReplicationFactor() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func setenv(t *testing.T, keyval string) func() {
 	t.Helper()
 
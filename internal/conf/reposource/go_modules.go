@@ -0,0 +1,91 @@
+package reposource
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+// GoModule is a Go module identified by its module path, such as
+// "golang.org/x/mod".
+type GoModule struct {
+	Path string
+}
+
+// ParseGoModuleFromRepoURL parses the module from a repository URL path
+// without a leading `/`, such as "go/golang.org/x/mod".
+func ParseGoModuleFromRepoURL(urlPath string) (GoModule, error) {
+	if !strings.HasPrefix(urlPath, "go/") {
+		return GoModule{}, fmt.Errorf("failed to parse a Go module from the path %s", urlPath)
+	}
+	path := strings.TrimPrefix(urlPath, "go/")
+	if err := module.CheckPath(path); err != nil {
+		return GoModule{}, err
+	}
+	return GoModule{Path: path}, nil
+}
+
+// PackageSyntax returns the module path.
+func (m GoModule) PackageSyntax() string {
+	return m.Path
+}
+
+func (m GoModule) RepoName() api.RepoName {
+	return api.RepoName("go/" + m.Path)
+}
+
+func (m GoModule) CloneURL() string {
+	cloneURL := url.URL{Path: string(m.RepoName())}
+	return cloneURL.String()
+}
+
+// GoDependency is a specific version of a Go module.
+type GoDependency struct {
+	GoModule
+	Version string
+}
+
+// ParseGoDependency parses a dependency string of the form "path@version",
+// such as "golang.org/x/mod@v0.5.1". The version must be a canonical semantic
+// version, which includes pseudo-versions.
+func ParseGoDependency(dependency string) (GoDependency, error) {
+	i := strings.LastIndex(dependency, "@")
+	if i <= 0 {
+		return GoDependency{}, fmt.Errorf("Go dependency %q must be of the form path@version", dependency)
+	}
+	path, version := dependency[:i], dependency[i+1:]
+	if err := module.Check(path, version); err != nil {
+		return GoDependency{}, err
+	}
+	return GoDependency{GoModule: GoModule{Path: path}, Version: version}, nil
+}
+
+// PackageManagerSyntax returns the dependency in the form accepted by
+// `go get`, such as "golang.org/x/mod@v0.5.1".
+func (d GoDependency) PackageManagerSyntax() string {
+	return d.Path + "@" + d.Version
+}
+
+// GitTagFromVersion returns the version itself, since Go module versions
+// already are the git tags of the upstream repository.
+func (d GoDependency) GitTagFromVersion() string {
+	return d.Version
+}
+
+// SortGoDependencies sorts the dependencies by module path and then by
+// semantic version in descending order, so that the latest version of a module
+// comes first.
+func SortGoDependencies(dependencies []GoDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].Path != dependencies[j].Path {
+			return dependencies[i].Path > dependencies[j].Path
+		}
+		return semver.Compare(dependencies[i].Version, dependencies[j].Version) > 0
+	})
+}
@@ -0,0 +1,59 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseGoDependency(t *testing.T) {
+	dependency, err := ParseGoDependency("golang.org/x/mod@v0.5.1")
+	assert.Nil(t, err)
+	assert.Equal(t, "golang.org/x/mod", dependency.Path)
+	assert.Equal(t, "v0.5.1", dependency.Version)
+	assert.Equal(t, "v0.5.1", dependency.GitTagFromVersion())
+	assert.Equal(t, api.RepoName("go/golang.org/x/mod"), dependency.RepoName())
+
+	for _, invalid := range []string{"golang.org/x/mod", "golang.org/x/mod@0.5.1", "@v1.0.0", "golang.org/x/mod/v2@v1.0.0"} {
+		_, err := ParseGoDependency(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestParseGoModuleFromRepoURL(t *testing.T) {
+	module, err := ParseGoModuleFromRepoURL("go/github.com/gorilla/mux")
+	assert.Nil(t, err)
+	assert.Equal(t, GoModule{Path: "github.com/gorilla/mux"}, module)
+
+	_, err = ParseGoModuleFromRepoURL("npm/github.com/gorilla/mux")
+	assert.NotNil(t, err)
+}
+
+func parseGoDependencyOrPanic(t *testing.T, value string) GoDependency {
+	dependency, err := ParseGoDependency(value)
+	if err != nil {
+		t.Fatalf("error=%s", err)
+	}
+	return dependency
+}
+
+func TestSortGoDependencies(t *testing.T) {
+	dependencies := []GoDependency{
+		parseGoDependencyOrPanic(t, "a.com/a@v1.2.0"),
+		parseGoDependencyOrPanic(t, "b.com/a@v1.2.0"),
+		parseGoDependencyOrPanic(t, "a.com/a@v1.11.0"),
+		parseGoDependencyOrPanic(t, "a.com/a@v1.2.0-rc.1"),
+		parseGoDependencyOrPanic(t, "a.com/a@v0.0.0-20211110000000-abcdefabcdef"),
+	}
+	expected := []GoDependency{
+		parseGoDependencyOrPanic(t, "b.com/a@v1.2.0"),
+		parseGoDependencyOrPanic(t, "a.com/a@v1.11.0"),
+		parseGoDependencyOrPanic(t, "a.com/a@v1.2.0"),
+		parseGoDependencyOrPanic(t, "a.com/a@v1.2.0-rc.1"),
+		parseGoDependencyOrPanic(t, "a.com/a@v0.0.0-20211110000000-abcdefabcdef"),
+	}
+	SortGoDependencies(dependencies)
+	assert.Equal(t, expected, dependencies)
+}
@@ -76,6 +76,18 @@ func (d MavenDependency) CoursierSyntax() string {
 	return fmt.Sprintf("%s:%s:%s", d.MavenModule.GroupID, d.MavenModule.ArtifactID, d.Version)
 }
 
+// PackageSyntax returns the module in Coursier syntax, such as
+// "junit:junit".
+func (d MavenDependency) PackageSyntax() string {
+	return d.MavenModule.CoursierSyntax()
+}
+
+// PackageManagerSyntax returns the dependency in Coursier syntax, such as
+// "junit:junit:4.13.2".
+func (d MavenDependency) PackageManagerSyntax() string {
+	return d.CoursierSyntax()
+}
+
 func (d MavenDependency) GitTagFromVersion() string {
 	return "v" + d.Version
 }
@@ -0,0 +1,132 @@
+package reposource
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+)
+
+// npmPackageNamePattern matches the name of a package without its scope. It
+// follows the rules enforced by the public npm registry for new packages, with
+// the exception of upper case letters which older packages may still use.
+var npmPackageNamePattern = lazyregexp.New(`^[a-zA-Z0-9-~][a-zA-Z0-9-._~]*$`)
+
+// NpmPackage is a package published to an npm registry, such as "react" or
+// "@types/node". The scope is stored without the leading "@".
+type NpmPackage struct {
+	Scope string
+	Name  string
+}
+
+// NewNpmPackage returns the package with the given scope (without "@") and
+// name, validating both.
+func NewNpmPackage(scope, name string) (NpmPackage, error) {
+	if scope != "" && !npmPackageNamePattern.MatchString(scope) {
+		return NpmPackage{}, fmt.Errorf("invalid npm package scope %q", scope)
+	}
+	if !npmPackageNamePattern.MatchString(name) {
+		return NpmPackage{}, fmt.Errorf("invalid npm package name %q", name)
+	}
+	return NpmPackage{Scope: scope, Name: name}, nil
+}
+
+// ParseNpmPackageFromPackageSyntax parses a package name as it's written in a
+// package.json file, such as "react" or "@types/node".
+func ParseNpmPackageFromPackageSyntax(pkg string) (NpmPackage, error) {
+	if !strings.HasPrefix(pkg, "@") {
+		return NewNpmPackage("", pkg)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(pkg, "@"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return NpmPackage{}, fmt.Errorf("scoped npm package %q must be of the form @scope/name", pkg)
+	}
+	return NewNpmPackage(parts[0], parts[1])
+}
+
+// ParseNpmPackageFromRepoURL parses the package from a repository URL path
+// without a leading `/`, such as "npm/react" or "npm/types/node".
+func ParseNpmPackageFromRepoURL(urlPath string) (NpmPackage, error) {
+	parts := strings.Split(strings.TrimPrefix(urlPath, "npm/"), "/")
+	switch {
+	case !strings.HasPrefix(urlPath, "npm/"):
+	case len(parts) == 1:
+		return NewNpmPackage("", parts[0])
+	case len(parts) == 2:
+		return NewNpmPackage(parts[0], parts[1])
+	}
+	return NpmPackage{}, fmt.Errorf("failed to parse an npm package from the path %s", urlPath)
+}
+
+// PackageSyntax returns the name of the package as it's written in a
+// package.json file.
+func (p NpmPackage) PackageSyntax() string {
+	if p.Scope == "" {
+		return p.Name
+	}
+	return "@" + p.Scope + "/" + p.Name
+}
+
+func (p NpmPackage) RepoName() api.RepoName {
+	if p.Scope == "" {
+		return api.RepoName("npm/" + p.Name)
+	}
+	return api.RepoName("npm/" + p.Scope + "/" + p.Name)
+}
+
+func (p NpmPackage) CloneURL() string {
+	cloneURL := url.URL{Path: string(p.RepoName())}
+	return cloneURL.String()
+}
+
+// NpmDependency is a specific version of an npm package.
+type NpmDependency struct {
+	NpmPackage
+	Version string
+}
+
+// ParseNpmDependency parses a dependency string of the form
+// "(@scope/)?name@version", as accepted by `npm install`.
+func ParseNpmDependency(dependency string) (NpmDependency, error) {
+	i := strings.LastIndex(dependency, "@")
+	if i <= 0 || i == len(dependency)-1 {
+		return NpmDependency{}, fmt.Errorf("npm dependency %q must be of the form (@scope/)?name@version", dependency)
+	}
+	pkg, err := ParseNpmPackageFromPackageSyntax(dependency[:i])
+	if err != nil {
+		return NpmDependency{}, err
+	}
+	return NpmDependency{NpmPackage: pkg, Version: dependency[i+1:]}, nil
+}
+
+// PackageManagerSyntax returns the dependency in the form accepted by
+// `npm install`, such as "react@17.0.2".
+func (d NpmDependency) PackageManagerSyntax() string {
+	return d.PackageSyntax() + "@" + d.Version
+}
+
+func (d NpmDependency) GitTagFromVersion() string {
+	return "v" + d.Version
+}
+
+// SortNpmDependencies sorts the dependencies by package and then by semantic
+// version in descending order, so that the latest version of a package comes
+// first.
+func SortNpmDependencies(dependencies []NpmDependency) {
+	sort.Slice(dependencies, func(i, j int) bool {
+		if dependencies[i].NpmPackage != dependencies[j].NpmPackage {
+			return dependencies[i].PackageSyntax() > dependencies[j].PackageSyntax()
+		}
+		v1, err1 := semver.NewVersion(dependencies[i].Version)
+		v2, err2 := semver.NewVersion(dependencies[j].Version)
+		if err1 != nil || err2 != nil {
+			return versionGreaterThan(dependencies[i].Version, dependencies[j].Version)
+		}
+		return v1.GreaterThan(v2)
+	})
+}
@@ -0,0 +1,76 @@
+package reposource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestParseNpmDependency(t *testing.T) {
+	dependency, err := ParseNpmDependency("@types/node@16.11.7")
+	assert.Nil(t, err)
+	assert.Equal(t, "types", dependency.Scope)
+	assert.Equal(t, "node", dependency.Name)
+	assert.Equal(t, "16.11.7", dependency.Version)
+	assert.Equal(t, "@types/node@16.11.7", dependency.PackageManagerSyntax())
+	assert.Equal(t, api.RepoName("npm/types/node"), dependency.RepoName())
+	assert.Equal(t, "v16.11.7", dependency.GitTagFromVersion())
+
+	dependency, err = ParseNpmDependency("react@17.0.2")
+	assert.Nil(t, err)
+	assert.Equal(t, NpmPackage{Name: "react"}, dependency.NpmPackage)
+	assert.Equal(t, api.RepoName("npm/react"), dependency.RepoName())
+
+	for _, invalid := range []string{"react", "react@", "@types/node", "@types@1.0.0", "@/node@1.0.0"} {
+		_, err := ParseNpmDependency(invalid)
+		assert.NotNil(t, err, invalid)
+	}
+}
+
+func TestParseNpmPackageFromRepoURL(t *testing.T) {
+	pkg, err := ParseNpmPackageFromRepoURL("npm/types/node")
+	assert.Nil(t, err)
+	assert.Equal(t, "@types/node", pkg.PackageSyntax())
+
+	pkg, err = ParseNpmPackageFromRepoURL("npm/react")
+	assert.Nil(t, err)
+	assert.Equal(t, "react", pkg.PackageSyntax())
+
+	_, err = ParseNpmPackageFromRepoURL("maven/react")
+	assert.NotNil(t, err)
+	_, err = ParseNpmPackageFromRepoURL("npm/a/b/c")
+	assert.NotNil(t, err)
+}
+
+func parseNpmDependencyOrPanic(t *testing.T, value string) NpmDependency {
+	dependency, err := ParseNpmDependency(value)
+	if err != nil {
+		t.Fatalf("error=%s", err)
+	}
+	return dependency
+}
+
+func TestSortNpmDependencies(t *testing.T) {
+	dependencies := []NpmDependency{
+		parseNpmDependencyOrPanic(t, "a@1.2.0"),
+		parseNpmDependencyOrPanic(t, "@b/a@1.2.0"),
+		parseNpmDependencyOrPanic(t, "c@1.2.0"),
+		parseNpmDependencyOrPanic(t, "a@1.11.0"),
+		parseNpmDependencyOrPanic(t, "a@1.2.0-rc.1"),
+		parseNpmDependencyOrPanic(t, "a@1.2.0-rc.11"),
+		parseNpmDependencyOrPanic(t, "a@1.1.0"),
+	}
+	expected := []NpmDependency{
+		parseNpmDependencyOrPanic(t, "c@1.2.0"),
+		parseNpmDependencyOrPanic(t, "a@1.11.0"),
+		parseNpmDependencyOrPanic(t, "a@1.2.0"),
+		parseNpmDependencyOrPanic(t, "a@1.2.0-rc.11"),
+		parseNpmDependencyOrPanic(t, "a@1.2.0-rc.1"),
+		parseNpmDependencyOrPanic(t, "a@1.1.0"),
+		parseNpmDependencyOrPanic(t, "@b/a@1.2.0"),
+	}
+	SortNpmDependencies(dependencies)
+	assert.Equal(t, expected, dependencies)
+}
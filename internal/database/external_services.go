@@ -232,14 +232,19 @@ var ExternalServiceKinds = map[string]ExternalServiceKind{
 	extsvc.KindAWSCodeCommit:   {CodeHost: true, JSONSchema: schema.AWSCodeCommitSchemaJSON},
 	extsvc.KindBitbucketCloud:  {CodeHost: true, JSONSchema: schema.BitbucketCloudSchemaJSON},
 	extsvc.KindBitbucketServer: {CodeHost: true, JSONSchema: schema.BitbucketServerSchemaJSON},
+	extsvc.KindGitea:           {CodeHost: true, JSONSchema: schema.GiteaSchemaJSON},
 	extsvc.KindGitHub:          {CodeHost: true, JSONSchema: schema.GitHubSchemaJSON},
 	extsvc.KindGitLab:          {CodeHost: true, JSONSchema: schema.GitLabSchemaJSON},
 	extsvc.KindGitolite:        {CodeHost: true, JSONSchema: schema.GitoliteSchemaJSON},
+	extsvc.KindGoModules:       {CodeHost: true, JSONSchema: schema.GoModulesSchemaJSON},
 	extsvc.KindJVMPackages:     {CodeHost: true, JSONSchema: schema.JVMPackagesSchemaJSON},
+	extsvc.KindMercurial:       {CodeHost: true, JSONSchema: schema.MercurialSchemaJSON},
+	extsvc.KindNpmPackages:     {CodeHost: true, JSONSchema: schema.NpmPackagesSchemaJSON},
 	extsvc.KindOther:           {CodeHost: true, JSONSchema: schema.OtherExternalServiceSchemaJSON},
 	extsvc.KindPagure:          {CodeHost: true, JSONSchema: schema.PagureSchemaJSON},
 	extsvc.KindPerforce:        {CodeHost: true, JSONSchema: schema.PerforceSchemaJSON},
 	extsvc.KindPhabricator:     {CodeHost: true, JSONSchema: schema.PhabricatorSchemaJSON},
+	extsvc.KindSubversion:      {CodeHost: true, JSONSchema: schema.SubversionSchemaJSON},
 }
 
 // ExternalServiceKind describes a kind of external service.
@@ -468,6 +473,20 @@ func (e *externalServiceStore) ValidateConfig(ctx context.Context, opt ValidateE
 			return nil, err
 		}
 		err = validateOtherExternalServiceConnection(&c)
+
+	case extsvc.KindMercurial:
+		var c schema.MercurialConnection
+		if err = jsoniter.Unmarshal(normalized, &c); err != nil {
+			return nil, err
+		}
+		err = validateRepoCloneURLs(c.Url, c.Repos, "http", "https", "ssh")
+
+	case extsvc.KindSubversion:
+		var c schema.SubversionConnection
+		if err = jsoniter.Unmarshal(normalized, &c); err != nil {
+			return nil, err
+		}
+		err = validateRepoCloneURLs(c.Url, c.Repos, "http", "https", "svn", "svn+ssh")
 	}
 
 	return normalized, multierror.Append(errs, err).ErrorOrNil()
@@ -478,30 +497,43 @@ func (e *externalServiceStore) ValidateConfig(ctx context.Context, opt ValidateE
 // match the uri-reference format when url is set, instead of uri when
 // it isn't.
 func validateOtherExternalServiceConnection(c *schema.OtherExternalServiceConnection) error {
+	return validateRepoCloneURLs(c.Url, c.Repos, "git", "http", "https", "ssh")
+}
+
+// validateRepoCloneURLs validates that each of repos, resolved against
+// baseURL if it is set, is a clone URL using one of the given schemes.
+func validateRepoCloneURLs(baseURL string, repos []string, schemes ...string) error {
 	parseRepo := url.Parse
-	if c.Url != "" {
+	if baseURL != "" {
 		// We ignore the error because this already validated by JSON Schema.
-		baseURL, _ := url.Parse(c.Url)
-		parseRepo = baseURL.Parse
+		base, _ := url.Parse(baseURL)
+		parseRepo = base.Parse
 	}
 
-	for i, repo := range c.Repos {
+	for i, repo := range repos {
 		cloneURL, err := parseRepo(repo)
 		if err != nil {
 			return errors.Errorf(`repos.%d: %s`, i, err)
 		}
 
-		switch cloneURL.Scheme {
-		case "git", "http", "https", "ssh":
-			continue
-		default:
-			return errors.Errorf("repos.%d: scheme %q not one of git, http, https or ssh", i, cloneURL.Scheme)
+		if !stringSliceContains(schemes, cloneURL.Scheme) {
+			return errors.Errorf("repos.%d: scheme %q not one of %s or %s", i, cloneURL.Scheme,
+				strings.Join(schemes[:len(schemes)-1], ", "), schemes[len(schemes)-1])
 		}
 	}
 
 	return nil
 }
 
+func stringSliceContains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *externalServiceStore) validateGitHubConnection(ctx context.Context, id int64, c *schema.GitHubConnection) error {
 	err := new(multierror.Error)
 	for _, validate := range e.gitHubValidators {
@@ -1488,6 +1520,8 @@ func configurationHasWebhooks(config interface{}) bool {
 		return len(v.Webhooks) > 0
 	case *schema.BitbucketServerConnection:
 		return v.WebhookSecret() != ""
+	case *schema.BitbucketCloudConnection:
+		return v.WebhookSecret != ""
 	}
 
 	return false
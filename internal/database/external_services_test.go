@@ -155,6 +155,18 @@ func TestExternalServicesStore_ValidateConfig(t *testing.T) {
 			config:  `{"url": "https://bitbucket.org", "username": "ceo", "appPassword": "abc"}`,
 			wantErr: "<nil>",
 		},
+		{
+			name:    "0 errors - Mercurial",
+			kind:    extsvc.KindMercurial,
+			config:  `{"url": "https://hg.sgdev.org", "repos": ["core"]}`,
+			wantErr: "<nil>",
+		},
+		{
+			name:    "Subversion repo with unsupported scheme",
+			kind:    extsvc.KindSubversion,
+			config:  `{"url": "svn://svn.sgdev.org", "repos": ["git://svn.sgdev.org/legacy"]}`,
+			wantErr: "1 error occurred:\n\t* repos.0: scheme \"git\" not one of http, https, svn or svn+ssh\n\n",
+		},
 		{
 			name:    "1 error",
 			kind:    extsvc.KindGitHub,
@@ -85,7 +85,7 @@ func searchContextsPermissionsCondition(ctx context.Context, db dbutil.DB) (*sql
 }
 
 const listSearchContextsFmtStr = `
-SELECT sc.id, sc.name, sc.description, sc.public, sc.namespace_user_id, sc.namespace_org_id, sc.updated_at, u.username, o.name
+SELECT sc.id, sc.name, sc.description, sc.public, sc.namespace_user_id, sc.namespace_org_id, sc.updated_at, sc.query, u.username, o.name
 FROM search_contexts sc
 LEFT JOIN users u on sc.namespace_user_id = u.id
 LEFT JOIN orgs o on sc.namespace_org_id = o.id
@@ -306,8 +306,8 @@ func (s *searchContextsStore) DeleteSearchContext(ctx context.Context, searchCon
 
 const insertSearchContextFmtStr = `
 INSERT INTO search_contexts
-(name, description, public, namespace_user_id, namespace_org_id)
-VALUES (%s, %s, %s, %s, %s)
+(name, description, public, namespace_user_id, namespace_org_id, query)
+VALUES (%s, %s, %s, %s, %s, %s)
 `
 
 // 🚨 SECURITY: The caller must ensure that the actor is a site admin or has permission to create the search context.
@@ -336,6 +336,7 @@ SET
 	name = %s,
 	description = %s,
 	public = %s,
+	query = %s,
 	updated_at = now()
 WHERE id = %d
 `
@@ -353,6 +354,15 @@ func (s *searchContextsStore) UpdateSearchContextWithRepositoryRevisions(ctx con
 		return nil, err
 	}
 
+	if updatedSearchContext.Query != "" {
+		// Query-defined search contexts have no static repository revisions, drop
+		// any left over from before the context was defined by a query.
+		err = tx.Exec(ctx, sqlf.Sprintf("DELETE FROM search_context_repos WHERE search_context_id = %d", updatedSearchContext.ID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = tx.SetSearchContextRepositoryRevisions(ctx, updatedSearchContext.ID, repositoryRevisions)
 	if err != nil {
 		return nil, err
@@ -400,6 +410,7 @@ func createSearchContext(ctx context.Context, s SearchContextsStore, searchConte
 		searchContext.Public,
 		nullInt32Column(searchContext.NamespaceUserID),
 		nullInt32Column(searchContext.NamespaceOrgID),
+		nullStringColumn(searchContext.Query),
 	)
 	_, err := s.Handle().DB().ExecContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
 	if err != nil {
@@ -418,6 +429,7 @@ func updateSearchContext(ctx context.Context, s SearchContextsStore, searchConte
 		searchContext.Name,
 		searchContext.Description,
 		searchContext.Public,
+		nullStringColumn(searchContext.Query),
 		searchContext.ID,
 	)
 	_, err := s.Handle().DB().ExecContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
@@ -454,6 +466,7 @@ func scanSearchContexts(rows *sql.Rows) ([]*types.SearchContext, error) {
 			&dbutil.NullInt32{N: &sc.NamespaceUserID},
 			&dbutil.NullInt32{N: &sc.NamespaceOrgID},
 			&sc.UpdatedAt,
+			&dbutil.NullString{S: &sc.Query},
 			&dbutil.NullString{S: &sc.NamespaceUserName},
 			&dbutil.NullString{S: &sc.NamespaceOrgName},
 		)
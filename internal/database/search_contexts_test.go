@@ -133,6 +133,10 @@ func TestSearchContexts_Update(t *testing.T) {
 			name:    "update name",
 			updated: set(orgSC, func(sc *types.SearchContext) { sc.Name = "testname" }),
 		},
+		{
+			name:    "update query",
+			updated: set(userSC, func(sc *types.SearchContext) { sc.Query = "repo:^github\\.com/acme/ lang:go" }),
+		},
 	}
 
 	for _, tt := range tests {
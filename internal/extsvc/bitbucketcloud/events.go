@@ -0,0 +1,161 @@
+package bitbucketcloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	eventTypeHeader = "X-Event-Key"
+)
+
+func WebhookEventType(r *http.Request) string {
+	return r.Header.Get(eventTypeHeader)
+}
+
+func ParseWebhookEvent(eventType string, payload []byte) (e interface{}, err error) {
+	switch eventType {
+	case "pullrequest:approved":
+		e = &PullRequestApprovedEvent{}
+	case "pullrequest:unapproved":
+		e = &PullRequestUnapprovedEvent{}
+	case "pullrequest:changes_request_created":
+		e = &PullRequestChangesRequestCreatedEvent{}
+	case "pullrequest:changes_request_removed":
+		e = &PullRequestChangesRequestRemovedEvent{}
+	case "pullrequest:created":
+		e = &PullRequestCreatedEvent{}
+	case "pullrequest:updated":
+		e = &PullRequestUpdatedEvent{}
+	case "pullrequest:fulfilled":
+		e = &PullRequestFulfilledEvent{}
+	case "pullrequest:rejected":
+		e = &PullRequestRejectedEvent{}
+	case "repo:commit_status_created":
+		e = &RepoCommitStatusCreatedEvent{}
+	case "repo:commit_status_updated":
+		e = &RepoCommitStatusUpdatedEvent{}
+	default:
+		return nil, errUnknownWebhookEvent{eventType: eventType}
+	}
+
+	return e, json.Unmarshal(payload, e)
+}
+
+type errUnknownWebhookEvent struct {
+	eventType string
+}
+
+func (e errUnknownWebhookEvent) Error() string {
+	return fmt.Sprintf("unknown webhook event type: %q", e.eventType)
+}
+
+// IsUnknownWebhookEvent reports whether err was returned by ParseWebhookEvent
+// for an event type that isn't supported.
+func IsUnknownWebhookEvent(err error) bool {
+	return errors.HasType(err, errUnknownWebhookEvent{})
+}
+
+// PullRequestEvent contains the fields common to all pull request webhook
+// events.
+type PullRequestEvent struct {
+	Actor       Account     `json:"actor"`
+	PullRequest PullRequest `json:"pullrequest"`
+	Repository  Repo        `json:"repository"`
+}
+
+type PullRequestCreatedEvent struct {
+	PullRequestEvent
+}
+
+type PullRequestUpdatedEvent struct {
+	PullRequestEvent
+}
+
+// PullRequestFulfilledEvent is sent when a pull request is merged.
+type PullRequestFulfilledEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestFulfilledEvent) Key() string {
+	return fmt.Sprintf("fulfilled:%d", e.PullRequest.ID)
+}
+
+// PullRequestRejectedEvent is sent when a pull request is declined.
+type PullRequestRejectedEvent struct {
+	PullRequestEvent
+}
+
+func (e *PullRequestRejectedEvent) Key() string {
+	return fmt.Sprintf("rejected:%d", e.PullRequest.ID)
+}
+
+// Approval is an approval of a pull request by a user.
+type Approval struct {
+	Date time.Time `json:"date"`
+	User Account   `json:"user"`
+}
+
+type PullRequestApprovedEvent struct {
+	PullRequestEvent
+	Approval Approval `json:"approval"`
+}
+
+func (e *PullRequestApprovedEvent) Key() string {
+	return fmt.Sprintf("approved:%s:%s", e.Approval.User.UUID, e.Approval.Date.UTC().Format(time.RFC3339Nano))
+}
+
+type PullRequestUnapprovedEvent struct {
+	PullRequestEvent
+	Approval Approval `json:"approval"`
+}
+
+func (e *PullRequestUnapprovedEvent) Key() string {
+	return fmt.Sprintf("unapproved:%s:%s", e.Approval.User.UUID, e.Approval.Date.UTC().Format(time.RFC3339Nano))
+}
+
+// ChangesRequest is a request for changes on a pull request by a user.
+type ChangesRequest struct {
+	Date time.Time `json:"date"`
+	User Account   `json:"user"`
+}
+
+type PullRequestChangesRequestCreatedEvent struct {
+	PullRequestEvent
+	ChangesRequest ChangesRequest `json:"changes_request"`
+}
+
+func (e *PullRequestChangesRequestCreatedEvent) Key() string {
+	return fmt.Sprintf("changes_request_created:%s:%s", e.ChangesRequest.User.UUID, e.ChangesRequest.Date.UTC().Format(time.RFC3339Nano))
+}
+
+type PullRequestChangesRequestRemovedEvent struct {
+	PullRequestEvent
+	ChangesRequest ChangesRequest `json:"changes_request"`
+}
+
+func (e *PullRequestChangesRequestRemovedEvent) Key() string {
+	return fmt.Sprintf("changes_request_removed:%s:%s", e.ChangesRequest.User.UUID, e.ChangesRequest.Date.UTC().Format(time.RFC3339Nano))
+}
+
+// RepoCommitStatusEvent contains the fields common to commit status webhook
+// events. Commit statuses are not attached to pull requests, but to commits,
+// so the pull requests affected have to be inferred from the RefName of the
+// status.
+type RepoCommitStatusEvent struct {
+	Actor        Account           `json:"actor"`
+	Repository   Repo              `json:"repository"`
+	CommitStatus PullRequestStatus `json:"commit_status"`
+}
+
+type RepoCommitStatusCreatedEvent struct {
+	RepoCommitStatusEvent
+}
+
+type RepoCommitStatusUpdatedEvent struct {
+	RepoCommitStatusEvent
+}
@@ -0,0 +1,74 @@
+package bitbucketcloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseWebhookEvent(t *testing.T) {
+	date := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("unknown event", func(t *testing.T) {
+		_, err := ParseWebhookEvent("repo:push", []byte(`{}`))
+		if !IsUnknownWebhookEvent(err) {
+			t.Fatalf("expected unknown webhook event error, have %v", err)
+		}
+	})
+
+	t.Run("approved", func(t *testing.T) {
+		payload := `{
+			"actor": {"uuid": "{user}"},
+			"pullrequest": {"id": 42},
+			"repository": {"uuid": "{repo}"},
+			"approval": {"date": "2021-10-01T12:00:00Z", "user": {"uuid": "{user}"}}
+		}`
+
+		e, err := ParseWebhookEvent("pullrequest:approved", []byte(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &PullRequestApprovedEvent{
+			PullRequestEvent: PullRequestEvent{
+				Actor:       Account{UUID: "{user}"},
+				PullRequest: PullRequest{ID: 42},
+				Repository:  Repo{UUID: "{repo}"},
+			},
+			Approval: Approval{Date: date, User: Account{UUID: "{user}"}},
+		}
+		if diff := cmp.Diff(want, e); diff != "" {
+			t.Errorf("mismatch (-want +have):\n%s", diff)
+		}
+		if have, want := want.Key(), "approved:{user}:2021-10-01T12:00:00Z"; have != want {
+			t.Errorf("unexpected key: have %q want %q", have, want)
+		}
+	})
+
+	t.Run("commit status", func(t *testing.T) {
+		payload := `{
+			"repository": {"uuid": "{repo}"},
+			"commit_status": {"key": "build", "refname": "feature", "state": "INPROGRESS"}
+		}`
+
+		e, err := ParseWebhookEvent("repo:commit_status_updated", []byte(payload))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &RepoCommitStatusUpdatedEvent{
+			RepoCommitStatusEvent: RepoCommitStatusEvent{
+				Repository: Repo{UUID: "{repo}"},
+				CommitStatus: PullRequestStatus{
+					Key:     "build",
+					RefName: "feature",
+					State:   PullRequestStatusStateInProgress,
+				},
+			},
+		}
+		if diff := cmp.Diff(want, e); diff != "" {
+			t.Errorf("mismatch (-want +have):\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,325 @@
+package bitbucketcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// PullRequestState is the state of a Bitbucket Cloud pull request.
+type PullRequestState string
+
+const (
+	PullRequestStateOpen       PullRequestState = "OPEN"
+	PullRequestStateMerged     PullRequestState = "MERGED"
+	PullRequestStateDeclined   PullRequestState = "DECLINED"
+	PullRequestStateSuperseded PullRequestState = "SUPERSEDED"
+)
+
+// ParticipantRole is the role of a participant on a pull request.
+type ParticipantRole string
+
+const (
+	ParticipantRoleParticipant ParticipantRole = "PARTICIPANT"
+	ParticipantRoleReviewer    ParticipantRole = "REVIEWER"
+)
+
+// ParticipantState is the review state of a participant on a pull request.
+type ParticipantState string
+
+const (
+	ParticipantStateApproved         ParticipantState = "approved"
+	ParticipantStateChangesRequested ParticipantState = "changes_requested"
+	ParticipantStateNull             ParticipantState = ""
+)
+
+// PullRequestStatusState is the state of a build status attached to the head
+// commit of a pull request.
+type PullRequestStatusState string
+
+const (
+	PullRequestStatusStateSuccessful PullRequestStatusState = "SUCCESSFUL"
+	PullRequestStatusStateFailed     PullRequestStatusState = "FAILED"
+	PullRequestStatusStateInProgress PullRequestStatusState = "INPROGRESS"
+	PullRequestStatusStateStopped    PullRequestStatusState = "STOPPED"
+)
+
+// MergeStrategy is the strategy used when merging a pull request.
+type MergeStrategy string
+
+const (
+	MergeStrategyMergeCommit MergeStrategy = "merge_commit"
+	MergeStrategySquash      MergeStrategy = "squash"
+	MergeStrategyFastForward MergeStrategy = "fast_forward"
+)
+
+// Account is a Bitbucket Cloud user or team.
+type Account struct {
+	Links       Links  `json:"links"`
+	Username    string `json:"username"`
+	Nickname    string `json:"nickname"`
+	DisplayName string `json:"display_name"`
+	UUID        string `json:"uuid"`
+	AccountID   string `json:"account_id"`
+}
+
+// PullRequest is a Bitbucket Cloud pull request.
+type PullRequest struct {
+	ID                int64               `json:"id"`
+	Title             string              `json:"title"`
+	Description       string              `json:"description"`
+	State             PullRequestState    `json:"state"`
+	Draft             bool                `json:"draft"`
+	Author            Account             `json:"author"`
+	Source            PullRequestEndpoint `json:"source"`
+	Destination       PullRequestEndpoint `json:"destination"`
+	MergeCommit       *PullRequestCommit  `json:"merge_commit,omitempty"`
+	CloseSourceBranch bool                `json:"close_source_branch"`
+	ClosedBy          *Account            `json:"closed_by,omitempty"`
+	Reason            string              `json:"reason"`
+	Participants      []Participant       `json:"participants"`
+	CommentCount      int64               `json:"comment_count"`
+	TaskCount         int64               `json:"task_count"`
+	CreatedOn         time.Time           `json:"created_on"`
+	UpdatedOn         time.Time           `json:"updated_on"`
+	Links             Links               `json:"links"`
+
+	// Statuses are the build statuses attached to the pull request. They are
+	// not returned by the pull request endpoint, and have to be loaded
+	// separately with LoadPullRequestStatuses.
+	Statuses []*PullRequestStatus `json:"statuses,omitempty"`
+}
+
+// PullRequestEndpoint is the source or destination of a pull request.
+type PullRequestEndpoint struct {
+	Repo   Repo              `json:"repository"`
+	Branch PullRequestBranch `json:"branch"`
+	Commit PullRequestCommit `json:"commit"`
+}
+
+type PullRequestBranch struct {
+	Name string `json:"name"`
+}
+
+type PullRequestCommit struct {
+	Hash string `json:"hash"`
+}
+
+// Participant is a user that participated in a pull request, either as a
+// reviewer or by commenting on it.
+type Participant struct {
+	User           Account          `json:"user"`
+	Role           ParticipantRole  `json:"role"`
+	Approved       bool             `json:"approved"`
+	State          ParticipantState `json:"state"`
+	ParticipatedOn time.Time        `json:"participated_on"`
+}
+
+// Key is a unique key identifying this participant in the context of a pull
+// request.
+func (p *Participant) Key() string {
+	return p.User.UUID
+}
+
+// PullRequestStatus is a build status attached to the head commit of a pull
+// request.
+type PullRequestStatus struct {
+	Links       Links                  `json:"links"`
+	UUID        string                 `json:"uuid"`
+	Key         string                 `json:"key"`
+	RefName     string                 `json:"refname"`
+	URL         string                 `json:"url"`
+	State       PullRequestStatusState `json:"state"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	CreatedOn   time.Time              `json:"created_on"`
+	UpdatedOn   time.Time              `json:"updated_on"`
+}
+
+// PullRequestInput is the input used to create or update a pull request.
+type PullRequestInput struct {
+	Title        string
+	Description  string
+	SourceBranch string
+	// DestinationBranch may be left empty when updating a pull request to leave
+	// the destination branch unchanged, and when creating a pull request to
+	// target the main branch of the repository.
+	DestinationBranch string
+	Draft             *bool
+}
+
+func (input *PullRequestInput) MarshalJSON() ([]byte, error) {
+	type branch struct {
+		Name string `json:"name"`
+	}
+	type endpoint struct {
+		Branch branch `json:"branch"`
+	}
+	type request struct {
+		Title       string    `json:"title"`
+		Description string    `json:"description"`
+		Draft       *bool     `json:"draft,omitempty"`
+		Source      *endpoint `json:"source,omitempty"`
+		Destination *endpoint `json:"destination,omitempty"`
+	}
+
+	req := request{
+		Title:       input.Title,
+		Description: input.Description,
+		Draft:       input.Draft,
+	}
+	if input.SourceBranch != "" {
+		req.Source = &endpoint{Branch: branch{Name: input.SourceBranch}}
+	}
+	if input.DestinationBranch != "" {
+		req.Destination = &endpoint{Branch: branch{Name: input.DestinationBranch}}
+	}
+
+	return json.Marshal(&req)
+}
+
+// ErrNotMergeable is returned by MergePullRequest when the pull request cannot
+// be merged, because a precondition such as a merge check is not met.
+var ErrNotMergeable = errors.New("pull request cannot be merged")
+
+// CurrentUser returns the user authenticated by the Client's credentials.
+func (c *Client) CurrentUser(ctx context.Context) (*Account, error) {
+	var user Account
+	if err := c.send(ctx, "GET", "/2.0/user", nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// CreatePullRequest opens a new pull request in the given repository.
+//
+// Bitbucket Cloud does not fail if an open pull request already exists between
+// the same branches: instead, it updates the existing pull request with the
+// given input and returns it.
+func (c *Client) CreatePullRequest(ctx context.Context, repo *Repo, input *PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "POST", pullRequestsPath(repo), input, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// GetPullRequest retrieves a single pull request.
+func (c *Client) GetPullRequest(ctx context.Context, repo *Repo, id int64) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "GET", pullRequestPath(repo, id), nil, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// UpdatePullRequest updates the title, description, destination branch, and
+// draft state of a pull request.
+func (c *Client) UpdatePullRequest(ctx context.Context, repo *Repo, id int64, input *PullRequestInput) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "PUT", pullRequestPath(repo, id), input, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// DeclinePullRequest declines (closes without merging) a pull request.
+//
+// Declined pull requests cannot be reopened on Bitbucket Cloud.
+func (c *Client) DeclinePullRequest(ctx context.Context, repo *Repo, id int64) (*PullRequest, error) {
+	var pr PullRequest
+	if err := c.send(ctx, "POST", pullRequestPath(repo, id)+"/decline", nil, &pr); err != nil {
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// MergePullRequest merges a pull request using the given strategy. If the
+// strategy is empty, the default strategy of the repository is used.
+func (c *Client) MergePullRequest(ctx context.Context, repo *Repo, id int64, strategy MergeStrategy) (*PullRequest, error) {
+	var payload interface{}
+	if strategy != "" {
+		payload = map[string]interface{}{"merge_strategy": strategy}
+	}
+
+	var pr PullRequest
+	if err := c.send(ctx, "POST", pullRequestPath(repo, id)+"/merge", payload, &pr); err != nil {
+		var e *httpError
+		if errors.As(err, &e) && e.StatusCode == http.StatusBadRequest {
+			return nil, errors.Wrap(ErrNotMergeable, err.Error())
+		}
+		return nil, err
+	}
+	return &pr, nil
+}
+
+// CreatePullRequestComment posts a comment on a pull request.
+func (c *Client) CreatePullRequestComment(ctx context.Context, repo *Repo, id int64, body string) error {
+	payload := map[string]interface{}{
+		"content": map[string]string{"raw": body},
+	}
+
+	err := c.send(ctx, "POST", pullRequestPath(repo, id)+"/comments", payload, nil)
+	return err
+}
+
+// LoadPullRequestStatuses loads all build statuses attached to the head commit
+// of the pull request into its Statuses field.
+func (c *Client) LoadPullRequestStatuses(ctx context.Context, repo *Repo, pr *PullRequest) (err error) {
+	path := pullRequestPath(repo, pr.ID) + "/statuses"
+
+	var statuses []*PullRequestStatus
+	for t := (&PageToken{Pagelen: 100}); ; {
+		var page []*PullRequestStatus
+		if t.HasMore() {
+			t, err = c.reqPage(ctx, t.Next, &page)
+		} else {
+			t, err = c.page(ctx, path, nil, t, &page)
+		}
+		if err != nil {
+			return err
+		}
+
+		statuses = append(statuses, page...)
+		if !t.HasMore() {
+			break
+		}
+	}
+
+	pr.Statuses = statuses
+	return nil
+}
+
+func (c *Client) send(ctx context.Context, method, path string, payload, result interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		bs, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(bs)
+	}
+
+	u := url.URL{Path: path}
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, req, result)
+}
+
+func pullRequestsPath(repo *Repo) string {
+	return fmt.Sprintf("/2.0/repositories/%s/pullrequests", repo.FullName)
+}
+
+func pullRequestPath(repo *Repo, id int64) string {
+	return fmt.Sprintf("%s/%d", pullRequestsPath(repo), id)
+}
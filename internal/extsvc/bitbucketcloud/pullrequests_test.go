@@ -0,0 +1,172 @@
+package bitbucketcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newPullRequestTestClient(t *testing.T, h http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewClient(u, srv.Client())
+}
+
+var testRepo = &Repo{FullName: "sglocal/mux", UUID: "{e1e75436-05e6-4c38-8543-9c36ec26fad1}"}
+
+func TestPullRequestInput_MarshalJSON(t *testing.T) {
+	draft := true
+	for name, tc := range map[string]struct {
+		input *PullRequestInput
+		want  string
+	}{
+		"all fields": {
+			input: &PullRequestInput{
+				Title:             "title",
+				Description:       "description",
+				SourceBranch:      "feature",
+				DestinationBranch: "main",
+				Draft:             &draft,
+			},
+			want: `{"title":"title","description":"description","draft":true,"source":{"branch":{"name":"feature"}},"destination":{"branch":{"name":"main"}}}`,
+		},
+		"no branches": {
+			input: &PullRequestInput{Title: "title"},
+			want:  `{"title":"title","description":""}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := json.Marshal(tc.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, string(have)); diff != "" {
+				t.Errorf("mismatch (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClient_CreatePullRequest(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/2.0/repositories/sglocal/mux/pullrequests" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if have, want := body["title"], "title"; have != want {
+			t.Errorf("unexpected title: have %v want %v", have, want)
+		}
+
+		fmt.Fprint(w, `{"id":42,"title":"title","state":"OPEN","source":{"branch":{"name":"feature"}}}`)
+	})
+
+	pr, err := cli.CreatePullRequest(context.Background(), testRepo, &PullRequestInput{
+		Title:        "title",
+		SourceBranch: "feature",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &PullRequest{
+		ID:     42,
+		Title:  "title",
+		State:  PullRequestStateOpen,
+		Source: PullRequestEndpoint{Branch: PullRequestBranch{Name: "feature"}},
+	}
+	if diff := cmp.Diff(want, pr); diff != "" {
+		t.Errorf("mismatch (-want +have):\n%s", diff)
+	}
+}
+
+func TestClient_GetPullRequest_NotFound(t *testing.T) {
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"type":"error","error":{"message":"not found"}}`)
+	})
+
+	_, err := cli.GetPullRequest(context.Background(), testRepo, 1)
+	var e *httpError
+	if !errors.As(err, &e) || !e.NotFound() {
+		t.Fatalf("expected not found error, have %v", err)
+	}
+}
+
+func TestClient_MergePullRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/2.0/repositories/sglocal/mux/pullrequests/42/merge" {
+				t.Errorf("unexpected path: %s", r.URL.Path)
+			}
+			body, _ := io.ReadAll(r.Body)
+			if have, want := string(body), `{"merge_strategy":"squash"}`; have != want {
+				t.Errorf("unexpected body: have %s want %s", have, want)
+			}
+			fmt.Fprint(w, `{"id":42,"state":"MERGED"}`)
+		})
+
+		pr, err := cli.MergePullRequest(context.Background(), testRepo, 42, MergeStrategySquash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pr.State != PullRequestStateMerged {
+			t.Errorf("unexpected state: %s", pr.State)
+		}
+	})
+
+	t.Run("not mergeable", func(t *testing.T) {
+		cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"type":"error","error":{"message":"merge checks failed"}}`)
+		})
+
+		_, err := cli.MergePullRequest(context.Background(), testRepo, 42, "")
+		if !errors.Is(err, ErrNotMergeable) {
+			t.Fatalf("expected ErrNotMergeable, have %v", err)
+		}
+	})
+}
+
+func TestClient_LoadPullRequestStatuses(t *testing.T) {
+	var srvURL string
+	cli := newPullRequestTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprint(w, `{"values":[{"key":"b","state":"FAILED"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"values":[{"key":"a","state":"SUCCESSFUL"}],"next":%q}`, srvURL+r.URL.Path+"?page=2")
+	})
+	srvURL = cli.URL.String()
+
+	pr := &PullRequest{ID: 42}
+	if err := cli.LoadPullRequestStatuses(context.Background(), testRepo, pr); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*PullRequestStatus{
+		{Key: "a", State: PullRequestStatusStateSuccessful},
+		{Key: "b", State: PullRequestStatusStateFailed},
+	}
+	if diff := cmp.Diff(want, pr.Statuses); diff != "" {
+		t.Errorf("mismatch (-want +have):\n%s", diff)
+	}
+}
@@ -505,6 +505,11 @@ type UpdatePullRequestInput struct {
 	Title       string `json:"title"`
 	Description string `json:"description"`
 	ToRef       Ref    `json:"toRef"`
+
+	// Reviewers are the usernames of the reviewers of the pull request. If
+	// empty, the reviewers are left unchanged. Otherwise, they replace the
+	// existing reviewers.
+	Reviewers []string `json:"-"`
 }
 
 func (c *Client) UpdatePullRequest(ctx context.Context, in *UpdatePullRequestInput) (*PullRequest, error) {
@@ -515,11 +520,40 @@ func (c *Client) UpdatePullRequest(ctx context.Context, in *UpdatePullRequestInp
 		in.PullRequestID,
 	)
 
+	var payload interface{} = in
+	if len(in.Reviewers) > 0 {
+		payload = struct {
+			*UpdatePullRequestInput
+			Reviewers []reviewerPayload `json:"reviewers"`
+		}{
+			UpdatePullRequestInput: in,
+			Reviewers:              newReviewerPayloads(in.Reviewers),
+		}
+	}
+
 	pr := &PullRequest{}
-	_, err := c.send(ctx, "PUT", path, nil, in, pr)
+	_, err := c.send(ctx, "PUT", path, nil, payload, pr)
 	return pr, err
 }
 
+// reviewerPayload is a minimal version of Reviewer, to reduce the payload size
+// sent when creating or updating a pull request.
+type reviewerPayload struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+}
+
+func newReviewerPayloads(names []string) []reviewerPayload {
+	reviewers := make([]reviewerPayload, 0, len(names))
+	for _, name := range names {
+		var r reviewerPayload
+		r.User.Name = name
+		reviewers = append(reviewers, r)
+	}
+	return reviewers
+}
+
 // ErrAlreadyExists is returned by Client.CreatePullRequest when a Pull Request
 // for the given FromRef and ToRef already exists.
 type ErrAlreadyExists struct {
@@ -550,23 +584,16 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		}
 	}
 
-	// Minimal version of Reviewer, to reduce payload size sent.
-	type reviewer struct {
-		User struct {
-			Name string `json:"name"`
-		} `json:"user"`
-	}
-
 	type requestBody struct {
-		Title       string     `json:"title"`
-		Description string     `json:"description"`
-		State       string     `json:"state"`
-		Open        bool       `json:"open"`
-		Closed      bool       `json:"closed"`
-		FromRef     Ref        `json:"fromRef"`
-		ToRef       Ref        `json:"toRef"`
-		Locked      bool       `json:"locked"`
-		Reviewers   []reviewer `json:"reviewers"`
+		Title       string            `json:"title"`
+		Description string            `json:"description"`
+		State       string            `json:"state"`
+		Open        bool              `json:"open"`
+		Closed      bool              `json:"closed"`
+		FromRef     Ref               `json:"fromRef"`
+		ToRef       Ref               `json:"toRef"`
+		Locked      bool              `json:"locked"`
+		Reviewers   []reviewerPayload `json:"reviewers"`
 	}
 
 	defaultReviewers, err := c.FetchDefaultReviewers(ctx, pr)
@@ -577,12 +604,20 @@ func (c *Client) CreatePullRequest(ctx context.Context, pr *PullRequest) error {
 		// return errors.Wrap(err, "fetching default reviewers")
 	}
 
-	reviewers := make([]reviewer, 0, len(defaultReviewers))
+	// Reviewers set on the given pull request are requested in addition to
+	// the default reviewers.
+	names := defaultReviewers
+	seen := make(map[string]bool, len(defaultReviewers))
 	for _, r := range defaultReviewers {
-		reviewers = append(reviewers, reviewer{User: struct {
-			Name string `json:"name"`
-		}{Name: r}})
+		seen[r] = true
+	}
+	for _, r := range pr.Reviewers {
+		if r.User != nil && !seen[r.User.Name] {
+			seen[r.User.Name] = true
+			names = append(names, r.User.Name)
+		}
 	}
+	reviewers := newReviewerPayloads(names)
 
 	// Bitbucket Server doesn't support GFM taskitems. But since we might add
 	// those to a PR description for certain batch changes, we have to
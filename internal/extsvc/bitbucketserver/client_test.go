@@ -10,6 +10,8 @@ import (
 	"encoding/pem"
 	"flag"
 	"fmt"
+	"io"
+	"net/http"
 	"net/url"
 	"os"
 	"reflect"
@@ -23,6 +25,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
@@ -712,6 +715,59 @@ func TestClient_FetchDefaultReviewers(t *testing.T) {
 	}
 }
 
+func TestClient_UpdatePullRequest_Reviewers(t *testing.T) {
+	for name, tc := range map[string]struct {
+		reviewers []string
+		want      string
+	}{
+		"no reviewers": {
+			want: `{"version":1,"title":"title","description":"description","toRef":{"id":"refs/heads/main","repository":{"id":0,"slug":"repo","project":{"key":"PROJ"}}}}`,
+		},
+		"reviewers": {
+			reviewers: []string{"alice", "bob"},
+			want:      `{"version":1,"title":"title","description":"description","toRef":{"id":"refs/heads/main","repository":{"id":0,"slug":"repo","project":{"key":"PROJ"}}},"reviewers":[{"user":{"name":"alice"}},{"user":{"name":"bob"}}]}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var have string
+			doer := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				have = strings.TrimSpace(string(body))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"id":1}`)),
+				}, nil
+			})
+
+			cli, err := NewClient(&schema.BitbucketServerConnection{Url: "https://bitbucket.example.com", Token: "token"}, doer)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			in := &UpdatePullRequestInput{
+				PullRequestID: "1",
+				Version:       1,
+				Title:         "title",
+				Description:   "description",
+				Reviewers:     tc.reviewers,
+			}
+			in.ToRef.ID = "refs/heads/main"
+			in.ToRef.Repository.Slug = "repo"
+			in.ToRef.Repository.Project.Key = "PROJ"
+
+			if _, err := cli.UpdatePullRequest(context.Background(), in); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, have); diff != "" {
+				t.Errorf("unexpected payload (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestClient_DeclinePullRequest(t *testing.T) {
 	instanceURL := os.Getenv("BITBUCKET_SERVER_URL")
 	if instanceURL == "" {
@@ -0,0 +1,232 @@
+//nolint:bodyclose // Body is closed in Client.Do, but the response is still returned to provide access to the headers
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	defaultRateLimit      = rate.Limit(8) // 480/min or 28,800/hr
+	defaultRateLimitBurst = 500
+
+	// DefaultPerPage is the page size requested when listing repositories. Gitea
+	// caps page sizes at its MAX_RESPONSE_ITEMS setting, which defaults to 50.
+	DefaultPerPage = 50
+)
+
+// Client access a Gitea (or Forgejo) instance via the REST API.
+type Client struct {
+	// HTTP Client used to communicate with the API
+	httpClient httpcli.Doer
+
+	// Config is the code host connection config for this client
+	Config *schema.GiteaConnection
+
+	// URL is the base URL of Gitea.
+	URL *url.URL
+
+	// RateLimit is the self-imposed rate limiter (since Gitea does not have a concept
+	// of rate limiting in HTTP response headers).
+	RateLimit *rate.Limiter
+}
+
+// NewClient returns an authenticated Gitea API client with
+// the provided configuration. If a nil httpClient is provided, httpcli.ExternalDoer
+// will be used.
+func NewClient(config *schema.GiteaConnection, httpClient httpcli.Doer) (*Client, error) {
+	u, err := url.Parse(config.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+
+	// Normally our registry will return a default infinite limiter when nothing has been
+	// synced from config. However, we always want to ensure there is at least some form of rate
+	// limiting for Gitea.
+	defaultLimiter := rate.NewLimiter(defaultRateLimit, defaultRateLimitBurst)
+	l := ratelimit.DefaultRegistry.GetOrSet(u.String(), defaultLimiter)
+
+	return &Client{
+		httpClient: httpClient,
+		Config:     config,
+		URL:        u,
+		RateLimit:  l,
+	}, nil
+}
+
+// Page selects a single page of results in list method calls. Page numbers start
+// at 1.
+type Page struct {
+	Page    int
+	PerPage int
+}
+
+func (p *Page) encodeTo(qs url.Values) {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PerPage < 1 {
+		p.PerPage = DefaultPerPage
+	}
+	qs.Set("page", strconv.Itoa(p.Page))
+	qs.Set("limit", strconv.Itoa(p.PerPage))
+}
+
+// ListOrgRepos returns a page of the repositories owned by the given organization.
+// hasNextPage is true if there may be more repositories to fetch.
+func (c *Client) ListOrgRepos(ctx context.Context, org string, page Page) (repos []*Repository, hasNextPage bool, err error) {
+	return c.listRepos(ctx, "api/v1/orgs/"+url.PathEscape(org)+"/repos", page)
+}
+
+// ListUserRepos returns a page of the repositories owned by the given user.
+// hasNextPage is true if there may be more repositories to fetch.
+func (c *Client) ListUserRepos(ctx context.Context, user string, page Page) (repos []*Repository, hasNextPage bool, err error) {
+	return c.listRepos(ctx, "api/v1/users/"+url.PathEscape(user)+"/repos", page)
+}
+
+func (c *Client) listRepos(ctx context.Context, path string, page Page) ([]*Repository, bool, error) {
+	qs := make(url.Values)
+	page.encodeTo(qs)
+
+	u := url.URL{Path: path, RawQuery: qs.Encode()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var repos []*Repository
+	resp, err := c.do(ctx, req, &repos)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Gitea silently caps the page size at its MAX_RESPONSE_ITEMS setting, so we
+	// can't compare the number of results with the requested page size. Every
+	// page but the last one has the capped size, which together with the
+	// X-Total-Count header tells us whether we've seen everything. Without the
+	// header, we keep paging until we get an empty page.
+	if len(repos) == 0 {
+		return repos, false, nil
+	}
+	if total, err := strconv.Atoi(resp.Header.Get("X-Total-Count")); err == nil {
+		return repos, page.Page*len(repos) < total, nil
+	}
+	return repos, true, nil
+}
+
+// GetRepo returns the repository with the given owner and name.
+func (c *Client) GetRepo(ctx context.Context, owner, name string) (*Repository, error) {
+	u := url.URL{Path: "api/v1/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(name)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var repo Repository
+	if _, err := c.do(ctx, req, &repo); err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, result interface{}) (*http.Response, error) {
+	req.URL = c.URL.ResolveReference(req.URL)
+	req.Header.Set("Accept", "application/json")
+
+	if c.Config.Token != "" {
+		req.Header.Add("Authorization", "token "+c.Config.Token)
+	}
+
+	startWait := time.Now()
+	if err := c.RateLimit.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	if d := time.Since(startWait); d > 200*time.Millisecond {
+		log15.Warn("Gitea self-enforced API rate limit: request delayed longer than expected due to rate limit", "delay", d)
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		return nil, errors.WithStack(&httpError{
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       bs,
+		})
+	}
+
+	return resp, json.Unmarshal(bs, result)
+}
+
+// Repository is a Gitea repository as returned by the REST API.
+type Repository struct {
+	ID            int64     `json:"id"`
+	Owner         User      `json:"owner"`
+	Name          string    `json:"name"`
+	FullName      string    `json:"full_name"`
+	Description   string    `json:"description"`
+	Private       bool      `json:"private"`
+	Fork          bool      `json:"fork"`
+	Mirror        bool      `json:"mirror"`
+	Archived      bool      `json:"archived"`
+	Empty         bool      `json:"empty"`
+	HTMLURL       string    `json:"html_url"`
+	SSHURL        string    `json:"ssh_url"`
+	CloneURL      string    `json:"clone_url"`
+	DefaultBranch string    `json:"default_branch"`
+	Stars         int       `json:"stars_count"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// User is a Gitea user or organization.
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+type httpError struct {
+	StatusCode int
+	URL        *url.URL
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Gitea API HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
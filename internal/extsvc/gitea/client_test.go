@@ -0,0 +1,124 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+var update = flag.Bool("update", false, "update testdata")
+
+type listReposResult struct {
+	Repos       []*Repository
+	HasNextPage bool
+}
+
+func TestClient_ListOrgRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListOrgRepos", *update)
+	defer save()
+
+	repos, hasNextPage, err := cli.ListOrgRepos(context.Background(), "gitea", Page{Page: 1, PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/ListOrgRepos.json", *update, listReposResult{repos, hasNextPage})
+}
+
+func TestClient_ListUserRepos(t *testing.T) {
+	cli, save := NewTestClient(t, "ListUserRepos", *update)
+	defer save()
+
+	repos, hasNextPage, err := cli.ListUserRepos(context.Background(), "lunny", Page{Page: 1, PerPage: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/golden/ListUserRepos.json", *update, listReposResult{repos, hasNextPage})
+}
+
+func TestClient_ListOrgRepos_CappedPageSize(t *testing.T) {
+	all := []*Repository{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}, {ID: 5}}
+
+	for _, tc := range []struct {
+		name       string
+		totalCount bool
+	}{
+		{name: "with X-Total-Count", totalCount: true},
+		{name: "without X-Total-Count", totalCount: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// The server caps page sizes at 2, as if MAX_RESPONSE_ITEMS was
+			// configured below the requested page size.
+			const maxResponseItems = 2
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				start := (page - 1) * maxResponseItems
+				end := start + maxResponseItems
+				if start > len(all) {
+					start = len(all)
+				}
+				if end > len(all) {
+					end = len(all)
+				}
+				if tc.totalCount {
+					w.Header().Set("X-Total-Count", strconv.Itoa(len(all)))
+				}
+				_ = json.NewEncoder(w).Encode(all[start:end])
+			}))
+			defer srv.Close()
+
+			cli, err := NewClient(&schema.GiteaConnection{Url: srv.URL}, http.DefaultClient)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []int64
+			page := Page{Page: 1, PerPage: DefaultPerPage}
+			for requests := 1; ; requests++ {
+				if requests > 10 {
+					t.Fatal("too many requests")
+				}
+
+				repos, hasNextPage, err := cli.ListOrgRepos(context.Background(), "gitea", page)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, r := range repos {
+					ids = append(ids, r.ID)
+				}
+				if !hasNextPage {
+					break
+				}
+				page.Page++
+			}
+
+			if len(ids) != len(all) {
+				t.Fatalf("wrong number of repos: have %v; want %d", ids, len(all))
+			}
+			for i, r := range all {
+				if ids[i] != r.ID {
+					t.Fatalf("wrong repos: have %v", ids)
+				}
+			}
+		})
+	}
+}
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if !testing.Verbose() {
+		log15.Root().SetHandler(log15.LvlFilterHandler(log15.LvlError, log15.Root().GetHandler()))
+	}
+	os.Exit(m.Run())
+}
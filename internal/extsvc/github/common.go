@@ -156,25 +156,27 @@ type Label struct {
 
 // PullRequest is a GitHub pull request.
 type PullRequest struct {
-	RepoWithOwner string `json:"-"`
-	ID            string
-	Title         string
-	Body          string
-	State         string
-	URL           string
-	HeadRefOid    string
-	BaseRefOid    string
-	HeadRefName   string
-	BaseRefName   string
-	Number        int64
-	Author        Actor
-	Participants  []Actor
-	Labels        struct{ Nodes []Label }
-	TimelineItems []TimelineItem
-	Commits       struct{ Nodes []CommitWithChecks }
-	IsDraft       bool
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	RepoWithOwner    string `json:"-"`
+	ID               string
+	Title            string
+	Body             string
+	State            string
+	URL              string
+	HeadRefOid       string
+	BaseRefOid       string
+	HeadRefName      string
+	BaseRefName      string
+	Number           int64
+	Author           Actor
+	Participants     []Actor
+	Labels           struct{ Nodes []Label }
+	TimelineItems    []TimelineItem
+	Commits          struct{ Nodes []CommitWithChecks }
+	IsDraft          bool
+	Mergeable        string
+	MergeStateStatus string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 // AssignedEvent represents an 'assigned' event on a PullRequest.
@@ -608,6 +610,12 @@ type UpdatePullRequestInput struct {
 	Title string `json:"title"`
 	// The body of the pull request (optional).
 	Body string `json:"body"`
+	// The Node IDs of the labels to set on the pull request (optional).
+	LabelIDs []string `json:"labelIds,omitempty"`
+	// The Node IDs of the users to assign to the pull request (optional).
+	AssigneeIDs []string `json:"assigneeIds,omitempty"`
+	// The Node ID of the milestone to add the pull request to (optional).
+	MilestoneID string `json:"milestoneId,omitempty"`
 }
 
 // UpdatePullRequest creates a PullRequest on Github.
@@ -1254,8 +1262,12 @@ fragment timelineItems on PullRequestTimelineItems {
     requestedTeam: requestedReviewer {
       ... on Team {
         name
+        slug
         url
         avatarUrl
+        organization {
+          login
+        }
       }
     }
     createdAt
@@ -1270,8 +1282,12 @@ fragment timelineItems on PullRequestTimelineItems {
     requestedTeam: requestedReviewer {
       ... on Team {
         name
+        slug
         url
         avatarUrl
+        organization {
+          login
+        }
       }
     }
     createdAt
@@ -1388,6 +1404,8 @@ fragment pr on PullRequest {
   baseRefOid
   headRefName
   baseRefName
+  mergeable
+  mergeStateStatus
   %s
   author {
     ...actor
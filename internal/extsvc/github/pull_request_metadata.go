@@ -0,0 +1,233 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// PullRequestMetadata describes the labels, reviewers, assignees and milestone
+// that should be set on a pull request, by name.
+type PullRequestMetadata struct {
+	// Labels are the names of existing labels in the repository.
+	Labels []string
+	// Reviewers are user logins or, for teams, "org/team-slug".
+	Reviewers []string
+	// Assignees are user logins.
+	Assignees []string
+	// Milestone is the title of an open milestone in the repository.
+	Milestone string
+}
+
+// IsEmpty returns true if no metadata is set.
+func (md *PullRequestMetadata) IsEmpty() bool {
+	return len(md.Labels) == 0 && len(md.Reviewers) == 0 && len(md.Assignees) == 0 && md.Milestone == ""
+}
+
+// PullRequestMetadataIDs holds the node IDs that PullRequestMetadata resolved
+// to, as expected by the pull request mutations.
+type PullRequestMetadataIDs struct {
+	LabelIDs        []string
+	AssigneeIDs     []string
+	ReviewerUserIDs []string
+	ReviewerTeamIDs []string
+	MilestoneID     string
+}
+
+// ResolvePullRequestMetadata looks up the node IDs of the labels, users, teams
+// and milestone in md in a single GraphQL request. An error is returned if any
+// of them can't be found.
+func (c *V4Client) ResolvePullRequestMetadata(ctx context.Context, owner, name string, md *PullRequestMetadata) (*PullRequestMetadataIDs, error) {
+	ids := &PullRequestMetadataIDs{}
+	if md.IsEmpty() {
+		return ids, nil
+	}
+
+	q, vars, err := buildResolvePullRequestMetadataQuery(owner, name, md)
+	if err != nil {
+		return nil, err
+	}
+
+	type node struct {
+		ID string `json:"id"`
+	}
+
+	var result map[string]json.RawMessage
+	if err := c.requestGraphQL(ctx, q, vars, &result); err != nil {
+		return nil, err
+	}
+
+	var repo map[string]json.RawMessage
+	if err := json.Unmarshal(result["repository"], &repo); err != nil {
+		return nil, errors.Wrap(err, "decoding repository")
+	}
+	if repo == nil {
+		return nil, errors.Errorf("repository %s/%s not found", owner, name)
+	}
+
+	for i, label := range md.Labels {
+		var n *node
+		if err := json.Unmarshal(repo[fmt.Sprintf("label%d", i)], &n); err != nil {
+			return nil, errors.Wrapf(err, "decoding label %q", label)
+		}
+		if n == nil {
+			return nil, errors.Errorf("label %q not found in repository %s/%s", label, owner, name)
+		}
+		ids.LabelIDs = append(ids.LabelIDs, n.ID)
+	}
+
+	if md.Milestone != "" {
+		var milestones struct {
+			Nodes []struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"nodes"`
+		}
+		if err := json.Unmarshal(repo["milestones"], &milestones); err != nil {
+			return nil, errors.Wrap(err, "decoding milestones")
+		}
+		for _, m := range milestones.Nodes {
+			if m.Title == md.Milestone {
+				ids.MilestoneID = m.ID
+				break
+			}
+		}
+		if ids.MilestoneID == "" {
+			return nil, errors.Errorf("open milestone %q not found in repository %s/%s", md.Milestone, owner, name)
+		}
+	}
+
+	user := func(alias, login string) (string, error) {
+		var n *node
+		if err := json.Unmarshal(result[alias], &n); err != nil {
+			return "", errors.Wrapf(err, "decoding user %q", login)
+		}
+		if n == nil {
+			return "", errors.Errorf("user %q not found", login)
+		}
+		return n.ID, nil
+	}
+
+	for i, login := range md.Assignees {
+		id, err := user(fmt.Sprintf("assignee%d", i), login)
+		if err != nil {
+			return nil, err
+		}
+		ids.AssigneeIDs = append(ids.AssigneeIDs, id)
+	}
+
+	for i, reviewer := range md.Reviewers {
+		alias := fmt.Sprintf("reviewer%d", i)
+		if !strings.Contains(reviewer, "/") {
+			id, err := user(alias, reviewer)
+			if err != nil {
+				return nil, err
+			}
+			ids.ReviewerUserIDs = append(ids.ReviewerUserIDs, id)
+			continue
+		}
+
+		var org *struct {
+			Team *node `json:"team"`
+		}
+		if err := json.Unmarshal(result[alias], &org); err != nil {
+			return nil, errors.Wrapf(err, "decoding team %q", reviewer)
+		}
+		if org == nil || org.Team == nil {
+			return nil, errors.Errorf("team %q not found", reviewer)
+		}
+		ids.ReviewerTeamIDs = append(ids.ReviewerTeamIDs, org.Team.ID)
+	}
+
+	return ids, nil
+}
+
+// buildResolvePullRequestMetadataQuery builds the query used by
+// ResolvePullRequestMetadata, using one aliased field per label, user and
+// team.
+func buildResolvePullRequestMetadataQuery(owner, name string, md *PullRequestMetadata) (string, map[string]interface{}, error) {
+	vars := map[string]interface{}{"owner": owner, "name": name}
+	params := []string{"$owner: String!", "$name: String!"}
+	addVar := func(name, value string) {
+		vars[name] = value
+		params = append(params, fmt.Sprintf("$%s: String!", name))
+	}
+
+	var repoFields, fields strings.Builder
+	for i, label := range md.Labels {
+		v := fmt.Sprintf("label%d", i)
+		addVar(v, label)
+		fmt.Fprintf(&repoFields, "    %s: label(name: $%s) { id }\n", v, v)
+	}
+	if md.Milestone != "" {
+		repoFields.WriteString("    milestones(first: 100, states: OPEN) { nodes { id title } }\n")
+	}
+	for i, login := range md.Assignees {
+		v := fmt.Sprintf("assignee%d", i)
+		addVar(v, login)
+		fmt.Fprintf(&fields, "  %s: user(login: $%s) { id }\n", v, v)
+	}
+	for i, reviewer := range md.Reviewers {
+		v := fmt.Sprintf("reviewer%d", i)
+		parts := strings.Split(reviewer, "/")
+		if len(parts) == 1 {
+			addVar(v, reviewer)
+			fmt.Fprintf(&fields, "  %s: user(login: $%s) { id }\n", v, v)
+			continue
+		}
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return "", nil, errors.Errorf("invalid team %q: must be of the form org/team", reviewer)
+		}
+		addVar(v+"Org", parts[0])
+		addVar(v+"Team", parts[1])
+		fmt.Fprintf(&fields, "  %s: organization(login: $%sOrg) { team(slug: $%sTeam) { id } }\n", v, v, v)
+	}
+
+	var q strings.Builder
+	fmt.Fprintf(&q, "query ResolvePullRequestMetadata(%s) {\n", strings.Join(params, ", "))
+	q.WriteString("  repository(owner: $owner, name: $name) {\n    id\n")
+	q.WriteString(repoFields.String())
+	q.WriteString("  }\n")
+	q.WriteString(fields.String())
+	q.WriteString("}")
+
+	return q.String(), vars, nil
+}
+
+const requestReviewsMutation = `
+mutation RequestReviews($input: RequestReviewsInput!) {
+  requestReviews(input: $input) {
+    clientMutationId
+  }
+}
+`
+
+// RequestReviews requests reviews on the pull request from the given users and
+// teams, in addition to the already requested reviewers.
+func (c *V4Client) RequestReviews(ctx context.Context, pr *PullRequest, userIDs, teamIDs []string) error {
+	if len(userIDs) == 0 && len(teamIDs) == 0 {
+		return nil
+	}
+
+	input := map[string]interface{}{"input": struct {
+		PullRequestID string   `json:"pullRequestId"`
+		UserIDs       []string `json:"userIds,omitempty"`
+		TeamIDs       []string `json:"teamIds,omitempty"`
+		Union         bool     `json:"union"`
+	}{
+		PullRequestID: pr.ID,
+		UserIDs:       userIDs,
+		TeamIDs:       teamIDs,
+		Union:         true,
+	}}
+
+	var result struct {
+		RequestReviews struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"requestReviews"`
+	}
+	return c.requestGraphQL(ctx, requestReviewsMutation, input, &result)
+}
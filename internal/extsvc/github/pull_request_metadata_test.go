@@ -0,0 +1,121 @@
+package github
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildResolvePullRequestMetadataQuery(t *testing.T) {
+	md := &PullRequestMetadata{
+		Labels:    []string{"bug"},
+		Reviewers: []string{"alice", "sourcegraph/batchers"},
+		Assignees: []string{"bob"},
+		Milestone: "v1.0",
+	}
+
+	q, vars, err := buildResolvePullRequestMetadataQuery("sourcegraph", "sourcegraph", md)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"query ResolvePullRequestMetadata($owner: String!, $name: String!, $label0: String!, $assignee0: String!, $reviewer0: String!, $reviewer1Org: String!, $reviewer1Team: String!)",
+		"label0: label(name: $label0) { id }",
+		"milestones(first: 100, states: OPEN) { nodes { id title } }",
+		"assignee0: user(login: $assignee0) { id }",
+		"reviewer0: user(login: $reviewer0) { id }",
+		"reviewer1: organization(login: $reviewer1Org) { team(slug: $reviewer1Team) { id } }",
+	} {
+		if !strings.Contains(q, want) {
+			t.Errorf("query does not contain %q:\n%s", want, q)
+		}
+	}
+
+	wantVars := map[string]interface{}{
+		"owner":         "sourcegraph",
+		"name":          "sourcegraph",
+		"label0":        "bug",
+		"assignee0":     "bob",
+		"reviewer0":     "alice",
+		"reviewer1Org":  "sourcegraph",
+		"reviewer1Team": "batchers",
+	}
+	if diff := cmp.Diff(wantVars, vars); diff != "" {
+		t.Errorf("unexpected variables (-want +have):\n%s", diff)
+	}
+
+	if _, _, err := buildResolvePullRequestMetadataQuery("o", "n", &PullRequestMetadata{Reviewers: []string{"org/"}}); err == nil {
+		t.Error("unexpected nil error for invalid team")
+	}
+}
+
+func TestV4Client_ResolvePullRequestMetadata(t *testing.T) {
+	md := &PullRequestMetadata{
+		Labels:    []string{"bug"},
+		Reviewers: []string{"alice", "sourcegraph/batchers"},
+		Assignees: []string{"bob"},
+		Milestone: "v1.0",
+	}
+	apiURL := &url.URL{Scheme: "https", Host: "example.com", Path: "/"}
+
+	t.Run("found", func(t *testing.T) {
+		mock := mockHTTPResponseBody{responseBody: `{"data": {
+			"repository": {
+				"id": "repo",
+				"label0": {"id": "label-bug"},
+				"milestones": {"nodes": [{"id": "ms-0.9", "title": "v0.9"}, {"id": "ms-1.0", "title": "v1.0"}]}
+			},
+			"assignee0": {"id": "user-bob"},
+			"reviewer0": {"id": "user-alice"},
+			"reviewer1": {"team": {"id": "team-batchers"}}
+		}}`}
+		c := NewV4Client(apiURL, nil, &mock)
+
+		have, err := c.ResolvePullRequestMetadata(context.Background(), "sourcegraph", "sourcegraph", md)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := &PullRequestMetadataIDs{
+			LabelIDs:        []string{"label-bug"},
+			AssigneeIDs:     []string{"user-bob"},
+			ReviewerUserIDs: []string{"user-alice"},
+			ReviewerTeamIDs: []string{"team-batchers"},
+			MilestoneID:     "ms-1.0",
+		}
+		if diff := cmp.Diff(want, have); diff != "" {
+			t.Errorf("unexpected IDs (-want +have):\n%s", diff)
+		}
+	})
+
+	t.Run("label not found", func(t *testing.T) {
+		mock := mockHTTPResponseBody{responseBody: `{"data": {
+			"repository": {"id": "repo", "label0": null, "milestones": {"nodes": []}},
+			"assignee0": {"id": "user-bob"},
+			"reviewer0": {"id": "user-alice"},
+			"reviewer1": {"team": {"id": "team-batchers"}}
+		}}`}
+		c := NewV4Client(apiURL, nil, &mock)
+
+		_, err := c.ResolvePullRequestMetadata(context.Background(), "sourcegraph", "sourcegraph", md)
+		if err == nil || !strings.Contains(err.Error(), `label "bug" not found`) {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		mock := mockHTTPResponseBody{}
+		c := NewV4Client(apiURL, nil, &mock)
+
+		if _, err := c.ResolvePullRequestMetadata(context.Background(), "sourcegraph", "sourcegraph", &PullRequestMetadata{}); err != nil {
+			t.Fatal(err)
+		}
+		if mock.count != 0 {
+			t.Errorf("unexpected number of requests: %d", mock.count)
+		}
+	})
+}
@@ -133,6 +133,9 @@ func (c *V4Client) requestGraphQL(ctx context.Context, query string, vars map[st
 	// Enable Checks API
 	// https://developer.github.com/v4/previews/#checks
 	req.Header.Add("Accept", "application/vnd.github.antiope-preview+json")
+	// Enable mergeStateStatus on pull requests
+	// https://docs.github.com/en/graphql/overview/schema-previews#merge-info-preview
+	req.Header.Add("Accept", "application/vnd.github.merge-info-preview+json")
 	var respBody struct {
 		Data   json.RawMessage `json:"data"`
 		Errors graphqlErrors   `json:"errors"`
@@ -45,6 +45,11 @@ type MergeRequest struct {
 
 	DiffRefs DiffRefs `json:"diff_refs"`
 
+	HasConflicts bool `json:"has_conflicts"`
+	// DivergedCommitsCount is the number of commits on the target branch that
+	// are not in the source branch. It is only set by GetMergeRequest.
+	DivergedCommitsCount int `json:"diverged_commits_count"`
+
 	// The fields below are computed from other REST API requests when getting a
 	// Merge Request. Once our minimum version is GitLab 12.0, we can use the
 	// GraphQL API to retrieve all of this data at once, but until then, we have
@@ -89,6 +94,11 @@ type CreateMergeRequestOpts struct {
 	TargetBranch string `json:"target_branch"`
 	Title        string `json:"title"`
 	Description  string `json:"description,omitempty"`
+	// Labels is a comma-separated list of label names.
+	Labels      string `json:"labels,omitempty"`
+	AssigneeIDs []ID   `json:"assignee_ids,omitempty"`
+	ReviewerIDs []ID   `json:"reviewer_ids,omitempty"`
+	MilestoneID ID     `json:"milestone_id,omitempty"`
 	// TODO: other fields at
 	// https://docs.gitlab.com/ee/api/merge_requests.html#create-mr as needed.
 }
@@ -129,7 +139,7 @@ func (c *Client) GetMergeRequest(ctx context.Context, project *Project, iid ID)
 
 	time.Sleep(c.rateLimitMonitor.RecommendedWaitForBackgroundOp(1))
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/merge_requests/%d", project.ID, iid), nil)
+	req, err := http.NewRequest("GET", fmt.Sprintf("projects/%d/merge_requests/%d?include_diverged_commits_count=true", project.ID, iid), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "creating request to get a merge request")
 	}
@@ -199,6 +209,12 @@ type UpdateMergeRequestOpts struct {
 	Title        string                       `json:"title"`
 	Description  string                       `json:"description,omitempty"`
 	StateEvent   UpdateMergeRequestStateEvent `json:"state_event,omitempty"`
+	// Labels is a comma-separated list of label names, replacing all existing
+	// labels.
+	Labels      string `json:"labels,omitempty"`
+	AssigneeIDs []ID   `json:"assignee_ids,omitempty"`
+	ReviewerIDs []ID   `json:"reviewer_ids,omitempty"`
+	MilestoneID ID     `json:"milestone_id,omitempty"`
 }
 
 type UpdateMergeRequestStateEvent string
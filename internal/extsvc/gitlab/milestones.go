@@ -0,0 +1,56 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+type Milestone struct {
+	ID    ID     `json:"id"`
+	IID   ID     `json:"iid"`
+	Title string `json:"title"`
+	State string `json:"state"`
+}
+
+// ErrMilestoneNotFound is returned by GetMilestoneByTitle if no active
+// milestone with the given title exists.
+var ErrMilestoneNotFound = errors.New("milestone not found")
+
+// GetMilestoneByTitle returns the active milestone with the given title that's
+// available to the project, including milestones of its parent groups.
+func (c *Client) GetMilestoneByTitle(ctx context.Context, project *Project, title string) (*Milestone, error) {
+	if MockGetMilestoneByTitle != nil {
+		return MockGetMilestoneByTitle(c, ctx, project, title)
+	}
+
+	values := url.Values{
+		"title":                     {title},
+		"state":                     {"active"},
+		"include_parent_milestones": {"true"},
+	}
+	u := &url.URL{Path: fmt.Sprintf("projects/%d/milestones", project.ID), RawQuery: values.Encode()}
+
+	time.Sleep(c.rateLimitMonitor.RecommendedWaitForBackgroundOp(1))
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating request to get milestone")
+	}
+
+	var milestones []*Milestone
+	if _, _, err := c.do(ctx, req, &milestones); err != nil {
+		return nil, errors.Wrap(err, "sending request to get milestone")
+	}
+
+	for _, m := range milestones {
+		if m.Title == title {
+			return m, nil
+		}
+	}
+	return nil, errors.Wrapf(ErrMilestoneNotFound, "title %q", title)
+}
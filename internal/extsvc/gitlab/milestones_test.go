@@ -0,0 +1,78 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+func TestGetMilestoneByTitle(t *testing.T) {
+	ctx := context.Background()
+	project := &Project{ProjectCommon: ProjectCommon{ID: 1}}
+
+	t.Run("found", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{
+			responseBody: `[{"id": 11, "iid": 1, "title": "v1.0-rc", "state": "active"}, {"id": 12, "iid": 2, "title": "v1.0", "state": "active"}]`,
+		}
+
+		m, err := client.GetMilestoneByTitle(ctx, project, "v1.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.ID != 12 {
+			t.Errorf("unexpected milestone: %+v", m)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{responseBody: `[]`}
+
+		_, err := client.GetMilestoneByTitle(ctx, project, "v1.0")
+		if !errors.Is(err, ErrMilestoneNotFound) {
+			t.Errorf("unexpected error: %+v", err)
+		}
+	})
+
+	t.Run("error status code", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPEmptyResponse{http.StatusNotFound}
+
+		_, err := client.GetMilestoneByTitle(ctx, project, "v1.0")
+		if err == nil || errors.Is(err, ErrMilestoneNotFound) {
+			t.Errorf("unexpected error: %+v", err)
+		}
+	})
+}
+
+func TestGetUserByUsername(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("found", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{
+			responseBody: `[{"id": 42, "username": "alice"}]`,
+		}
+
+		u, err := client.GetUserByUsername(ctx, "alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.ID != 42 {
+			t.Errorf("unexpected user: %+v", u)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestClient(t)
+		client.httpClient = &mockHTTPResponseBody{responseBody: `[]`}
+
+		_, err := client.GetUserByUsername(ctx, "alice")
+		if !errors.Is(err, ErrUserNotFound) {
+			t.Errorf("unexpected error: %+v", err)
+		}
+	})
+}
@@ -52,3 +52,11 @@ var MockMergeMergeRequest func(c *Client, ctx context.Context, project *Project,
 // MockCreateMergeRequestNote, if non-nil, will be called instead of
 // Client.CreateMergeRequestNote
 var MockCreateMergeRequestNote func(c *Client, ctx context.Context, project *Project, mr *MergeRequest, body string) error
+
+// MockGetUserByUsername, if non-nil, will be called instead of
+// Client.GetUserByUsername
+var MockGetUserByUsername func(c *Client, ctx context.Context, username string) (*User, error)
+
+// MockGetMilestoneByTitle, if non-nil, will be called instead of
+// Client.GetMilestoneByTitle
+var MockGetMilestoneByTitle func(c *Client, ctx context.Context, project *Project, title string) (*Milestone, error)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
+	"github.com/cockroachdb/errors"
 	"github.com/peterhellberg/link"
 )
 
@@ -68,3 +70,29 @@ func (c *Client) GetUser(ctx context.Context, id string) (*User, error) {
 	}
 	return &usr, nil
 }
+
+// ErrUserNotFound is returned by GetUserByUsername if no user with the given
+// username exists.
+var ErrUserNotFound = errors.New("user not found")
+
+// GetUserByUsername returns the user with the given username.
+func (c *Client) GetUserByUsername(ctx context.Context, username string) (*User, error) {
+	if MockGetUserByUsername != nil {
+		return MockGetUserByUsername(c, ctx, username)
+	}
+
+	u := &url.URL{Path: "users", RawQuery: url.Values{"username": {username}}.Encode()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*User
+	if _, _, err := c.do(ctx, req, &users); err != nil {
+		return nil, err
+	}
+	if len(users) == 0 {
+		return nil, errors.Wrapf(ErrUserNotFound, "username %q", username)
+	}
+	return users[0], nil
+}
@@ -0,0 +1,168 @@
+package gomodproxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/mod/module"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	defaultRateLimit      = rate.Limit(57600.0 / 3600.0)
+	defaultRateLimitBurst = 100
+
+	// maxZipSize is the maximum size of a module zip, as enforced by the go
+	// command itself.
+	maxZipSize = 500 << 20
+)
+
+// Client fetches module metadata and zips from one or more module proxies,
+// implementing the GOPROXY protocol documented at https://go.dev/ref/mod#goproxy-protocol.
+type Client struct {
+	httpClient httpcli.Doer
+	urls       []string
+	limiter    *rate.Limiter
+}
+
+// NewClient returns a client for the proxies of the given connection. If a
+// nil httpClient is provided, httpcli.ExternalDoer will be used.
+func NewClient(config *schema.GoModulesConnection, httpClient httpcli.Doer) *Client {
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+	urls := make([]string, 0, len(config.Urls))
+	for _, u := range config.Urls {
+		urls = append(urls, strings.TrimSuffix(u, "/"))
+	}
+	defaultLimiter := rate.NewLimiter(defaultRateLimit, defaultRateLimitBurst)
+	return &Client{
+		httpClient: httpClient,
+		urls:       urls,
+		limiter:    ratelimit.DefaultRegistry.GetOrSet("go", defaultLimiter),
+	}
+}
+
+// ModuleVersion is the metadata of a module version returned by the proxy.
+type ModuleVersion struct {
+	Version string
+	Time    time.Time
+}
+
+// GetVersion returns the metadata of the given module version. It returns an
+// error for which IsNotFound is true if none of the proxies know the version.
+func (c *Client) GetVersion(ctx context.Context, dep reposource.GoDependency) (*ModuleVersion, error) {
+	body, err := c.get(ctx, dep, "info")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var v ModuleVersion
+	if err := json.NewDecoder(body).Decode(&v); err != nil {
+		return nil, errors.Wrapf(err, "decoding version info of %s", dep.PackageManagerSyntax())
+	}
+	return &v, nil
+}
+
+// GetZip returns the zip archive of the given module version.
+func (c *Client) GetZip(ctx context.Context, dep reposource.GoDependency) ([]byte, error) {
+	body, err := c.get(ctx, dep, "zip")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	zip, err := io.ReadAll(io.LimitReader(body, maxZipSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(zip) > maxZipSize {
+		return nil, errors.Errorf("module zip of %s exceeds %d bytes", dep.PackageManagerSyntax(), maxZipSize)
+	}
+	return zip, nil
+}
+
+// get requests the file with the given extension of a module version from each
+// proxy in turn, falling back to the next proxy only if the previous one
+// responded with 404 or 410, like the go command does.
+func (c *Client) get(ctx context.Context, dep reposource.GoDependency, ext string) (io.ReadCloser, error) {
+	escapedPath, err := module.EscapePath(dep.Path)
+	if err != nil {
+		return nil, err
+	}
+	escapedVersion, err := module.EscapeVersion(dep.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.urls) == 0 {
+		return nil, errors.New("no Go module proxy URLs configured")
+	}
+
+	var lastErr error
+	for _, baseURL := range c.urls {
+		url := fmt.Sprintf("%s/%s/@v/%s.%s", baseURL, escapedPath, escapedVersion, ext)
+		body, err := c.getURL(ctx, url)
+		if IsNotFound(err) {
+			lastErr = err
+			continue
+		}
+		return body, err
+	}
+	return nil, lastErr
+}
+
+func (c *Client) getURL(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		bs, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.WithStack(&httpError{URL: url, StatusCode: resp.StatusCode, Body: bs})
+	}
+	return resp.Body, nil
+}
+
+type httpError struct {
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("Go module proxy HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound || e.StatusCode == http.StatusGone
+}
+
+// IsNotFound reports whether err is an HTTP 404 or 410 response of a proxy.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
@@ -0,0 +1,65 @@
+package gomodproxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient_FallbackOnNotFound(t *testing.T) {
+	gone := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	}))
+	t.Cleanup(gone.Close)
+
+	var requested []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/github.com/!burnt!sushi/toml/@v/v0.4.1.info":
+			fmt.Fprint(w, `{"Version":"v0.4.1","Time":"2021-08-05T08:22:35Z"}`)
+		case "/github.com/!burnt!sushi/toml/@v/v0.4.1.zip":
+			fmt.Fprint(w, "zip")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(proxy.Close)
+
+	cli := NewClient(&schema.GoModulesConnection{Urls: []string{gone.URL, proxy.URL}}, http.DefaultClient)
+
+	dep, err := reposource.ParseGoDependency("github.com/BurntSushi/toml@v0.4.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version, err := cli.GetVersion(context.Background(), dep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ModuleVersion{Version: "v0.4.1", Time: time.Date(2021, 8, 5, 8, 22, 35, 0, time.UTC)}
+	if diff := cmp.Diff(want, version); diff != "" {
+		t.Errorf("mismatch (-want +have):\n%s", diff)
+	}
+
+	zip, err := cli.GetZip(context.Background(), dep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(zip), "zip"; have != want {
+		t.Errorf("unexpected zip: have %q want %q", have, want)
+	}
+
+	dep.Version = "v0.0.1"
+	if _, err := cli.GetVersion(context.Background(), dep); !IsNotFound(err) {
+		t.Errorf("expected not found error, have %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package npm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	defaultRateLimit      = rate.Limit(3000.0 / 3600.0)
+	defaultRateLimitBurst = 100
+)
+
+// Client fetches package metadata and tarballs from an npm registry.
+type Client struct {
+	httpClient  httpcli.Doer
+	registryURL string
+	credentials string
+	limiter     *rate.Limiter
+}
+
+// NewClient returns a client for the registry of the given connection. If a
+// nil httpClient is provided, httpcli.ExternalDoer will be used.
+func NewClient(config *schema.NpmPackagesConnection, httpClient httpcli.Doer) *Client {
+	if httpClient == nil {
+		httpClient = httpcli.ExternalDoer
+	}
+	defaultLimiter := rate.NewLimiter(defaultRateLimit, defaultRateLimitBurst)
+	return &Client{
+		httpClient:  httpClient,
+		registryURL: strings.TrimSuffix(config.Registry, "/"),
+		credentials: config.Credentials,
+		limiter:     ratelimit.DefaultRegistry.GetOrSet(config.Registry, defaultLimiter),
+	}
+}
+
+// DependencyInfo is the subset of the registry's version metadata that we use.
+type DependencyInfo struct {
+	Description string             `json:"description"`
+	Dist        DependencyInfoDist `json:"dist"`
+}
+
+type DependencyInfoDist struct {
+	TarballURL string `json:"tarball"`
+}
+
+// GetDependencyInfo returns the registry metadata of a single version of a
+// package. It returns an error for which IsNotFound is true if the package or
+// version doesn't exist.
+func (c *Client) GetDependencyInfo(ctx context.Context, dep reposource.NpmDependency) (*DependencyInfo, error) {
+	url := fmt.Sprintf("%s/%s/%s", c.registryURL, dep.PackageSyntax(), dep.Version)
+	body, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var info DependencyInfo
+	if err := json.NewDecoder(body).Decode(&info); err != nil {
+		return nil, errors.Wrapf(err, "decoding metadata of %s", dep.PackageManagerSyntax())
+	}
+	if info.Dist.TarballURL == "" {
+		return nil, errors.Errorf("npm registry returned no tarball URL for %s", dep.PackageManagerSyntax())
+	}
+	return &info, nil
+}
+
+// FetchTarball returns the gzipped tarball of the given dependency. The caller
+// must close the returned reader.
+func (c *Client) FetchTarball(ctx context.Context, dep reposource.NpmDependency) (io.ReadCloser, error) {
+	info, err := c.GetDependencyInfo(ctx, dep)
+	if err != nil {
+		return nil, err
+	}
+	return c.get(ctx, info.Dist.TarballURL)
+}
+
+func (c *Client) get(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.credentials != "" {
+		req.Header.Set("Authorization", "Bearer "+c.credentials)
+	}
+
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		bs, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.WithStack(&httpError{URL: url, StatusCode: resp.StatusCode, Body: bs})
+	}
+	return resp.Body, nil
+}
+
+type httpError struct {
+	URL        string
+	StatusCode int
+	Body       []byte
+}
+
+func (e *httpError) Error() string {
+	return fmt.Sprintf("npm registry HTTP error: code=%d url=%q body=%q", e.StatusCode, e.URL, e.Body)
+}
+
+func (e *httpError) NotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsNotFound reports whether err is an HTTP 404 response of the registry.
+func IsNotFound(err error) bool {
+	var e *httpError
+	return errors.As(err, &e) && e.NotFound()
+}
@@ -0,0 +1,57 @@
+package npm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestClient_FetchTarball(t *testing.T) {
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if have, want := r.Header.Get("Authorization"), "Bearer secret"; have != want {
+			t.Errorf("unexpected Authorization header: have %q want %q", have, want)
+		}
+		switch r.URL.Path {
+		case "/@types/node/16.11.7":
+			fmt.Fprintf(w, `{"description":"TypeScript definitions for Node.js","dist":{"tarball":%q}}`, srvURL+"/node-16.11.7.tgz")
+		case "/node-16.11.7.tgz":
+			fmt.Fprint(w, "tarball")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":"Not found"}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	cli := NewClient(&schema.NpmPackagesConnection{Registry: srv.URL + "/", Credentials: "secret"}, srv.Client())
+
+	dep, err := reposource.ParseNpmDependency("@types/node@16.11.7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tarball, err := cli.FetchTarball(context.Background(), dep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tarball.Close()
+	bs, err := io.ReadAll(tarball)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if have, want := string(bs), "tarball"; have != want {
+		t.Errorf("unexpected tarball: have %q want %q", have, want)
+	}
+
+	dep.Version = "0.0.0"
+	if _, err := cli.GetDependencyInfo(context.Background(), dep); !IsNotFound(err) {
+		t.Errorf("expected not found error, have %v", err)
+	}
+}
@@ -83,7 +83,12 @@ const (
 	KindPerforce        = "PERFORCE"
 	KindPhabricator     = "PHABRICATOR"
 	KindJVMPackages     = "JVMPACKAGES"
+	KindNpmPackages     = "NPMPACKAGES"
+	KindGoModules       = "GOMODULES"
 	KindPagure          = "PAGURE"
+	KindGitea           = "GITEA"
+	KindMercurial       = "MERCURIAL"
+	KindSubversion      = "SUBVERSION"
 	KindOther           = "OTHER"
 )
 
@@ -123,9 +128,27 @@ const (
 	// TypeJVMPackages is the (api.ExternalRepoSpec).ServiceType value for Maven packages (Java/JVM ecosystem libraries).
 	TypeJVMPackages = "jvmPackages"
 
+	// TypeNpmPackages is the (api.ExternalRepoSpec).ServiceType value for npm packages (JavaScript/TypeScript ecosystem libraries).
+	TypeNpmPackages = "npmPackages"
+
+	// TypeGoModules is the (api.ExternalRepoSpec).ServiceType value for Go modules fetched from a module proxy.
+	TypeGoModules = "goModules"
+
 	// TypePagure is the (api.ExternalRepoSpec).ServiceType value for Pagure projects.
 	TypePagure = "pagure"
 
+	// TypeGitea is the (api.ExternalRepoSpec).ServiceType value for Gitea (and Forgejo) repositories. The
+	// ServiceID value is the base URL to the Gitea instance.
+	TypeGitea = "gitea"
+
+	// TypeMercurial is the (api.ExternalRepoSpec).ServiceType value for Mercurial repositories that
+	// gitserver mirrors into git. The ServiceID value is the base URL of the Mercurial server.
+	TypeMercurial = "mercurial"
+
+	// TypeSubversion is the (api.ExternalRepoSpec).ServiceType value for Subversion repositories that
+	// gitserver mirrors into git. The ServiceID value is the base URL of the Subversion server.
+	TypeSubversion = "subversion"
+
 	// TypeOther is the (api.ExternalRepoSpec).ServiceType value for other projects.
 	TypeOther = "other"
 
@@ -154,8 +177,18 @@ func KindToType(kind string) string {
 		return TypePerforce
 	case KindJVMPackages:
 		return TypeJVMPackages
+	case KindNpmPackages:
+		return TypeNpmPackages
+	case KindGoModules:
+		return TypeGoModules
 	case KindPagure:
 		return TypePagure
+	case KindGitea:
+		return TypeGitea
+	case KindMercurial:
+		return TypeMercurial
+	case KindSubversion:
+		return TypeSubversion
 	case KindOther:
 		return TypeOther
 	default:
@@ -185,8 +218,18 @@ func TypeToKind(t string) string {
 		return KindPhabricator
 	case TypeJVMPackages:
 		return KindJVMPackages
+	case TypeNpmPackages:
+		return KindNpmPackages
+	case TypeGoModules:
+		return KindGoModules
 	case TypePagure:
 		return KindPagure
+	case TypeGitea:
+		return KindGitea
+	case TypeMercurial:
+		return KindMercurial
+	case TypeSubversion:
+		return KindSubversion
 	case TypeOther:
 		return KindOther
 	default:
@@ -199,6 +242,8 @@ var (
 	bbsLower = strings.ToLower(TypeBitbucketServer)
 	bbcLower = strings.ToLower(TypeBitbucketCloud)
 	jvmLower = strings.ToLower(TypeJVMPackages)
+	npmLower = strings.ToLower(TypeNpmPackages)
+	goLower  = strings.ToLower(TypeGoModules)
 )
 
 // ParseServiceType will return a ServiceType constant after doing a case insensitive match on s.
@@ -223,8 +268,18 @@ func ParseServiceType(s string) (string, bool) {
 		return TypePhabricator, true
 	case jvmLower:
 		return TypeJVMPackages, true
+	case npmLower:
+		return TypeNpmPackages, true
+	case goLower:
+		return TypeGoModules, true
 	case TypePagure:
 		return TypePagure, true
+	case TypeGitea:
+		return TypeGitea, true
+	case TypeMercurial:
+		return TypeMercurial, true
+	case TypeSubversion:
+		return TypeSubversion, true
 	case TypeOther:
 		return TypeOther, true
 	default:
@@ -254,8 +309,18 @@ func ParseServiceKind(s string) (string, bool) {
 		return KindPhabricator, true
 	case KindJVMPackages:
 		return KindJVMPackages, true
+	case KindNpmPackages:
+		return KindNpmPackages, true
+	case KindGoModules:
+		return KindGoModules, true
 	case KindPagure:
 		return KindPagure, true
+	case KindGitea:
+		return KindGitea, true
+	case KindMercurial:
+		return KindMercurial, true
+	case KindSubversion:
+		return KindSubversion, true
 	case KindOther:
 		return KindOther, true
 	default:
@@ -297,8 +362,18 @@ func ParseConfig(kind, config string) (cfg interface{}, _ error) {
 		cfg = &schema.PhabricatorConnection{}
 	case KindJVMPackages:
 		cfg = &schema.JVMPackagesConnection{}
+	case KindNpmPackages:
+		cfg = &schema.NpmPackagesConnection{}
+	case KindGoModules:
+		cfg = &schema.GoModulesConnection{}
 	case KindPagure:
 		cfg = &schema.PagureConnection{}
+	case KindGitea:
+		cfg = &schema.GiteaConnection{}
+	case KindMercurial:
+		cfg = &schema.MercurialConnection{}
+	case KindSubversion:
+		cfg = &schema.SubversionConnection{}
 	case KindOther:
 		cfg = &schema.OtherExternalServiceConnection{}
 	default:
@@ -316,6 +391,8 @@ func WebhookURL(kind string, externalServiceID int64, externalURL string) string
 		path = "github-webhooks"
 	case KindBitbucketServer:
 		path = "bitbucket-server-webhooks"
+	case KindBitbucketCloud:
+		path = "bitbucket-cloud-webhooks"
 	case KindGitLab:
 		path = "gitlab-webhooks"
 	default:
@@ -342,6 +419,8 @@ func ExtractToken(config string, kind string) (string, error) {
 		return c.Token, nil
 	case *schema.PagureConnection:
 		return c.Token, nil
+	case *schema.GiteaConnection:
+		return c.Token, nil
 	default:
 		return "", errors.Errorf("unable to extract token for service kind %q", kind)
 	}
@@ -426,6 +505,20 @@ func GetLimitFromConfig(kind string, config interface{}) (rlc RateLimitConfig, e
 			rlc.IsDefault = false
 		}
 		rlc.BaseURL = "maven"
+	case *schema.NpmPackagesConnection:
+		rlc.Limit = rate.Limit(3000.0 / 3600.0)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = c.Registry
+	case *schema.GoModulesConnection:
+		rlc.Limit = rate.Limit(57600.0 / 3600.0)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = "go"
 	case *schema.PagureConnection:
 		// 8/s is the default limit we enforce
 		rlc.Limit = rate.Limit(8)
@@ -434,6 +527,14 @@ func GetLimitFromConfig(kind string, config interface{}) (rlc RateLimitConfig, e
 			rlc.IsDefault = false
 		}
 		rlc.BaseURL = c.Url
+	case *schema.GiteaConnection:
+		// 8/s is the default limit we enforce
+		rlc.Limit = rate.Limit(8)
+		if c != nil && c.RateLimit != nil {
+			rlc.Limit = limitOrInf(c.RateLimit.Enabled, c.RateLimit.RequestsPerHour)
+			rlc.IsDefault = false
+		}
+		rlc.BaseURL = c.Url
 	default:
 		return rlc, ErrRateLimitUnsupported{codehostKind: kind}
 	}
@@ -531,8 +632,18 @@ func UniqueCodeHostIdentifier(kind, config string) (string, error) {
 		return c.P4Port, nil
 	case *schema.JVMPackagesConnection:
 		return KindJVMPackages, nil
+	case *schema.NpmPackagesConnection:
+		return KindNpmPackages, nil
+	case *schema.GoModulesConnection:
+		return KindGoModules, nil
 	case *schema.PagureConnection:
 		rawURL = c.Url
+	case *schema.GiteaConnection:
+		rawURL = c.Url
+	case *schema.MercurialConnection:
+		rawURL = c.Url
+	case *schema.SubversionConnection:
+		rawURL = c.Url
 	default:
 		return "", errors.Errorf("unknown external service kind: %s", kind)
 	}
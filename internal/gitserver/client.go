@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -66,8 +67,9 @@ func NewClient(cli httpcli.Doer) *Client {
 		Addrs: func() []string {
 			return conf.Get().ServiceConnections().GitServers
 		},
-		HTTPClient:  cli,
-		HTTPLimiter: parallel.NewRun(500),
+		ReplicationFactor: conf.GitReplicationFactor,
+		HTTPClient:        cli,
+		HTTPLimiter:       parallel.NewRun(500),
 		// Use the binary name for UserAgent. This should effectively identify
 		// which service is making the request (excluding requests proxied via the
 		// frontend internal API)
@@ -88,6 +90,11 @@ type Client struct {
 	// concurrent use. It may return different results at different times.
 	Addrs func() []string
 
+	// ReplicationFactor is a function which should return the number of
+	// gitservers each repository is stored on. If it is nil, every repository
+	// is stored on a single gitserver.
+	ReplicationFactor func() int
+
 	// UserAgent is a string identifying who the client is. It will be logged in
 	// the telemetry in gitserver.
 	UserAgent string
@@ -102,6 +109,20 @@ func (c *Client) AddrForRepo(repo api.RepoName) string {
 	return AddrForRepo(repo, addrs)
 }
 
+// ReplicaAddrsForRepo returns the addresses of the gitservers that store the
+// given repo name, starting with the primary returned by AddrForRepo.
+func (c *Client) ReplicaAddrsForRepo(repo api.RepoName) []string {
+	addrs := c.Addrs()
+	if len(addrs) == 0 {
+		panic("unexpected state: no gitserver addresses")
+	}
+	n := 1
+	if c.ReplicationFactor != nil {
+		n = c.ReplicationFactor()
+	}
+	return ReplicaAddrsForRepo(repo, addrs, n)
+}
+
 // RendezvousAddrForRepo returns the gitserver address to use for the given repo name using the
 // Rendezvous hashing scheme.
 func (c *Client) RendezvousAddrForRepo(repo api.RepoName) string {
@@ -137,6 +158,30 @@ func AddrForRepo(repo api.RepoName, addrs []string) string {
 	return addrForKey(string(repo), addrs)
 }
 
+// ReplicaAddrsForRepo returns the addresses of the n gitservers that store the
+// given repo name. The first address is the primary returned by AddrForRepo,
+// the others are the replicas, which are the addresses following the primary
+// in addrs. Fewer than n addresses are returned if addrs has fewer than n
+// entries.
+//
+// It should never be called with an empty slice.
+func ReplicaAddrsForRepo(repo api.RepoName, addrs []string, n int) []string {
+	if n > len(addrs) {
+		n = len(addrs)
+	}
+	if n < 1 {
+		n = 1
+	}
+
+	repo = protocol.NormalizeRepo(repo)
+	primary := addrIndexForKey(string(repo), addrs)
+	replicas := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		replicas = append(replicas, addrs[(primary+i)%len(addrs)])
+	}
+	return replicas
+}
+
 // RendezvousAddrForRepo returns the gitserver address to use for the given repo name using the
 // Rendezvous hashing scheme.
 //
@@ -146,12 +191,34 @@ func RendezvousAddrForRepo(repo api.RepoName, addrs []string) string {
 	return r.Lookup(string(protocol.NormalizeRepo(repo)))
 }
 
+// HostnameMatch reports whether the gitserver address addr belongs to the
+// gitserver with the given hostname, which is the shard ID gitservers record
+// in the database. If we don't find an exact match, we look at the initial
+// prefix.
+func HostnameMatch(hostname, addr string) bool {
+	if !strings.HasPrefix(addr, hostname) {
+		return false
+	}
+	if addr == hostname {
+		return true
+	}
+	// We know that hostname is shorter than addr so we can safely check the
+	// next char
+	next := addr[len(hostname)]
+	return next == '.' || next == ':'
+}
+
 // addrForKey returns the gitserver address to use for the given string key,
 // which is hashed for sharding purposes.
 func addrForKey(key string, addrs []string) string {
+	return addrs[addrIndexForKey(key, addrs)]
+}
+
+// addrIndexForKey returns the index in addrs of the gitserver address to use
+// for the given string key.
+func addrIndexForKey(key string, addrs []string) int {
 	sum := md5.Sum([]byte(key))
-	serverIndex := binary.BigEndian.Uint64(sum[:]) % uint64(len(addrs))
-	return addrs[serverIndex]
+	return int(binary.BigEndian.Uint64(sum[:]) % uint64(len(addrs)))
 }
 
 // ArchiveOptions contains options for the Archive func.
@@ -292,7 +359,22 @@ func (c *Cmd) sendExec(ctx context.Context) (_ io.ReadCloser, _ http.Header, err
 		EnsureRevision: c.EnsureRevision,
 		Args:           c.Args[1:],
 	}
-	resp, err := c.client.httpPost(ctx, repoName, "exec", req)
+
+	var (
+		body    io.ReadCloser
+		trailer http.Header
+	)
+	err := c.client.doWithReplicas(ctx, repoName, "exec", func(addr string) (err error) {
+		body, trailer, err = c.sendExecTo(ctx, addr, req)
+		return err
+	})
+	return body, trailer, err
+}
+
+// sendExecTo sends the exec request req to the gitserver at addr.
+func (c *Cmd) sendExecTo(ctx context.Context, addr string, req *protocol.ExecRequest) (io.ReadCloser, http.Header, error) {
+	repoName := req.Repo
+	resp, err := c.client.httpPostWithURI(ctx, repoName, "http://"+addr+"/exec", req)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -341,8 +423,17 @@ func (c *Client) Search(ctx context.Context, args *protocol.SearchRequest, onMat
 		return false, err
 	}
 
-	uri := "http://" + c.AddrForRepo(repoName) + "/search"
-	resp, err := c.do(ctx, repoName, "POST", uri, buf.Bytes())
+	err = c.doWithReplicas(ctx, repoName, "search", func(addr string) (err error) {
+		limitHit, err = c.searchOn(ctx, addr, repoName, buf.Bytes(), onMatches)
+		return err
+	})
+	return limitHit, err
+}
+
+// searchOn runs the encoded search request payload on the gitserver at addr.
+func (c *Client) searchOn(ctx context.Context, addr string, repoName api.RepoName, payload []byte, onMatches func([]protocol.CommitMatch)) (limitHit bool, err error) {
+	uri := "http://" + addr + "/search"
+	resp, err := c.do(ctx, repoName, "POST", uri, payload)
 	if err != nil {
 		return false, err
 	}
@@ -423,6 +514,49 @@ var deadlineExceededCounter = promauto.NewCounter(prometheus.CounterOpts{
 	Help: "Times that Client.sendExec() returned context.DeadlineExceeded",
 })
 
+var replicaFallbackCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_gitserver_client_replica_fallback_total",
+	Help: "Number of requests that were retried on a replica of the repository",
+}, []string{"op"})
+
+// doWithReplicas calls do with the address of the primary gitserver of repo.
+// As long as do fails with an error after which a replica might succeed (see
+// shouldTryReplica), do is called again with the address of the next replica.
+// If every gitserver fails with such an error, the error of the primary is
+// returned.
+func (c *Client) doWithReplicas(ctx context.Context, repo api.RepoName, op string, do func(addr string) error) error {
+	var primaryErr, err error
+	for i, addr := range c.ReplicaAddrsForRepo(repo) {
+		if i > 0 {
+			log15.Debug("gitserver: retrying request on replica", "op", op, "repo", repo, "addr", addr, "error", err)
+			replicaFallbackCounter.WithLabelValues(op).Inc()
+		}
+		err = do(addr)
+		if err == nil || !shouldTryReplica(ctx, err) {
+			return err
+		}
+		if primaryErr == nil {
+			primaryErr = err
+		}
+	}
+	return primaryErr
+}
+
+// shouldTryReplica reports whether err, returned by a request to a gitserver,
+// means that the gitserver is unreachable or does not have the repository
+// cloned, so that the request should be sent to a replica.
+func shouldTryReplica(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if gitdomain.IsRepoNotExist(err) {
+		return true
+	}
+	var opErr *net.OpError
+	var dnsErr *net.DNSError
+	return errors.As(err, &opErr) || errors.As(err, &dnsErr)
+}
+
 // Cmd represents a command to be executed remotely.
 type Cmd struct {
 	client *Client
@@ -658,20 +792,27 @@ func (c *Client) RequestRepoUpdate(ctx context.Context, repo api.RepoName, since
 // RequestRepoMigrate is effectively RequestRepoUpdate but with some additional metadata to aid our
 // migration of gitserver repos to the rendezvous hashing scheme.
 func (c *Client) RequestRepoMigrate(ctx context.Context, repo api.RepoName) (*protocol.RepoUpdateResponse, error) {
+	// We send the request to the gitserver instance that should be the new owner of this "repo"
+	// based on the rendezvous hashing scheme. It will clone the repo from the gitserver instance
+	// that owns this repo based on the existing hashing scheme.
+	return c.RequestRepoMove(ctx, repo, c.AddrForRepo(repo), c.RendezvousAddrForRepo(repo))
+}
+
+// RequestRepoMove requests the gitserver at the address to to clone repo from the gitserver at the
+// address from instead of the upstream repo URL of the external service. The gitserver at from
+// streams the packfile of the repo to the gitserver at to. It blocks until the clone is finished.
+func (c *Client) RequestRepoMove(ctx context.Context, repo api.RepoName, from, to string) (*protocol.RepoUpdateResponse, error) {
 	// We do not need to set a value for the attribute "Since" because the repo is not expected to
 	// be cloned at the new gitserver instance. And for not cloned repos, this attribute is already
 	// ignored.
 	req := &protocol.RepoUpdateRequest{
 		Repo:        repo,
-		MigrateFrom: c.AddrForRepo(repo),
+		MigrateFrom: from,
 	}
 
-	// We set "uri" to the HTTP URL of the gitserver instance that should be the new owner of this
-	// "repo" based on the rendezvous hashing scheme. This way, when the gitserver instance receives
-	// the request at /repo-update, it will treat it as a new clone operation and attempt to clone
-	// the repo from the URL set in MigrateFrom - the gitserver instance that owns this repo based
-	// on the existing hashing scheme.
-	uri := "http://" + c.RendezvousAddrForRepo(repo) + "/repo-update"
+	// When the gitserver instance receives the request at /repo-update, it will treat it as a new
+	// clone operation and attempt to clone the repo from the URL set in MigrateFrom.
+	uri := "http://" + to + "/repo-update"
 	resp, err := c.httpPostWithURI(ctx, repo, uri, req)
 	if err != nil {
 		return nil, err
@@ -960,10 +1101,15 @@ func (c *Client) doReposStats(ctx context.Context, addr string) (*protocol.Repos
 
 // Remove removes the repository clone from gitserver.
 func (c *Client) Remove(ctx context.Context, repo api.RepoName) error {
+	return c.RemoveFrom(ctx, repo, c.AddrForRepo(repo))
+}
+
+// RemoveFrom removes the repository clone from the gitserver at addr.
+func (c *Client) RemoveFrom(ctx context.Context, repo api.RepoName, addr string) error {
 	req := &protocol.RepoDeleteRequest{
 		Repo: repo,
 	}
-	resp, err := c.httpPost(ctx, repo, "delete", req)
+	resp, err := c.httpPostWithURI(ctx, repo, "http://"+addr+"/delete", req)
 	if err != nil {
 		return err
 	}
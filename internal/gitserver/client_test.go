@@ -7,6 +7,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -320,6 +322,172 @@ func TestAddrForRepo(t *testing.T) {
 	}
 }
 
+func TestReplicaAddrsForRepo(t *testing.T) {
+	addrs := []string{"gitserver-1", "gitserver-2", "gitserver-3"}
+
+	testCases := []struct {
+		name string
+		repo api.RepoName
+		n    int
+		want []string
+	}{
+		{
+			name: "no replication",
+			repo: api.RepoName("repo1"),
+			n:    1,
+			want: []string{"gitserver-3"},
+		},
+		{
+			name: "replicas wrap around",
+			repo: api.RepoName("repo1"),
+			n:    2,
+			want: []string{"gitserver-3", "gitserver-1"},
+		},
+		{
+			name: "another repo",
+			repo: api.RepoName("github.com/sourcegraph/sourcegraph.git"),
+			n:    2,
+			want: []string{"gitserver-2", "gitserver-3"},
+		},
+		{
+			name: "more replicas than gitservers",
+			repo: api.RepoName("github.com/sourcegraph/sourcegraph.git"),
+			n:    5,
+			want: []string{"gitserver-2", "gitserver-3", "gitserver-1"},
+		},
+		{
+			name: "invalid replication factor",
+			repo: api.RepoName("repo1"),
+			n:    0,
+			want: []string{"gitserver-3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := gitserver.ReplicaAddrsForRepo(tc.repo, addrs, tc.n)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Fatalf("Mismatch (-want +got):\n%s", diff)
+			}
+			if got[0] != gitserver.AddrForRepo(tc.repo, addrs) {
+				t.Fatalf("primary %q is not the address returned by AddrForRepo", got[0])
+			}
+		})
+	}
+}
+
+func TestClient_ReplicaFallback(t *testing.T) {
+	repo := api.RepoName("repo1")
+	addrs := []string{"gitserver-1", "gitserver-2", "gitserver-3"}
+
+	ok := func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewBufferString("output")),
+			Trailer:    http.Header{"X-Exec-Exit-Status": []string{"0"}},
+		}, nil
+	}
+	notCloned := func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"cloneInProgress":true}`)),
+		}, nil
+	}
+	unreachable := func() (*http.Response, error) {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	failed := func() (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       io.NopCloser(bytes.NewBufferString("")),
+		}, nil
+	}
+
+	tests := []struct {
+		name      string
+		factor    int
+		responses map[string]func() (*http.Response, error)
+		wantHosts []string
+		wantErr   string
+	}{
+		{
+			name:      "primary succeeds",
+			factor:    2,
+			responses: map[string]func() (*http.Response, error){"gitserver-3": ok},
+			wantHosts: []string{"gitserver-3"},
+		},
+		{
+			name:      "primary unreachable",
+			factor:    2,
+			responses: map[string]func() (*http.Response, error){"gitserver-3": unreachable, "gitserver-1": ok},
+			wantHosts: []string{"gitserver-3", "gitserver-1"},
+		},
+		{
+			name:      "primary not cloned",
+			factor:    3,
+			responses: map[string]func() (*http.Response, error){"gitserver-3": notCloned, "gitserver-1": unreachable, "gitserver-2": ok},
+			wantHosts: []string{"gitserver-3", "gitserver-1", "gitserver-2"},
+		},
+		{
+			name:      "no replication",
+			factor:    1,
+			responses: map[string]func() (*http.Response, error){"gitserver-3": unreachable, "gitserver-1": ok},
+			wantHosts: []string{"gitserver-3"},
+			wantErr:   "connection refused",
+		},
+		{
+			name:      "all replicas fail",
+			factor:    2,
+			responses: map[string]func() (*http.Response, error){"gitserver-3": notCloned, "gitserver-1": unreachable},
+			wantHosts: []string{"gitserver-3", "gitserver-1"},
+			wantErr:   "repository does not exist (clone in progress): repo1",
+		},
+		{
+			name:      "no fallback on other errors",
+			factor:    2,
+			responses: map[string]func() (*http.Response, error){"gitserver-3": failed, "gitserver-1": ok},
+			wantHosts: []string{"gitserver-3"},
+			wantErr:   "unexpected status code: 500",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var hosts []string
+			cli := &gitserver.Client{
+				Addrs:             func() []string { return addrs },
+				ReplicationFactor: func() int { return test.factor },
+				HTTPClient: httpcli.DoerFunc(func(r *http.Request) (*http.Response, error) {
+					hosts = append(hosts, r.URL.Host)
+					respond, ok := test.responses[r.URL.Host]
+					if !ok || r.URL.Path != "/exec" {
+						return nil, errors.Errorf("unexpected url: %s", r.URL)
+					}
+					return respond()
+				}),
+			}
+
+			cmd := cli.Command("git", "rev-parse", "HEAD")
+			cmd.Repo = repo
+			out, err := cmd.Output(context.Background())
+			if test.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(out) != "output" {
+					t.Fatalf("unexpected output %q", out)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("want error containing %q, got %v", test.wantErr, err)
+			}
+
+			if diff := cmp.Diff(test.wantHosts, hosts); diff != "" {
+				t.Fatalf("Mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestRendezvousAddrForRepo(t *testing.T) {
 	addrs := []string{"gitserver-1", "gitserver-2", "gitserver-3"}
 
@@ -17,6 +17,10 @@ type SearchRequest struct {
 	Query       Node
 	IncludeDiff bool
 	Limit       int
+
+	// IncludeMerges includes merge commits in the search, which are skipped
+	// by default.
+	IncludeMerges bool
 }
 
 type RevisionSpecifier struct {
@@ -43,7 +47,7 @@ type SearchEventDone struct {
 func (s SearchEventDone) Err() error {
 	if s.Error != "" {
 		var e gitdomain.RepoNotExistError
-		if err := json.Unmarshal([]byte(s.Error), &e); err != nil {
+		if err := json.Unmarshal([]byte(s.Error), &e); err == nil {
 			return &e
 		}
 		return errors.New(s.Error)
@@ -191,6 +195,23 @@ type RepoDeleteRequest struct {
 	Repo api.RepoName
 }
 
+// RepoBackupRequest is a request to back up repositories on gitserver.
+type RepoBackupRequest struct {
+	// Repos are the repositories to back up. If empty, all repositories
+	// cloned on the gitserver are backed up.
+	Repos []api.RepoName
+}
+
+// RepoBackupResponse is the response to a RepoBackupRequest.
+type RepoBackupResponse struct {
+	// BackedUp are the repositories that were backed up.
+	BackedUp []api.RepoName
+
+	// Errors maps the repositories that could not be backed up to the
+	// reason why not.
+	Errors map[api.RepoName]string
+}
+
 // RepoInfoRequest is a request for information about multiple repositories on gitserver.
 type RepoInfoRequest struct {
 	// Repos are the repositories to get information about.
@@ -85,6 +85,50 @@ func (d *DiffModifiesFile) String() string {
 	return fmt.Sprintf("%T(%s)", d, d.Expr)
 }
 
+// DiffRenamesFile is a predicate that matches if the commit renames any files
+// whose old or new path matches the given regex pattern.
+type DiffRenamesFile struct {
+	Expr       string
+	IgnoreCase bool
+}
+
+func (d *DiffRenamesFile) String() string {
+	return fmt.Sprintf("%T(%s)", d, d.Expr)
+}
+
+// DiffLinesAdded is a predicate that matches if the number of lines added by
+// the commit is at least Min and at most Max. A negative Max means there is no
+// upper bound.
+type DiffLinesAdded struct {
+	Min, Max int
+}
+
+func (d *DiffLinesAdded) String() string {
+	return fmt.Sprintf("%T(%d,%d)", d, d.Min, d.Max)
+}
+
+// DiffLinesRemoved is a predicate that matches if the number of lines removed
+// by the commit is at least Min and at most Max. A negative Max means there is
+// no upper bound.
+type DiffLinesRemoved struct {
+	Min, Max int
+}
+
+func (d *DiffLinesRemoved) String() string {
+	return fmt.Sprintf("%T(%d,%d)", d, d.Min, d.Max)
+}
+
+// CommitParents is a predicate that matches if the commit has at least Min
+// parents. Merge commits have at least two parents. Note that merge commits
+// are only searched if SearchRequest.IncludeMerges is set.
+type CommitParents struct {
+	Min int
+}
+
+func (c *CommitParents) String() string {
+	return fmt.Sprintf("%T(%d)", c, c.Min)
+}
+
 // Boolean is a predicate that will either always match or never match
 type Boolean struct {
 	Value bool
@@ -219,6 +263,10 @@ func RegisterGob() {
 		gob.Register(&MessageMatches{})
 		gob.Register(&DiffMatches{})
 		gob.Register(&DiffModifiesFile{})
+		gob.Register(&DiffRenamesFile{})
+		gob.Register(&DiffLinesAdded{})
+		gob.Register(&DiffLinesRemoved{})
+		gob.Register(&CommitParents{})
 		gob.Register(&Boolean{})
 		gob.Register(&Operator{})
 	})
@@ -216,6 +216,16 @@ func mergeOrRegexp(n Node) Node {
 			} else {
 				mergeable[key] = v
 			}
+		case *DiffRenamesFile:
+			key := DiffRenamesFile{IgnoreCase: v.IgnoreCase}
+			if prev, ok := mergeable[key]; ok {
+				mergeable[key] = &DiffRenamesFile{
+					Expr:       "(" + prev.(*DiffRenamesFile).Expr + ")|(" + v.Expr + ")",
+					IgnoreCase: v.IgnoreCase,
+				}
+			} else {
+				mergeable[key] = v
+			}
 		default:
 			unmergeable = append(unmergeable, operand)
 		}
@@ -244,11 +254,13 @@ func estimatedNodeCost(n Node) float64 {
 		return 0
 	case *CommitBefore, *CommitAfter:
 		return 1
+	case *CommitParents:
+		return 1
 	case *AuthorMatches, *CommitterMatches:
 		return 5
 	case *MessageMatches:
 		return 10
-	case *DiffModifiesFile:
+	case *DiffModifiesFile, *DiffRenamesFile, *DiffLinesAdded, *DiffLinesRemoved:
 		return 1000
 	case *DiffMatches:
 		return 10000
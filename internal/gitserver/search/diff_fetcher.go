@@ -14,7 +14,8 @@ import (
 // DiffFetcher is a handle to the stdin and stdout of a git diff-tree subprocess
 // started with StartDiffFetcher
 type DiffFetcher struct {
-	dir string
+	dir           string
+	detectRenames bool
 
 	startOnce sync.Once
 	stdin     io.Writer
@@ -25,10 +26,11 @@ type DiffFetcher struct {
 }
 
 // NewDiffFetcher starts a git diff-tree subprocess that waits, listening on stdin
-// for comimt hashes to generate patches for.
-func NewDiffFetcher(dir string) (*DiffFetcher, error) {
+// for comimt hashes to generate patches for. If detectRenames is set, renamed
+// files are reported as renames rather than as a deletion and a creation.
+func NewDiffFetcher(dir string, detectRenames bool) (*DiffFetcher, error) {
 
-	return &DiffFetcher{dir: dir}, nil
+	return &DiffFetcher{dir: dir, detectRenames: detectRenames}, nil
 }
 
 func (d *DiffFetcher) Stop() {
@@ -42,14 +44,18 @@ func (d *DiffFetcher) start() (err error) {
 	d.startOnce.Do(func() {
 		ctx := context.Background()
 		ctx, d.cancel = context.WithCancel(ctx)
-		d.cmd = exec.CommandContext(ctx, "git",
+		args := []string{
 			"diff-tree",
 			"--stdin",          // Read commit hashes from stdin
 			"--no-prefix",      // Do not prefix file names with a/ and b/
 			"-p",               // Output in patch format
 			"--format=format:", // Output only the patch, not any other commit metadata
 			"--root",           // Treat the root commit as a big creation event (otherwise the diff would be empty)
-		)
+		}
+		if d.detectRenames {
+			args = append(args, "-M") // Detect renames, so that renamed files are not reported as a deletion and a creation
+		}
+		d.cmd = exec.CommandContext(ctx, "git", args...)
 		d.cmd.Dir = d.dir
 
 		var stdoutReader io.ReadCloser
@@ -92,7 +98,9 @@ func (d *DiffFetcher) start() (err error) {
 }
 
 // Fetch fetches a diff from the git diff-tree subprocess, writing to its stdin
-// and waiting for its response on stdout. Note that this is not safe to call concurrently.
+// and waiting for its response on stdout. hash may be followed by the hashes of
+// the parents to diff against, separated by spaces. Note that this is not safe
+// to call concurrently.
 func (d *DiffFetcher) Fetch(hash []byte) ([]byte, error) {
 	if err := d.start(); err != nil {
 		return nil, err
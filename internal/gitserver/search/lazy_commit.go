@@ -20,6 +20,10 @@ type LazyCommit struct {
 	diff        []*diff.FileDiff
 	diffFetcher *DiffFetcher
 
+	// lineCounts are the numbers of added and removed lines in diff, computed
+	// on the first call to LineCounts
+	lineCounts *[2]int
+
 	// LowerBuf is a re-usable buffer for doing case-transformations on the fields of LazyCommit
 	LowerBuf []byte
 }
@@ -42,8 +46,17 @@ func (l *LazyCommit) CommitterDate() (time.Time, error) {
 	return time.Unix(int64(unixSeconds), 0), nil
 }
 
-// RawDiff returns the diff exactly as returned by git diff-tree
+// RawDiff returns the diff exactly as returned by git diff-tree. Merge commits
+// are diffed against their first parent, since git diff-tree prints no patch
+// for them otherwise.
 func (l *LazyCommit) RawDiff() ([]byte, error) {
+	if parents := bytes.Fields(l.ParentHashes); len(parents) > 1 {
+		hash := make([]byte, 0, len(l.Hash)+1+len(parents[0]))
+		hash = append(hash, l.Hash...)
+		hash = append(hash, ' ')
+		hash = append(hash, parents[0]...)
+		return l.diffFetcher.Fetch(hash)
+	}
 	return l.diffFetcher.Fetch(l.Hash)
 }
 
@@ -58,6 +71,11 @@ func (l *LazyCommit) Diff() ([]*diff.FileDiff, error) {
 		return nil, err
 	}
 
+	// git diff-tree separates the output for consecutive commits with an
+	// empty line, which go-diff fails to parse if the first file diff has no
+	// hunks, as is the case for a pure rename.
+	rawDiff = bytes.TrimLeft(rawDiff, "\n")
+
 	r := diff.NewMultiFileDiffReader(bytes.NewReader(rawDiff))
 	diff, err := r.ReadAllFiles()
 	if err != nil {
@@ -67,6 +85,37 @@ func (l *LazyCommit) Diff() ([]*diff.FileDiff, error) {
 	return diff, nil
 }
 
+// LineCounts returns the number of lines added and removed by the commit,
+// caching the result
+func (l *LazyCommit) LineCounts() (added, removed int, err error) {
+	if l.lineCounts != nil {
+		return l.lineCounts[0], l.lineCounts[1], nil
+	}
+
+	diff, err := l.Diff()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, fileDiff := range diff {
+		for _, hunk := range fileDiff.Hunks {
+			for _, line := range bytes.Split(hunk.Body, []byte("\n")) {
+				if len(line) == 0 {
+					continue
+				}
+				switch line[0] {
+				case '+':
+					added++
+				case '-':
+					removed++
+				}
+			}
+		}
+	}
+	l.lineCounts = &[2]int{added, removed}
+	return added, removed, nil
+}
+
 func (l *LazyCommit) ParentIDs() []api.CommitID {
 	strs := strings.Split(string(l.ParentHashes), " ")
 	commitIDs := make([]api.CommitID, 0, len(strs))
@@ -2,9 +2,11 @@ package search
 
 import (
 	"bytes"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/cockroachdb/errors"
+	"github.com/sourcegraph/go-diff/diff"
 
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
 	"github.com/sourcegraph/sourcegraph/internal/search/casetransform"
@@ -35,6 +37,15 @@ func ToMatchTree(q protocol.Node) (MatchTree, error) {
 	case *protocol.DiffModifiesFile:
 		re, err := casetransform.CompileRegexp(v.Expr, v.IgnoreCase)
 		return &DiffModifiesFile{re}, err
+	case *protocol.DiffRenamesFile:
+		re, err := casetransform.CompileRegexp(v.Expr, v.IgnoreCase)
+		return &DiffRenamesFile{re}, err
+	case *protocol.DiffLinesAdded:
+		return &DiffLinesAdded{*v}, nil
+	case *protocol.DiffLinesRemoved:
+		return &DiffLinesRemoved{*v}, nil
+	case *protocol.CommitParents:
+		return &CommitParents{*v}, nil
 	case *protocol.Boolean:
 		return &Constant{v.Value}, nil
 	case *protocol.Operator:
@@ -218,6 +229,113 @@ func (dmf *DiffModifiesFile) Match(lc *LazyCommit) (CommitFilterResult, MatchedC
 	return CommitFilterResult{MatchedFileDiffs: matchedFileDiffs}, MatchedCommit{Diff: fileDiffHighlights}, nil
 }
 
+// DiffRenamesFile is a predicate that matches if the commit renames any files
+// whose old or new path matches the given regex pattern.
+type DiffRenamesFile struct {
+	*casetransform.Regexp
+}
+
+func (drf *DiffRenamesFile) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	diff, err := lc.Diff()
+	if err != nil {
+		return filterResult(false), MatchedCommit{}, err
+	}
+
+	var fileDiffHighlights map[int]MatchedFileDiff
+	matchedFileDiffs := make(map[int]struct{})
+	for fileIdx, fileDiff := range diff {
+		if !isRename(fileDiff) {
+			continue
+		}
+
+		oldFileMatches := drf.FindAllIndex([]byte(fileDiff.OrigName), -1, &lc.LowerBuf)
+		newFileMatches := drf.FindAllIndex([]byte(fileDiff.NewName), -1, &lc.LowerBuf)
+		if oldFileMatches != nil || newFileMatches != nil {
+			if fileDiffHighlights == nil {
+				fileDiffHighlights = make(map[int]MatchedFileDiff)
+			}
+			fileDiffHighlights[fileIdx] = MatchedFileDiff{
+				OldFile: matchesToRanges([]byte(fileDiff.OrigName), oldFileMatches),
+				NewFile: matchesToRanges([]byte(fileDiff.NewName), newFileMatches),
+			}
+			matchedFileDiffs[fileIdx] = struct{}{}
+		}
+	}
+
+	return CommitFilterResult{MatchedFileDiffs: matchedFileDiffs}, MatchedCommit{Diff: fileDiffHighlights}, nil
+}
+
+// hasDiffRenamesFile returns whether the match tree contains a DiffRenamesFile
+// predicate, which needs git to detect renames.
+func hasDiffRenamesFile(mt MatchTree) bool {
+	switch v := mt.(type) {
+	case *DiffRenamesFile:
+		return true
+	case *Operator:
+		for _, operand := range v.Operands {
+			if hasDiffRenamesFile(operand) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRename returns whether the file diff is a rename, which git reports in
+// the extended headers.
+func isRename(fileDiff *diff.FileDiff) bool {
+	for _, header := range fileDiff.Extended {
+		if strings.HasPrefix(header, "rename from ") {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffLinesAdded is a predicate that matches if the number of lines added by
+// the commit is within the given range.
+type DiffLinesAdded struct {
+	protocol.DiffLinesAdded
+}
+
+func (d *DiffLinesAdded) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	added, _, err := lc.LineCounts()
+	if err != nil {
+		return filterResult(false), MatchedCommit{}, err
+	}
+	return filterResult(inLineCountRange(added, d.Min, d.Max)), MatchedCommit{}, nil
+}
+
+// DiffLinesRemoved is a predicate that matches if the number of lines removed
+// by the commit is within the given range.
+type DiffLinesRemoved struct {
+	protocol.DiffLinesRemoved
+}
+
+func (d *DiffLinesRemoved) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	_, removed, err := lc.LineCounts()
+	if err != nil {
+		return filterResult(false), MatchedCommit{}, err
+	}
+	return filterResult(inLineCountRange(removed, d.Min, d.Max)), MatchedCommit{}, nil
+}
+
+// inLineCountRange returns whether n is within [min, max]. A negative max
+// means there is no upper bound.
+func inLineCountRange(n, min, max int) bool {
+	return n >= min && (max < 0 || n <= max)
+}
+
+// CommitParents is a predicate that matches if the commit has at least the
+// given number of parents.
+type CommitParents struct {
+	protocol.CommitParents
+}
+
+func (c *CommitParents) Match(lc *LazyCommit) (CommitFilterResult, MatchedCommit, error) {
+	return filterResult(len(bytes.Fields(lc.ParentHashes)) >= c.Min), MatchedCommit{}, nil
+}
+
 type Constant struct {
 	Value bool
 }
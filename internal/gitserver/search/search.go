@@ -54,7 +54,6 @@ var (
 		"log",
 		"--decorate=full",
 		"-z",
-		"--no-merges",
 		"--format=format:" + strings.Join(commitFields, "%x00") + "%x00",
 	}
 
@@ -77,6 +76,9 @@ type CommitSearcher struct {
 	Query       MatchTree
 	Revisions   []protocol.RevisionSpecifier
 	IncludeDiff bool
+
+	// IncludeMerges includes merge commits, which are skipped by default.
+	IncludeMerges bool
 }
 
 // Search runs a search for commits matching the given predicate across the revisions passed in as revisionArgs.
@@ -123,8 +125,12 @@ func (cs *CommitSearcher) Search(ctx context.Context, onMatch func(*protocol.Com
 }
 
 func (cs *CommitSearcher) feedBatches(ctx context.Context, jobs chan job, resultChans chan chan *protocol.CommitMatch) (err error) {
-	revArgs := revsToGitArgs(cs.Revisions)
-	cmd := exec.CommandContext(ctx, "git", append(logArgs, revArgs...)...)
+	args := append([]string{}, logArgs...)
+	if !cs.IncludeMerges {
+		args = append(args, "--no-merges")
+	}
+	args = append(args, revsToGitArgs(cs.Revisions)...)
+	cmd := exec.CommandContext(ctx, "git", args...)
 	cmd.Dir = cs.RepoDir
 	stdoutReader, err := cmd.StdoutPipe()
 	if err != nil {
@@ -189,7 +195,7 @@ func tryInterpretErrorWithStderr(ctx context.Context, err error, stderr string)
 
 func (cs *CommitSearcher) runJobs(ctx context.Context, jobs chan job) error {
 	// Create a new diff fetcher subprocess for each worker
-	diffFetcher, err := NewDiffFetcher(cs.RepoDir)
+	diffFetcher, err := NewDiffFetcher(cs.RepoDir, hasDiffRenamesFile(cs.Query))
 	if err != nil {
 		return err
 	}
@@ -190,6 +190,95 @@ func TestSearch(t *testing.T) {
 	})
 }
 
+func TestSearchDiffPredicates(t *testing.T) {
+	cmds := []string{
+		"git config user.name camden",
+		"git config user.email camden@ccheek.com",
+		"printf 'a\\nb\\nc\\n' > file1",
+		"git add -A",
+		"git commit -m add",
+		"git mv file1 file2",
+		"git commit -m rename",
+		"git checkout -b other",
+		"printf 'a\\n' > file2",
+		"git commit -am shrink",
+		"git checkout -",
+		"echo d > file3",
+		"git add -A",
+		"git commit -m grow",
+		"git merge --no-ff -m merge other",
+	}
+	dir := initGitRepository(t, cmds...)
+
+	search := func(t *testing.T, query protocol.Node, includeMerges bool) []string {
+		t.Helper()
+		tree, err := ToMatchTree(query)
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir:       dir,
+			Query:         tree,
+			IncludeDiff:   true,
+			IncludeMerges: includeMerges,
+		}
+		var messages []string
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			messages = append(messages, strings.TrimSpace(match.Message.Content))
+		})
+		require.NoError(t, err)
+		return messages
+	}
+
+	t.Run("renamed", func(t *testing.T) {
+		require.Equal(t, []string{"rename"}, search(t, &protocol.DiffRenamesFile{Expr: "file1"}, false))
+		require.Empty(t, search(t, &protocol.DiffRenamesFile{Expr: "file3"}, false))
+	})
+
+	t.Run("renamed highlights", func(t *testing.T) {
+		tree, err := ToMatchTree(&protocol.DiffRenamesFile{Expr: "file2"})
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir:     dir,
+			Query:       tree,
+			IncludeDiff: true,
+		}
+		var matches []*protocol.CommitMatch
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			matches = append(matches, match)
+		})
+		require.NoError(t, err)
+		require.Len(t, matches, 1)
+		require.Equal(t, "file1 file2\n", matches[0].Diff.Content)
+		require.Equal(t, result.Ranges{{
+			Start: result.Location{Offset: 6, Column: 6},
+			End:   result.Location{Offset: 11, Column: 11},
+		}}, matches[0].Diff.MatchedRanges)
+	})
+
+	// Without a renamed predicate, renames aren't detected and count as
+	// removing and adding all lines of the file.
+	t.Run("lines added", func(t *testing.T) {
+		require.Equal(t, []string{"rename", "add"}, search(t, &protocol.DiffLinesAdded{Min: 2, Max: -1}, false))
+		require.Equal(t, []string{"grow"}, search(t, &protocol.DiffLinesAdded{Min: 1, Max: 1}, false))
+	})
+
+	t.Run("lines removed", func(t *testing.T) {
+		require.Equal(t, []string{"shrink", "rename"}, search(t, &protocol.DiffLinesRemoved{Min: 1, Max: -1}, false))
+		require.Equal(t, []string{"grow", "shrink", "add"}, search(t, &protocol.DiffLinesRemoved{Min: 0, Max: 2}, false))
+	})
+
+	t.Run("lines added of renames", func(t *testing.T) {
+		query := protocol.NewAnd(&protocol.DiffRenamesFile{Expr: "file"}, &protocol.DiffLinesAdded{Min: 0, Max: 0})
+		require.Equal(t, []string{"rename"}, search(t, query, false))
+	})
+
+	t.Run("merges", func(t *testing.T) {
+		require.Empty(t, search(t, &protocol.CommitParents{Min: 2}, false))
+		require.Equal(t, []string{"merge"}, search(t, &protocol.CommitParents{Min: 2}, true))
+		require.Len(t, search(t, protocol.NewAnd(), true), 5)
+		require.Len(t, search(t, protocol.NewAnd(), false), 4)
+	})
+}
+
 func TestCommitScanner(t *testing.T) {
 	cases := []struct {
 		input    []byte
@@ -513,3 +602,92 @@ func (authorNameGenerator) Generate(rand *rand.Rand, size int) reflect.Value {
 	}
 	return reflect.ValueOf(buf)
 }
+
+func TestSearchMergeDiffs(t *testing.T) {
+	cmds := []string{
+		"git config user.name camden",
+		"git config user.email camden@ccheek.com",
+		"printf 'a\\nb\\nc\\n' > file1",
+		"git add -A",
+		"git commit -m add",
+		"git checkout -b other",
+		"git mv file1 file2",
+		"printf 'a\\nb\\nc\\nd\\n' > file2",
+		"git commit -am rename",
+		"git checkout -",
+		"echo x > unrelated",
+		"git add -A",
+		"git commit -m unrelated",
+		"git merge --no-ff -m merge other",
+	}
+	dir := initGitRepository(t, cmds...)
+
+	search := func(t *testing.T, query protocol.Node) []*protocol.CommitMatch {
+		t.Helper()
+		tree, err := ToMatchTree(query)
+		require.NoError(t, err)
+		searcher := &CommitSearcher{
+			RepoDir:       dir,
+			Query:         tree,
+			IncludeDiff:   true,
+			IncludeMerges: true,
+		}
+		var matches []*protocol.CommitMatch
+		err = searcher.Search(context.Background(), func(match *protocol.CommitMatch) {
+			matches = append(matches, match)
+		})
+		require.NoError(t, err)
+		return matches
+	}
+
+	// Merge commits are diffed against their first parent.
+	onlyMerge := func(t *testing.T, query protocol.Node) {
+		t.Helper()
+		matches := search(t, protocol.NewAnd(&protocol.CommitParents{Min: 2}, query))
+		require.Len(t, matches, 1)
+		require.Equal(t, "merge", strings.TrimSpace(matches[0].Message.Content))
+	}
+
+	t.Run("diff", func(t *testing.T) {
+		matches := search(t, &protocol.CommitParents{Min: 2})
+		require.Len(t, matches, 1)
+		require.Equal(t, "file1 /dev/null\n@@ -1,3 +0,0 @@ \n-a\n-b\n-c\n/dev/null file2\n@@ -0,0 +1,4 @@ \n+a\n+b\n+c\n+d\n", matches[0].Diff.Content)
+	})
+
+	t.Run("renamed", func(t *testing.T) {
+		onlyMerge(t, &protocol.DiffRenamesFile{Expr: "file1"})
+		onlyMerge(t, protocol.NewAnd(&protocol.DiffRenamesFile{Expr: "file1"}, &protocol.DiffLinesAdded{Min: 1, Max: 1}))
+	})
+
+	t.Run("lines added", func(t *testing.T) {
+		onlyMerge(t, &protocol.DiffLinesAdded{Min: 4, Max: 4})
+	})
+
+	t.Run("lines removed", func(t *testing.T) {
+		onlyMerge(t, &protocol.DiffLinesRemoved{Min: 3, Max: 3})
+	})
+
+	t.Run("file", func(t *testing.T) {
+		onlyMerge(t, &protocol.DiffModifiesFile{Expr: "file2"})
+	})
+
+	t.Run("diff pattern", func(t *testing.T) {
+		onlyMerge(t, &protocol.DiffMatches{Expr: "d"})
+	})
+}
+
+func TestHasDiffRenamesFile(t *testing.T) {
+	for _, tc := range []struct {
+		query protocol.Node
+		want  bool
+	}{
+		{query: &protocol.DiffModifiesFile{Expr: "a"}, want: false},
+		{query: &protocol.DiffRenamesFile{Expr: "a"}, want: true},
+		{query: protocol.NewAnd(&protocol.DiffMatches{Expr: "a"}, &protocol.DiffLinesAdded{Min: 1, Max: -1}), want: false},
+		{query: protocol.NewOr(&protocol.DiffMatches{Expr: "a"}, protocol.NewAnd(&protocol.DiffRenamesFile{Expr: "a"})), want: true},
+	} {
+		tree, err := ToMatchTree(tc.query)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, hasDiffRenamesFile(tree), tc.query.String())
+	}
+}
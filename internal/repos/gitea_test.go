@@ -0,0 +1,44 @@
+package repos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGiteaSource_ListRepos(t *testing.T) {
+	conf := &schema.GiteaConnection{
+		Url:   "https://try.gitea.io",
+		Token: "secret",
+		Orgs:  []string{"gitea"},
+		Users: []string{"lunny"},
+		Exclude: []*schema.ExcludedGiteaRepo{
+			{Pattern: "-archive$"},
+		},
+	}
+	cf, save := newClientFactory(t, t.Name())
+	defer save(t)
+
+	svc := &types.ExternalService{
+		Kind:   extsvc.KindGitea,
+		Config: marshalJSON(t, conf),
+	}
+
+	src, err := NewGiteaSource(svc, cf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src.perPage = 3 // 2 pages for the 4 repositories in the org, and a short last page can't be told apart from a capped one
+
+	repos, err := listAll(context.Background(), src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertGolden(t, "testdata/sources/"+t.Name(), update(t.Name()), repos)
+}
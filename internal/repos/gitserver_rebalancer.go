@@ -0,0 +1,286 @@
+package repos
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/inconshreveable/log15"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	gitserverprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/repoupdater/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+// maxGitserverRebalanceFailures is the maximum number of failures reported by
+// GitserverRebalancer.Status.
+const maxGitserverRebalanceFailures = 100
+
+var gitserverRebalanceMoves = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "src_repoupdater_gitserver_rebalance_moves_total",
+	Help: "Incremented each time a repository is moved between gitservers.",
+}, []string{"success"})
+
+// GitserverRebalancer moves repositories between gitservers after gitservers
+// were added or removed, so that every repository is stored on the gitserver
+// gitserver.AddrForRepo assigns it to. Instead of being cloned from the code
+// host again, a repository is cloned from the gitserver that currently stores
+// it, which streams its packfile to the new gitserver.
+//
+// The current placement of a repository is the shard ID recorded in the
+// gitserver_repos table. Repositories stored on a gitserver whose address the
+// rebalancer has never seen are left alone, gitservers clone them from the
+// code host on demand as before.
+type GitserverRebalancer struct {
+	// Store is used to list the current placement of repositories.
+	Store database.GitserverRepoStore
+
+	// Gitserver is used to move repositories.
+	Gitserver interface {
+		RequestRepoMove(ctx context.Context, repo api.RepoName, from, to string) (*gitserverprotocol.RepoUpdateResponse, error)
+		RemoveFrom(ctx context.Context, repo api.RepoName, addr string) error
+	}
+
+	// Addrs returns the addresses of the gitservers.
+	Addrs func() []string
+
+	// ReplicationFactor returns the number of gitservers each repository is
+	// stored on. Repositories are not removed from the gitservers that store
+	// a replica of them.
+	ReplicationFactor func() int
+
+	// Concurrency is the maximum number of repositories moved at once.
+	Concurrency int
+
+	trigger chan struct{}
+
+	mu sync.Mutex
+	// knownAddrs are the addresses of all gitservers seen since startup, so
+	// that repositories can be moved off gitservers that were removed.
+	knownAddrs []string
+	status     protocol.GitserverRebalanceStatus
+}
+
+// NewGitserverRebalancer returns a GitserverRebalancer that moves at most
+// concurrency repositories at once.
+func NewGitserverRebalancer(store database.GitserverRepoStore, cli *gitserver.Client, replicationFactor func() int, concurrency int) *GitserverRebalancer {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &GitserverRebalancer{
+		Store:             store,
+		Gitserver:         cli,
+		Addrs:             cli.Addrs,
+		ReplicationFactor: replicationFactor,
+		Concurrency:       concurrency,
+		trigger:           make(chan struct{}, 1),
+	}
+}
+
+// Run checks the list of gitservers every interval and moves repositories
+// whenever the list changed or a run was requested with Trigger. It returns
+// when ctx is canceled.
+func (r *GitserverRebalancer) Run(ctx context.Context, interval time.Duration) {
+	var previousAddrs string
+	for {
+		addrs := r.Addrs()
+		r.observe(addrs)
+
+		currentAddrs := strings.Join(addrs, ",")
+		changed := previousAddrs != "" && currentAddrs != previousAddrs
+		previousAddrs = currentAddrs
+
+		if changed {
+			r.rebalance(ctx, addrs)
+		}
+
+		select {
+		case <-r.trigger:
+			r.rebalance(ctx, r.Addrs())
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Trigger requests a run, which starts once the current run, if any, is
+// finished.
+func (r *GitserverRebalancer) Trigger() {
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Status returns the progress of the current or last run.
+func (r *GitserverRebalancer) Status() protocol.GitserverRebalanceStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	status := r.status
+	status.Failures = append([]protocol.GitserverRebalanceFailure(nil), r.status.Failures...)
+	return status
+}
+
+// observe records addrs in the list of known gitserver addresses.
+func (r *GitserverRebalancer) observe(addrs []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, addr := range addrs {
+		if !containsString(r.knownAddrs, addr) {
+			r.knownAddrs = append(r.knownAddrs, addr)
+		}
+	}
+}
+
+func (r *GitserverRebalancer) rebalance(ctx context.Context, addrs []string) {
+	if len(addrs) == 0 {
+		return
+	}
+	r.observe(addrs)
+
+	r.mu.Lock()
+	knownAddrs := append([]string(nil), r.knownAddrs...)
+	r.mu.Unlock()
+
+	// We collect the moves before doing any of them, so that we don't hold on
+	// to a database connection while moving.
+	var moves []gitserverMove
+	err := r.Store.IterateRepoGitserverStatus(ctx, database.IterateRepoGitserverStatusOptions{}, func(repo types.RepoGitserverStatus) error {
+		if m, ok := planGitserverMove(repo, addrs, knownAddrs); ok {
+			moves = append(moves, m)
+		}
+		return nil
+	})
+	if err != nil {
+		log15.Error("gitserver rebalancer: listing repositories", "error", err)
+		return
+	}
+
+	r.mu.Lock()
+	r.status = protocol.GitserverRebalanceStatus{
+		Running:   true,
+		StartedAt: time.Now(),
+		Total:     len(moves),
+	}
+	r.mu.Unlock()
+
+	log15.Info("gitserver rebalancer: moving repositories", "count", len(moves))
+
+	bounded := goroutine.NewBounded(r.Concurrency)
+	for _, m := range moves {
+		if ctx.Err() != nil {
+			break
+		}
+		m := m
+		bounded.Go(func() error {
+			r.recordMove(m, r.move(ctx, m, addrs))
+			return nil
+		})
+	}
+	_ = bounded.Wait()
+
+	r.mu.Lock()
+	r.status.Running = false
+	r.status.FinishedAt = time.Now()
+	log15.Info("gitserver rebalancer: finished", "moved", r.status.Moved, "failed", r.status.Failed)
+	r.mu.Unlock()
+}
+
+// move copies a repository to the gitserver it belongs to and removes it from
+// the gitserver that stored it, unless that gitserver stores a replica of it.
+func (r *GitserverRebalancer) move(ctx context.Context, m gitserverMove, addrs []string) error {
+	resp, err := r.Gitserver.RequestRepoMove(ctx, m.repo, m.from, m.to)
+	if err != nil {
+		return err
+	}
+	if resp != nil && resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	n := 1
+	if r.ReplicationFactor != nil {
+		n = r.ReplicationFactor()
+	}
+	if containsString(gitserver.ReplicaAddrsForRepo(m.repo, addrs, n), m.from) {
+		return nil
+	}
+	if err := r.Gitserver.RemoveFrom(ctx, m.repo, m.from); err != nil {
+		// The repository was moved, the copy left behind only wastes disk
+		// space.
+		log15.Warn("gitserver rebalancer: removing moved repository", "repo", m.repo, "addr", m.from, "error", err)
+	}
+	return nil
+}
+
+func (r *GitserverRebalancer) recordMove(m gitserverMove, err error) {
+	gitserverRebalanceMoves.WithLabelValues(strconv.FormatBool(err == nil)).Inc()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err == nil {
+		r.status.Moved++
+		return
+	}
+
+	r.status.Failed++
+	r.status.Failures = append(r.status.Failures, protocol.GitserverRebalanceFailure{
+		Repo:  m.repo,
+		From:  m.from,
+		To:    m.to,
+		Error: err.Error(),
+		Time:  time.Now(),
+	})
+	if len(r.status.Failures) > maxGitserverRebalanceFailures {
+		r.status.Failures = r.status.Failures[len(r.status.Failures)-maxGitserverRebalanceFailures:]
+	}
+}
+
+// gitserverMove is a repository to be moved from the gitserver at from to the
+// gitserver at to.
+type gitserverMove struct {
+	repo     api.RepoName
+	from, to string
+}
+
+// planGitserverMove returns the move of repo to the gitserver in addrs it
+// belongs to, if it is stored on another gitserver. The gitserver it is stored
+// on is looked up in knownAddrs by the shard ID of repo.
+func planGitserverMove(repo types.RepoGitserverStatus, addrs, knownAddrs []string) (gitserverMove, bool) {
+	if repo.GitserverRepo == nil || repo.CloneStatus != types.CloneStatusCloned || repo.ShardID == "" {
+		return gitserverMove{}, false
+	}
+
+	to := gitserver.AddrForRepo(repo.Name, addrs)
+	if gitserver.HostnameMatch(repo.ShardID, to) {
+		return gitserverMove{}, false
+	}
+
+	for _, from := range knownAddrs {
+		if gitserver.HostnameMatch(repo.ShardID, from) {
+			return gitserverMove{repo: repo.Name, from: from, to: to}, true
+		}
+	}
+	return gitserverMove{}, false
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
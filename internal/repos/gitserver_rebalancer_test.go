@@ -0,0 +1,182 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	gitserverprotocol "github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestPlanGitserverMove(t *testing.T) {
+	addrs := []string{"gitserver-1:3178", "gitserver-2:3178"}
+	knownAddrs := []string{"gitserver-1:3178", "gitserver-2:3178", "gitserver-3:3178"}
+
+	repo := func(shardID string, status types.CloneStatus) types.RepoGitserverStatus {
+		return types.RepoGitserverStatus{
+			Name:          "repo1",
+			GitserverRepo: &types.GitserverRepo{ShardID: shardID, CloneStatus: status},
+		}
+	}
+	// repo1 belongs to gitserver-1 with two gitservers.
+	if got := gitserver.AddrForRepo("repo1", addrs); got != "gitserver-1:3178" {
+		t.Fatalf("unexpected placement of repo1: %s", got)
+	}
+
+	tests := []struct {
+		name   string
+		repo   types.RepoGitserverStatus
+		want   gitserverMove
+		wantOK bool
+	}{
+		{
+			name: "no gitserver state",
+			repo: types.RepoGitserverStatus{Name: "repo1"},
+		},
+		{
+			name: "not cloned",
+			repo: repo("gitserver-2", types.CloneStatusNotCloned),
+		},
+		{
+			name: "on the right gitserver",
+			repo: repo("gitserver-1", types.CloneStatusCloned),
+		},
+		{
+			name:   "on another gitserver",
+			repo:   repo("gitserver-2", types.CloneStatusCloned),
+			want:   gitserverMove{repo: "repo1", from: "gitserver-2:3178", to: "gitserver-1:3178"},
+			wantOK: true,
+		},
+		{
+			name:   "on a removed gitserver",
+			repo:   repo("gitserver-3", types.CloneStatusCloned),
+			want:   gitserverMove{repo: "repo1", from: "gitserver-3:3178", to: "gitserver-1:3178"},
+			wantOK: true,
+		},
+		{
+			name: "on an unknown gitserver",
+			repo: repo("gitserver-9", types.CloneStatusCloned),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := planGitserverMove(test.repo, addrs, knownAddrs)
+			if ok != test.wantOK {
+				t.Fatalf("got ok %t, want %t", ok, test.wantOK)
+			}
+			if diff := cmp.Diff(test.want, got, cmp.AllowUnexported(gitserverMove{})); diff != "" {
+				t.Fatalf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGitserverRebalancer(t *testing.T) {
+	oldAddrs := []string{"gitserver-1:3178", "gitserver-2:3178"}
+	newAddrs := []string{"gitserver-1:3178", "gitserver-2:3178", "gitserver-3:3178"}
+
+	var repos []types.RepoGitserverStatus
+	var wantMoves []string
+	for i := 0; i < 30; i++ {
+		name := api.RepoName(fmt.Sprintf("repo-%d", i))
+		from, to := gitserver.AddrForRepo(name, oldAddrs), gitserver.AddrForRepo(name, newAddrs)
+		repos = append(repos, types.RepoGitserverStatus{
+			Name: name,
+			GitserverRepo: &types.GitserverRepo{
+				ShardID:     strings.TrimSuffix(from, ":3178"),
+				CloneStatus: types.CloneStatusCloned,
+			},
+		})
+		if from != to {
+			wantMoves = append(wantMoves, fmt.Sprintf("%s %s->%s", name, from, to))
+		}
+	}
+	if len(wantMoves) < 2 {
+		t.Fatalf("expected repositories to move, got %d", len(wantMoves))
+	}
+	sort.Strings(wantMoves)
+	failing := api.RepoName(strings.Fields(wantMoves[0])[0])
+
+	gs := &fakeRebalanceGitserver{fail: failing}
+	r := &GitserverRebalancer{
+		Store:       &fakeGitserverRepoStore{repos: repos},
+		Gitserver:   gs,
+		Addrs:       func() []string { return newAddrs },
+		Concurrency: 3,
+	}
+	r.observe(oldAddrs)
+	r.rebalance(context.Background(), newAddrs)
+
+	sort.Strings(gs.moves)
+	if diff := cmp.Diff(wantMoves, gs.moves); diff != "" {
+		t.Fatalf("moves mismatch (-want +got):\n%s", diff)
+	}
+	// Every moved repository except the failed one is removed from the
+	// gitserver it was moved from.
+	if have, want := len(gs.removals), len(wantMoves)-1; have != want {
+		t.Fatalf("got %d removals, want %d", have, want)
+	}
+
+	status := r.Status()
+	if status.Running || status.StartedAt.IsZero() || status.FinishedAt.IsZero() {
+		t.Fatalf("unexpected run state: %+v", status)
+	}
+	if status.Total != len(wantMoves) || status.Moved != len(wantMoves)-1 || status.Failed != 1 {
+		t.Fatalf("unexpected progress: total=%d moved=%d failed=%d", status.Total, status.Moved, status.Failed)
+	}
+	if len(status.Failures) != 1 || status.Failures[0].Repo != failing || status.Failures[0].Error != "clone failed" {
+		t.Fatalf("unexpected failures: %+v", status.Failures)
+	}
+}
+
+type fakeGitserverRepoStore struct {
+	database.GitserverRepoStore
+	repos []types.RepoGitserverStatus
+}
+
+func (s *fakeGitserverRepoStore) IterateRepoGitserverStatus(_ context.Context, _ database.IterateRepoGitserverStatusOptions, repoFn func(repo types.RepoGitserverStatus) error) error {
+	for _, repo := range s.repos {
+		if err := repoFn(repo); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeRebalanceGitserver struct {
+	fail api.RepoName
+
+	mu       sync.Mutex
+	moves    []string
+	removals []string
+}
+
+func (g *fakeRebalanceGitserver) RequestRepoMove(_ context.Context, repo api.RepoName, from, to string) (*gitserverprotocol.RepoUpdateResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.moves = append(g.moves, fmt.Sprintf("%s %s->%s", repo, from, to))
+	if repo == g.fail {
+		return &gitserverprotocol.RepoUpdateResponse{Error: "clone failed"}, nil
+	}
+	return &gitserverprotocol.RepoUpdateResponse{Cloned: true}, nil
+}
+
+func (g *fakeRebalanceGitserver) RemoveFrom(_ context.Context, repo api.RepoName, addr string) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if repo == g.fail {
+		return errors.New("must not remove a repository that failed to move")
+	}
+	g.removals = append(g.removals, fmt.Sprintf("%s %s", repo, addr))
+	return nil
+}
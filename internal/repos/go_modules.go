@@ -0,0 +1,164 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gomodproxy"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A GoModulesSource creates git repositories from the zips of Go modules
+// served by a module proxy.
+type GoModulesSource struct {
+	svc     *types.ExternalService
+	config  *schema.GoModulesConnection
+	client  *gomodproxy.Client
+	dbStore DependencyReposStore
+}
+
+// GoModulesScheme is the scheme under which Go dependencies are stored in the
+// dependency repos table.
+const GoModulesScheme = "gomod"
+
+// NewGoModulesSource returns a new GoModulesSource from the given external
+// service.
+func NewGoModulesSource(svc *types.ExternalService, cf *httpcli.Factory) (*GoModulesSource, error) {
+	var c schema.GoModulesConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GoModulesSource{
+		svc:     svc,
+		config:  &c,
+		client:  gomodproxy.NewClient(&c, cli),
+		dbStore: nil, // set via SetDB decorator
+	}, nil
+}
+
+func (s *GoModulesSource) SetDB(db dbutil.DB) {
+	s.dbStore = newDependencyReposStore(db)
+}
+
+// ListRepos returns a repository for every module configured in the external
+// service or referenced by an uploaded index.
+func (s *GoModulesSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[reposource.GoModule]bool)
+
+	dependencies, err := GoDependencies(*s.config)
+	if err != nil {
+		results <- SourceResult{Err: err}
+		return
+	}
+	for _, dep := range dependencies {
+		if seen[dep.GoModule] {
+			continue
+		}
+		seen[dep.GoModule] = true
+		results <- SourceResult{Source: s, Repo: s.makeRepo(dep.GoModule)}
+	}
+
+	var (
+		totalDBFetched  int
+		totalDBResolved int
+		lastID          int
+	)
+	for {
+		dbDeps, err := s.dbStore.GetDependencyRepos(ctx, dbstore.GetDependencyReposOpts{
+			Scheme: GoModulesScheme,
+			After:  lastID,
+			Limit:  100,
+		})
+		if err != nil {
+			results <- SourceResult{Err: err}
+			return
+		}
+
+		if len(dbDeps) == 0 {
+			break
+		}
+
+		totalDBFetched += len(dbDeps)
+
+		lastID = dbDeps[len(dbDeps)-1].ID
+
+		for _, dbDep := range dbDeps {
+			dep, err := reposource.ParseGoDependency(dbDep.Name + "@" + dbDep.Version)
+			if err != nil {
+				log15.Warn("error parsing Go module", "error", err, "module", dbDep.Name, "version", dbDep.Version)
+				continue
+			}
+			if seen[dep.GoModule] {
+				continue
+			}
+
+			// Like for JVM packages, only return modules that gitserver will
+			// be able to fetch.
+			if _, err := s.client.GetVersion(ctx, dep); err != nil {
+				log15.Warn("Go module not resolvable from proxy", "module", dep.PackageManagerSyntax(), "error", err)
+				continue
+			}
+
+			seen[dep.GoModule] = true
+			totalDBResolved++
+			results <- SourceResult{Source: s, Repo: s.makeRepo(dep.GoModule)}
+		}
+	}
+
+	log15.Info("finished listing resolvable Go modules", "totalDB", totalDBFetched, "resolvedDB", totalDBResolved, "totalConfig", len(dependencies))
+}
+
+func (s *GoModulesSource) makeRepo(mod reposource.GoModule) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: mod.RepoName(),
+		URI:  string(mod.RepoName()),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(mod.RepoName()),
+			ServiceID:   extsvc.TypeGoModules,
+			ServiceType: extsvc.TypeGoModules,
+		},
+		Private: false,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: mod.CloneURL(),
+			},
+		},
+		Metadata: &gomodproxy.Metadata{
+			Module: mod,
+		},
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *GoModulesSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func GoDependencies(connection schema.GoModulesConnection) (dependencies []reposource.GoDependency, err error) {
+	for _, dep := range connection.Dependencies {
+		dependency, err := reposource.ParseGoDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
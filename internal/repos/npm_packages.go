@@ -0,0 +1,171 @@
+package repos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/codeintel/stores/dbstore"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/npm"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// An NpmPackagesSource creates git repositories from the tarballs of packages
+// published to an npm registry.
+type NpmPackagesSource struct {
+	svc     *types.ExternalService
+	config  *schema.NpmPackagesConnection
+	client  *npm.Client
+	dbStore DependencyReposStore
+}
+
+// DependencyReposStore lists the package dependencies referenced by uploaded
+// code intelligence indexes.
+type DependencyReposStore interface {
+	GetDependencyRepos(ctx context.Context, filter dbstore.GetDependencyReposOpts) ([]dbstore.DependencyRepo, error)
+}
+
+// NpmPackagesScheme is the scheme under which npm dependencies are stored in
+// the dependency repos table.
+const NpmPackagesScheme = "npm"
+
+// NewNpmPackagesSource returns a new NpmPackagesSource from the given external
+// service.
+func NewNpmPackagesSource(svc *types.ExternalService, cf *httpcli.Factory) (*NpmPackagesSource, error) {
+	var c schema.NpmPackagesConnection
+	if err := jsonc.Unmarshal(svc.Config, &c); err != nil {
+		return nil, fmt.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NpmPackagesSource{
+		svc:     svc,
+		config:  &c,
+		client:  npm.NewClient(&c, cli),
+		dbStore: nil, // set via SetDB decorator
+	}, nil
+}
+
+func (s *NpmPackagesSource) SetDB(db dbutil.DB) {
+	s.dbStore = newDependencyReposStore(db)
+}
+
+// ListRepos returns a repository for every package configured in the external
+// service or referenced by an uploaded index.
+func (s *NpmPackagesSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	seen := make(map[reposource.NpmPackage]bool)
+
+	dependencies, err := NpmDependencies(*s.config)
+	if err != nil {
+		results <- SourceResult{Err: err}
+		return
+	}
+	for _, dep := range dependencies {
+		if seen[dep.NpmPackage] {
+			continue
+		}
+		seen[dep.NpmPackage] = true
+		results <- SourceResult{Source: s, Repo: s.makeRepo(dep.NpmPackage)}
+	}
+
+	var (
+		totalDBFetched  int
+		totalDBResolved int
+		lastID          int
+	)
+	for {
+		dbDeps, err := s.dbStore.GetDependencyRepos(ctx, dbstore.GetDependencyReposOpts{
+			Scheme: NpmPackagesScheme,
+			After:  lastID,
+			Limit:  100,
+		})
+		if err != nil {
+			results <- SourceResult{Err: err}
+			return
+		}
+
+		if len(dbDeps) == 0 {
+			break
+		}
+
+		totalDBFetched += len(dbDeps)
+
+		lastID = dbDeps[len(dbDeps)-1].ID
+
+		for _, dbDep := range dbDeps {
+			pkg, err := reposource.ParseNpmPackageFromPackageSyntax(dbDep.Name)
+			if err != nil {
+				log15.Warn("error parsing npm package", "error", err, "package", dbDep.Name)
+				continue
+			}
+			if seen[pkg] {
+				continue
+			}
+
+			// Like for JVM packages, only return packages that gitserver
+			// will be able to fetch.
+			dep := reposource.NpmDependency{NpmPackage: pkg, Version: dbDep.Version}
+			if _, err := s.client.GetDependencyInfo(ctx, dep); err != nil {
+				log15.Warn("npm package not resolvable from registry", "package", dep.PackageManagerSyntax(), "error", err)
+				continue
+			}
+
+			seen[pkg] = true
+			totalDBResolved++
+			results <- SourceResult{Source: s, Repo: s.makeRepo(pkg)}
+		}
+	}
+
+	log15.Info("finished listing resolvable npm packages", "totalDB", totalDBFetched, "resolvedDB", totalDBResolved, "totalConfig", len(dependencies))
+}
+
+func (s *NpmPackagesSource) makeRepo(pkg reposource.NpmPackage) *types.Repo {
+	urn := s.svc.URN()
+	return &types.Repo{
+		Name: pkg.RepoName(),
+		URI:  string(pkg.RepoName()),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(pkg.RepoName()),
+			ServiceID:   extsvc.TypeNpmPackages,
+			ServiceType: extsvc.TypeNpmPackages,
+		},
+		Private: false,
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: pkg.CloneURL(),
+			},
+		},
+		Metadata: &npm.Metadata{
+			Package: pkg,
+		},
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s *NpmPackagesSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func NpmDependencies(connection schema.NpmPackagesConnection) (dependencies []reposource.NpmDependency, err error) {
+	for _, dep := range connection.Dependencies {
+		dependency, err := reposource.ParseNpmDependency(dep)
+		if err != nil {
+			return nil, err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies, nil
+}
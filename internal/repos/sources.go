@@ -60,8 +60,18 @@ func NewSource(svc *types.ExternalService, cf *httpcli.Factory) (Source, error)
 		return NewPerforceSource(svc)
 	case extsvc.KindJVMPackages:
 		return NewJVMPackagesSource(svc)
+	case extsvc.KindNpmPackages:
+		return NewNpmPackagesSource(svc, cf)
+	case extsvc.KindGoModules:
+		return NewGoModulesSource(svc, cf)
 	case extsvc.KindPagure:
 		return NewPagureSource(svc, cf)
+	case extsvc.KindGitea:
+		return NewGiteaSource(svc, cf)
+	case extsvc.KindMercurial:
+		return NewMercurialSource(svc)
+	case extsvc.KindSubversion:
+		return NewSubversionSource(svc)
 	case extsvc.KindOther:
 		return NewOtherSource(svc, cf)
 	default:
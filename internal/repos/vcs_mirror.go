@@ -0,0 +1,93 @@
+package repos
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// mirroredRepos returns the repositories of a connection to a non-Git
+// version control server, such as Mercurial or Subversion, whose repositories
+// gitserver mirrors into Git. Like for the "other" code host, each repository
+// is a path relative to the base URL and named with the same
+// repositoryPathPattern placeholders.
+func mirroredRepos(svc *types.ExternalService, serviceType, baseURL string, repos []string, repositoryPathPattern string) ([]*types.Repo, error) {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	rs := reposource.Other{OtherExternalServiceConnection: &schema.OtherExternalServiceConnection{
+		Url:                   baseURL,
+		Repos:                 repos,
+		RepositoryPathPattern: repositoryPathPattern,
+	}}
+
+	urn := svc.URN()
+	result := make([]*types.Repo, 0, len(repos))
+	for _, repo := range repos {
+		cloneURL, err := base.Parse(repo)
+		if err != nil {
+			return nil, err
+		}
+		r, err := mirroredRepoFromCloneURL(urn, serviceType, rs, cloneURL)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+func mirroredRepoFromCloneURL(urn, serviceType string, rs reposource.Other, u *url.URL) (*types.Repo, error) {
+	cloneURL := u.String()
+	repoName, err := rs.CloneURLToRepoName(cloneURL)
+	if err != nil {
+		return nil, err
+	}
+	if repoName == "" {
+		return nil, errors.Errorf("repository %q is not below the base URL", redactedURL(u))
+	}
+	repoURI, err := rs.CloneURLToRepoURI(cloneURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unlike the "other" code host, we don't store the credentials of the
+	// base URL in the service ID. CloneURL adds them back from the
+	// configuration.
+	serviceID := url.URL{Scheme: u.Scheme, Host: u.Host}
+
+	return &types.Repo{
+		Name: repoName,
+		URI:  repoURI,
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          string(repoName),
+			ServiceType: serviceType,
+			ServiceID:   serviceID.String(),
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL,
+			},
+		},
+		Metadata: &extsvc.OtherRepoMetadata{
+			RelativePath: strings.TrimPrefix(redactedURL(u), serviceID.String()),
+		},
+	}, nil
+}
+
+// redactedURL returns u without its user information.
+func redactedURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	return redacted.String()
+}
@@ -234,6 +234,37 @@ func (c *Client) SchedulePermsSync(ctx context.Context, args protocol.PermsSyncR
 	return errors.New(res.Error)
 }
 
+// GitserverRebalance requests a run of the job that moves repositories between gitservers after
+// gitservers were added or removed, and returns the progress of the current or last run.
+func (c *Client) GitserverRebalance(ctx context.Context) (*protocol.GitserverRebalanceStatus, error) {
+	return c.gitserverRebalanceStatus(ctx, "gitserver-rebalance")
+}
+
+// GitserverRebalanceStatus returns the progress of the current or last run of the job that moves
+// repositories between gitservers.
+func (c *Client) GitserverRebalanceStatus(ctx context.Context) (*protocol.GitserverRebalanceStatus, error) {
+	return c.gitserverRebalanceStatus(ctx, "gitserver-rebalance-status")
+}
+
+func (c *Client) gitserverRebalanceStatus(ctx context.Context, method string) (*protocol.GitserverRebalanceStatus, error) {
+	resp, err := c.httpPost(ctx, method, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bs, _ := io.ReadAll(io.LimitReader(resp.Body, 200))
+		return nil, errors.Errorf("%s: http status %d: %s", method, resp.StatusCode, bs)
+	}
+
+	var status protocol.GitserverRebalanceStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
 // SyncExternalService requests the given external service to be synced.
 func (c *Client) SyncExternalService(
 	ctx context.Context,
@@ -280,3 +280,35 @@ type ExternalServiceSyncResult struct {
 	ExternalService api.ExternalService
 	Error           string
 }
+
+// GitserverRebalanceStatus is the progress of the job that moves repositories
+// between gitservers after gitservers were added or removed.
+type GitserverRebalanceStatus struct {
+	// Running is true while repositories are being moved.
+	Running bool
+	// StartedAt and FinishedAt are the times the last run started and
+	// finished. They are zero if no run has started or finished yet.
+	StartedAt  time.Time
+	FinishedAt time.Time
+
+	// Total is the number of repositories the last run moves, Moved and
+	// Failed the number of repositories it has moved and failed to move.
+	Total  int
+	Moved  int
+	Failed int
+
+	// Failures are the repositories the last run failed to move. The list is
+	// bounded, the oldest failures are dropped first.
+	Failures []GitserverRebalanceFailure
+}
+
+// GitserverRebalanceFailure is a repository that could not be moved between
+// gitservers.
+type GitserverRebalanceFailure struct {
+	Repo api.RepoName
+	// From and To are the addresses of the gitservers.
+	From  string
+	To    string
+	Error string
+	Time  time.Time
+}
@@ -30,6 +30,7 @@ type CommitSearch struct {
 	RepoOpts      search.RepoOptions
 	Diff          bool
 	HasTimeFilter bool
+	IncludeMerges bool
 	Limit         int
 
 	Db database.DB
@@ -71,11 +72,12 @@ func (j *CommitSearch) Run(ctx context.Context, stream streaming.Sender, repos s
 		}
 
 		args := &protocol.SearchRequest{
-			Repo:        repoRev.Repo.Name,
-			Revisions:   searchRevsToGitserverRevs(repoRev.Revs),
-			Query:       j.Query,
-			IncludeDiff: j.Diff,
-			Limit:       j.Limit,
+			Repo:          repoRev.Repo.Name,
+			Revisions:     searchRevsToGitserverRevs(repoRev.Revs),
+			Query:         j.Query,
+			IncludeDiff:   j.Diff,
+			Limit:         j.Limit,
+			IncludeMerges: j.IncludeMerges,
 		}
 
 		onMatches := func(in []protocol.CommitMatch) {
@@ -196,6 +198,13 @@ func HasTimeFilter(q query.Q) bool {
 	return hasTimeFilter
 }
 
+// IncludeMerges returns whether merge commits should be searched, which are
+// skipped unless the query contains merge:yes or merge:only.
+func IncludeMerges(q query.Q) bool {
+	merge := q.Merge()
+	return merge != nil && *merge != query.No
+}
+
 func QueryToGitQuery(q query.Q, diff bool) gitprotocol.Node {
 	return gitprotocol.Reduce(gitprotocol.NewAnd(queryNodesToPredicates(q, q.IsCaseSensitive(), diff)...))
 }
@@ -288,6 +297,20 @@ func queryParameterToPredicate(parameter query.Parameter, caseSensitive, diff bo
 		newPred = &gitprotocol.DiffModifiesFile{Expr: parameter.Value, IgnoreCase: !caseSensitive}
 	case query.FieldLang:
 		newPred = &gitprotocol.DiffModifiesFile{Expr: search.LangToFileRegexp(parameter.Value), IgnoreCase: true}
+	case query.FieldRenamed:
+		newPred = &gitprotocol.DiffRenamesFile{Expr: parameter.Value, IgnoreCase: !caseSensitive}
+	case query.FieldAdded:
+		min, max, _ := query.ParseLineCount(parameter.Value) // field already validated
+		newPred = &gitprotocol.DiffLinesAdded{Min: min, Max: max}
+	case query.FieldRemoved:
+		min, max, _ := query.ParseLineCount(parameter.Value) // field already validated
+		newPred = &gitprotocol.DiffLinesRemoved{Min: min, Max: max}
+	case query.FieldMerge:
+		// merge:yes and merge:no only decide whether merge commits are
+		// searched, see IncludeMerges.
+		if query.ParseYesNoOnly(parameter.Value) == query.Only {
+			newPred = &gitprotocol.CommitParents{Min: 2}
+		}
 	}
 
 	if parameter.Negated && newPred != nil {
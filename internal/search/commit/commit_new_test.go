@@ -62,6 +62,28 @@ func TestQueryToGitQuery(t *testing.T) {
 			&protocol.MessageMatches{Expr: "message2", IgnoreCase: true},
 			&protocol.DiffModifiesFile{Expr: "file", IgnoreCase: true},
 		),
+	}, {
+		name: "diff statistics and merges are converted",
+		input: []query.Node{
+			query.Parameter{Field: query.FieldRenamed, Value: "old"},
+			query.Parameter{Field: query.FieldAdded, Value: ">500"},
+			query.Parameter{Field: query.FieldRemoved, Value: "<=10"},
+			query.Parameter{Field: query.FieldMerge, Value: "only"},
+		},
+		diff: true,
+		output: protocol.NewAnd(
+			&protocol.CommitParents{Min: 2},
+			&protocol.DiffRenamesFile{Expr: "old", IgnoreCase: true},
+			&protocol.DiffLinesAdded{Min: 501, Max: -1},
+			&protocol.DiffLinesRemoved{Min: 0, Max: 10},
+		),
+	}, {
+		name: "merge:yes does not restrict matches",
+		input: []query.Node{
+			query.Parameter{Field: query.FieldMerge, Value: "yes"},
+		},
+		diff:   false,
+		output: &protocol.Boolean{Value: true},
 	}}
 
 	for _, tc := range cases {
@@ -39,6 +39,7 @@ func SearchLimits(c *conf.Unified) schema.SearchLimits {
 	withDefault(&limits.CommitDiffMaxRepos, 50)
 	withDefault(&limits.CommitDiffWithTimeFilterMaxRepos, 10000)
 	withDefault(&limits.MaxTimeoutSeconds, 60)
+	withDefault(&limits.MaxExportResults, 100000)
 
 	return limits
 }
@@ -26,6 +26,10 @@ const (
 	FieldAuthor    = "author"
 	FieldCommitter = "committer"
 	FieldMessage   = "message"
+	FieldRenamed   = "renamed"
+	FieldAdded     = "added"
+	FieldRemoved   = "removed"
+	FieldMerge     = "merge"
 
 	// Temporary experimental fields:
 	FieldIndex     = "index"
@@ -63,6 +67,10 @@ var allFields = map[string]struct{}{
 	FieldMessage:            empty,
 	"m":                     empty,
 	"msg":                   empty,
+	FieldRenamed:            empty,
+	FieldAdded:              empty,
+	FieldRemoved:            empty,
+	FieldMerge:              empty,
 	FieldIndex:              empty,
 	FieldCount:              empty,
 	FieldTimeout:            empty,
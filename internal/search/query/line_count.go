@@ -0,0 +1,44 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ParseLineCount parses the value of the added: and removed: fields, a number
+// of lines optionally prefixed by one of the comparison operators >, >=, < and
+// <=, into the inclusive range [min, max] of line counts it matches. A negative
+// max means there is no upper bound. For example, ">500" is parsed as [501, -1]
+// and "10" as [10, 10].
+func ParseLineCount(s string) (min, max int, err error) {
+	op := ""
+	for _, prefix := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(s, prefix) {
+			op = prefix
+			break
+		}
+	}
+
+	n, err := strconv.Atoi(strings.TrimPrefix(s, op))
+	if err != nil || n < 0 {
+		return 0, 0, errors.Errorf("invalid line count %q (examples: \">500\", \"<=10\", \"0\")", s)
+	}
+
+	switch op {
+	case ">=":
+		return n, -1, nil
+	case ">":
+		return n + 1, -1, nil
+	case "<=":
+		return 0, n, nil
+	case "<":
+		if n == 0 {
+			return 0, 0, errors.Errorf("invalid line count %q, no commit changes fewer than 0 lines", s)
+		}
+		return 0, n - 1, nil
+	default:
+		return n, n, nil
+	}
+}
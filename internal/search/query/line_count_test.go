@@ -0,0 +1,46 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLineCount(t *testing.T) {
+	cases := []struct {
+		input    string
+		min, max int
+	}{
+		{"10", 10, 10},
+		{"0", 0, 0},
+		{">500", 501, -1},
+		{">=500", 500, -1},
+		{"<10", 0, 9},
+		{"<=10", 0, 10},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.input, func(t *testing.T) {
+			min, max, err := ParseLineCount(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.min, min)
+			require.Equal(t, tc.max, max)
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		cases := []string{
+			"",
+			"many",
+			">",
+			"-1",
+			"<0",
+			"=>5",
+		}
+
+		for _, tc := range cases {
+			_, _, err := ParseLineCount(tc)
+			require.Error(t, err, tc)
+		}
+	})
+}
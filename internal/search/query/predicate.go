@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/filter"
 )
 
 type Predicate interface {
@@ -31,13 +33,37 @@ var DefaultPredicateRegistry = PredicateRegistry{
 		"contains.file":         func() Predicate { return &RepoContainsFilePredicate{} },
 		"contains.content":      func() Predicate { return &RepoContainsContentPredicate{} },
 		"contains.commit.after": func() Predicate { return &RepoContainsCommitAfterPredicate{} },
+		"contains.symbol":       func() Predicate { return &RepoContainsSymbolPredicate{} },
 	},
 	FieldFile: {
 		"contains.content": func() Predicate { return &FileContainsContentPredicate{} },
 		"contains":         func() Predicate { return &FileContainsContentPredicate{} },
+		"contains.symbol":  func() Predicate { return &FileContainsSymbolPredicate{} },
 	},
 }
 
+// ExpressionPredicate is implemented by predicates whose parameters may form
+// a boolean expression that cannot be planned as a single set of queries.
+type ExpressionPredicate interface {
+	Predicate
+
+	// PlanExpression generates an expression of sub-searches to evaluate
+	// instead of Plan. It returns nil if the predicate should be evaluated
+	// with Plan.
+	PlanExpression(parent Basic) (*PredicateExpression, error)
+}
+
+// PredicateExpression is a boolean expression over the sub-searches of a
+// predicate. A leaf holds the plan of one sub-search. The result sets of the
+// operands of an expression are intersected for And and united for Or.
+// Negated operands are subtracted from the result of their parent.
+type PredicateExpression struct {
+	Kind     operatorKind
+	Negated  bool
+	Plan     Plan
+	Operands []*PredicateExpression
+}
+
 // PredicateTable is a lookup map of one or more predicate names that resolve to the Predicate type.
 type PredicateTable map[string]func() Predicate
 
@@ -90,6 +116,13 @@ func (EmptyPredicate) Plan(Basic) (Plan, error) { return nil, nil }
 type RepoContainsPredicate struct {
 	File    string
 	Content string
+
+	// Expression is set instead of File and Content when the parameters
+	// combine `file:` and `content:` terms with or, not, or repeated
+	// fields, like `file:go.mod and not content:grpc`. Every term of an
+	// expression is searched separately and the resulting repositories are
+	// combined, see PlanExpression.
+	Expression []Node
 }
 
 func (f *RepoContainsPredicate) ParseParams(params string) error {
@@ -98,6 +131,14 @@ func (f *RepoContainsPredicate) ParseParams(params string) error {
 		return err
 	}
 
+	if isRepoContainsExpression(nodes) {
+		if err := validateContainsExpression(FieldRepo, nodes); err != nil {
+			return err
+		}
+		f.Expression = containsExpression(nodes)
+		return nil
+	}
+
 	for _, node := range nodes {
 		if err := f.parseNode(node); err != nil {
 			return err
@@ -180,10 +221,125 @@ func (f *RepoContainsPredicate) Plan(parent Basic) (Plan, error) {
 		})
 	}
 
+	if f.Expression != nil {
+		return nil, errors.New("`repo:contains` expressions must be planned with PlanExpression")
+	}
+
 	nodes = append(nodes, nonPredicateRepos(parent)...)
 	return ToPlan(Dnf(nodes))
 }
 
+// PlanExpression plans every `file:` and `content:` term of the expression as
+// its own sub-search for repositories, so that operators apply at the
+// repository level: `file:go.mod and not content:grpc` matches repositories
+// that contain a go.mod file and do not contain grpc in any file. It returns
+// nil if the predicate is not an expression.
+func (f *RepoContainsPredicate) PlanExpression(parent Basic) (*PredicateExpression, error) {
+	if f.Expression == nil {
+		return nil, nil
+	}
+	if len(f.Expression) == 1 {
+		return f.planExpression(parent, f.Expression[0])
+	}
+	return f.planExpression(parent, Operator{Kind: And, Operands: f.Expression})
+}
+
+func (f *RepoContainsPredicate) planExpression(parent Basic, n Node) (*PredicateExpression, error) {
+	var (
+		term    RepoContainsPredicate
+		negated bool
+	)
+	switch v := n.(type) {
+	case Operator:
+		expression := &PredicateExpression{Kind: v.Kind}
+		for _, operand := range v.Operands {
+			e, err := f.planExpression(parent, operand)
+			if err != nil {
+				return nil, err
+			}
+			expression.Operands = append(expression.Operands, e)
+		}
+		return expression, nil
+	case Parameter:
+		term.File, negated = v.Value, v.Negated
+	case Pattern:
+		term.Content, negated = v.Value, v.Negated
+	default:
+		return nil, errors.Errorf("unsupported node type %T", n)
+	}
+
+	plan, err := term.Plan(parent)
+	if err != nil {
+		return nil, err
+	}
+	return &PredicateExpression{Negated: negated, Plan: plan}, nil
+}
+
+// isRepoContainsExpression returns whether the parameters of a
+// `repo:contains()` predicate need to be planned as a boolean expression,
+// rather than as a single file and content pair.
+func isRepoContainsExpression(nodes []Node) bool {
+	fields := map[string]int{}
+	return Exists(nodes, func(node Node) bool {
+		switch v := node.(type) {
+		case Operator:
+			return v.Kind == Or
+		case Parameter:
+			fields[strings.ToLower(v.Field)]++
+			return v.Negated || fields[strings.ToLower(v.Field)] > 1
+		case Pattern:
+			return v.Negated
+		}
+		return false
+	})
+}
+
+// isFileContainsExpression returns whether the parameters of a
+// `file:contains()` predicate need to be planned as a boolean expression,
+// rather than as a single regular expression.
+func isFileContainsExpression(nodes []Node) bool {
+	return Exists(nodes, func(node Node) bool {
+		switch v := node.(type) {
+		case Operator:
+			return v.Kind == Or || v.Kind == And
+		case Parameter:
+			return true
+		case Pattern:
+			return v.Negated
+		}
+		return false
+	})
+}
+
+// containsExpression converts the parameters of a contains predicate into
+// the nodes of a sub-query: `content:` terms become regular expression
+// patterns, and whitespace-separated patterns are joined.
+func containsExpression(nodes []Node) []Node {
+	nodes = MapParameter(nodes, func(field, value string, negated bool, annotation Annotation) Node {
+		if strings.ToLower(field) == FieldContent {
+			return Pattern{
+				Value:      value,
+				Negated:    negated,
+				Annotation: Annotation{Labels: Regexp},
+			}
+		}
+		return Parameter{
+			Field:      strings.ToLower(field),
+			Value:      value,
+			Negated:    negated,
+			Annotation: annotation,
+		}
+	})
+	nodes = MapPattern(nodes, func(value string, negated bool, _ Annotation) Node {
+		return Pattern{
+			Value:      value,
+			Negated:    negated,
+			Annotation: Annotation{Labels: Regexp},
+		}
+	})
+	return substituteConcat(space)(nodes)
+}
+
 /* repo:contains.content(pattern) */
 
 type RepoContainsContentPredicate struct {
@@ -263,9 +419,21 @@ func (f *RepoContainsCommitAfterPredicate) Plan(parent Basic) (Plan, error) {
 
 type FileContainsContentPredicate struct {
 	Pattern string
+
+	// Expression is set instead of Pattern when the parameters combine
+	// patterns with and, or, or not, like `foo or bar`.
+	Expression []Node
 }
 
 func (f *FileContainsContentPredicate) ParseParams(params string) error {
+	if nodes, err := Parse(params, SearchTypeRegex); err == nil && isFileContainsExpression(nodes) {
+		if err := validateContainsExpression(FieldFile, nodes); err != nil {
+			return err
+		}
+		f.Expression = containsExpression(nodes)
+		return nil
+	}
+
 	if _, err := regexp.Compile(params); err != nil {
 		return errors.Errorf("file:contains.content argument: %w", err)
 	}
@@ -287,8 +455,81 @@ func (f *FileContainsContentPredicate) Plan(parent Basic) (Plan, error) {
 	}, Parameter{
 		Field: FieldType,
 		Value: "file",
+	})
+
+	if f.Expression != nil {
+		nodes = append(nodes, Operator{Kind: And, Operands: f.Expression})
+	} else {
+		nodes = append(nodes, Pattern{
+			Value:      f.Pattern,
+			Annotation: Annotation{Labels: Regexp},
+		})
+	}
+
+	nodes = append(nodes, nonPredicateRepos(parent)...)
+	return ToPlan(Dnf(nodes))
+}
+
+/* repo:contains.symbol(pattern) and file:contains.symbol(pattern) */
+
+// symbolParams holds the parsed arguments of the contains.symbol predicates.
+// Pattern is a regular expression matched against symbol names, and Kind
+// optionally restricts matches to a symbol kind like "function" or "class".
+type symbolParams struct {
+	Pattern string
+	Kind    string
+}
+
+// symbolKindRegexp matches a `kind:` token and the whitespace preceding it.
+var symbolKindRegexp = regexp.MustCompile(`(?i)(^|\s+)kind:(\S*)`)
+
+func (s *symbolParams) parseParams(name, params string) error {
+	kinds := symbolKindRegexp.FindAllStringSubmatch(params, -1)
+	if len(kinds) > 1 {
+		return errors.New("cannot specify kind multiple times")
+	}
+	if len(kinds) == 1 {
+		kind := strings.ToLower(kinds[0][2])
+		if _, err := filter.SelectPathFromString(filter.Symbol + "." + kind); err != nil {
+			return errors.Errorf("%s has invalid `kind` argument %q", name, kind)
+		}
+		s.Kind = kind
+	}
+
+	// Remove the kind token from the raw parameters rather than rebuilding
+	// the pattern, so that whitespace in the pattern is preserved.
+	pattern := strings.TrimSpace(symbolKindRegexp.ReplaceAllString(params, ""))
+	if pattern == "" {
+		return errors.Errorf("%s argument should contain a symbol pattern", name)
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return errors.Errorf("%s argument: %w", name, err)
+	}
+	s.Pattern = pattern
+	return nil
+}
+
+// plan returns the nodes of a symbol search for the symbol params. When a
+// kind is set, the search selects symbols of that kind, and the caller is
+// expected to reduce the resulting symbol matches to repos or files.
+func (s *symbolParams) plan(parent Basic, selectRoot string) (Plan, error) {
+	selectValue := selectRoot
+	if s.Kind != "" {
+		selectValue = filter.Symbol + "." + s.Kind
+	}
+
+	nodes := make([]Node, 0, 4)
+	nodes = append(nodes, Parameter{
+		Field: FieldSelect,
+		Value: selectValue,
+	}, Parameter{
+		Field: FieldCount,
+		Value: "99999",
+	}, Parameter{
+		Field: FieldType,
+		Value: "symbol",
 	}, Pattern{
-		Value:      f.Pattern,
+		Value:      s.Pattern,
 		Annotation: Annotation{Labels: Regexp},
 	})
 
@@ -296,6 +537,39 @@ func (f *FileContainsContentPredicate) Plan(parent Basic) (Plan, error) {
 	return ToPlan(Dnf(nodes))
 }
 
+// RepoContainsSymbolPredicate represents the `repo:contains.symbol()`
+// predicate, which filters to repos that define a symbol matching a pattern.
+// For example, `repo:contains.symbol(kind:function ^NewClient$)`.
+type RepoContainsSymbolPredicate struct {
+	symbolParams
+}
+
+func (f *RepoContainsSymbolPredicate) ParseParams(params string) error {
+	return f.parseParams("repo:contains.symbol", params)
+}
+
+func (f *RepoContainsSymbolPredicate) Field() string { return FieldRepo }
+func (f *RepoContainsSymbolPredicate) Name() string  { return "contains.symbol" }
+func (f *RepoContainsSymbolPredicate) Plan(parent Basic) (Plan, error) {
+	return f.plan(parent, filter.Repository)
+}
+
+// FileContainsSymbolPredicate represents the `file:contains.symbol()`
+// predicate, which filters to files that define a symbol matching a pattern.
+type FileContainsSymbolPredicate struct {
+	symbolParams
+}
+
+func (f *FileContainsSymbolPredicate) ParseParams(params string) error {
+	return f.parseParams("file:contains.symbol", params)
+}
+
+func (f *FileContainsSymbolPredicate) Field() string { return FieldFile }
+func (f *FileContainsSymbolPredicate) Name() string  { return "contains.symbol" }
+func (f *FileContainsSymbolPredicate) Plan(parent Basic) (Plan, error) {
+	return f.plan(parent, filter.File)
+}
+
 // nonPredicateRepos returns the repo nodes in a query that aren't predicates,
 // respecting parameters that determine repo results.
 func nonPredicateRepos(q Basic) []Node {
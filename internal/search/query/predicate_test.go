@@ -2,7 +2,11 @@ package query
 
 import (
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestRepoContainsPredicate(t *testing.T) {
@@ -19,6 +23,18 @@ func TestRepoContainsPredicate(t *testing.T) {
 			{`content`, `content:test`, &RepoContainsPredicate{Content: "test"}},
 			{`file and content`, `file:test.go content:abc`, &RepoContainsPredicate{File: "test.go", Content: "abc"}},
 			{`content and file`, `content:abc file:test.go`, &RepoContainsPredicate{File: "test.go", Content: "abc"}},
+			{`file or file`, `file:a or file:b`, &RepoContainsPredicate{Expression: []Node{
+				Operator{Kind: Or, Operands: []Node{
+					Parameter{Field: "file", Value: "a"},
+					Parameter{Field: "file", Value: "b"},
+				}},
+			}}},
+			{`file and not content`, `file:go.mod and not content:"grpc"`, &RepoContainsPredicate{Expression: []Node{
+				Operator{Kind: And, Operands: []Node{
+					Parameter{Field: "file", Value: "go.mod"},
+					Pattern{Value: "grpc", Negated: true, Annotation: Annotation{Labels: Regexp}},
+				}},
+			}}},
 		}
 
 		for _, tc := range valid {
@@ -29,8 +45,8 @@ func TestRepoContainsPredicate(t *testing.T) {
 					t.Fatalf("unexpected error: %s", err)
 				}
 
-				if !reflect.DeepEqual(tc.expected, p) {
-					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				if diff := cmp.Diff(tc.expected, p, cmpopts.IgnoreFields(Annotation{}, "Range")); diff != "" {
+					t.Fatal(diff)
 				}
 			})
 		}
@@ -42,6 +58,9 @@ func TestRepoContainsPredicate(t *testing.T) {
 			{`unsupported syntax`, `abc:test`, nil},
 			{`unnamed content`, `test`, nil},
 			{`catch invalid content regexp`, `file:foo content:([)`, nil},
+			{`only negated terms`, `not file:a or file:b`, nil},
+			{`unnamed content in expression`, `file:a or test`, nil},
+			{`invalid regexp in expression`, `file:a or content:([)`, nil},
 		}
 
 		for _, tc := range invalid {
@@ -56,6 +75,247 @@ func TestRepoContainsPredicate(t *testing.T) {
 	})
 }
 
+func TestRepoContainsPredicatePlanExpression(t *testing.T) {
+	var toString func(e *PredicateExpression) string
+	toString = func(e *PredicateExpression) string {
+		if e.Plan != nil {
+			s := e.Plan.ToParseTree().String()
+			if e.Negated {
+				return "(not " + s + ")"
+			}
+			return s
+		}
+		operands := make([]string, 0, len(e.Operands))
+		for _, operand := range e.Operands {
+			operands = append(operands, toString(operand))
+		}
+		kind := "and"
+		if e.Kind == Or {
+			kind = "or"
+		}
+		return "(" + kind + " " + strings.Join(operands, " ") + ")"
+	}
+
+	test := func(params string) string {
+		p := &RepoContainsPredicate{}
+		if err := p.ParseParams(params); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := p.Plan(Basic{}); err == nil {
+			t.Fatal("expected Plan to fail for an expression")
+		}
+		e, err := p.PlanExpression(Basic{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return toString(e)
+	}
+
+	check := func(t *testing.T, got, want string) {
+		t.Helper()
+		if got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+
+	t.Run("and of files is planned as separate searches", func(t *testing.T) {
+		check(t,
+			test(`file:go.mod and file:package.json`),
+			`(and (and "select:repo" "count:99999" "file:go.mod") (and "select:repo" "count:99999" "file:package.json"))`,
+		)
+	})
+
+	t.Run("negated content is planned as a separate search", func(t *testing.T) {
+		check(t,
+			test(`file:go.mod and not content:grpc`),
+			`(and (and "select:repo" "count:99999" "file:go.mod") (not (and "select:repo" "count:99999" "grpc")))`,
+		)
+	})
+
+	t.Run("nested or", func(t *testing.T) {
+		check(t,
+			test(`file:a (content:b or content:c)`),
+			`(and (and "select:repo" "count:99999" "file:a") (or (and "select:repo" "count:99999" "b") (and "select:repo" "count:99999" "c")))`,
+		)
+	})
+
+	t.Run("flat parameters are not an expression", func(t *testing.T) {
+		p := &RepoContainsPredicate{}
+		if err := p.ParseParams(`file:a content:b`); err != nil {
+			t.Fatal(err)
+		}
+		e, err := p.PlanExpression(Basic{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e != nil {
+			t.Fatalf("expected no expression, got %s", toString(e))
+		}
+	})
+}
+
+func TestFileContainsContentPredicate(t *testing.T) {
+	t.Run("ParseParams", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			expected *FileContainsContentPredicate
+		}
+
+		valid := []test{
+			{`pattern`, `test`, &FileContainsContentPredicate{Pattern: "test"}},
+			{`pattern with spaces`, `foo bar`, &FileContainsContentPredicate{Pattern: "foo bar"}},
+			{`regexp with groups and spaces`, `func (\w+) (Get|Set)Value\(`, &FileContainsContentPredicate{Pattern: `func (\w+) (Get|Set)Value\(`}},
+			{`or`, `foo or bar`, &FileContainsContentPredicate{Expression: []Node{
+				Operator{Kind: Or, Operands: []Node{
+					Pattern{Value: "foo", Annotation: Annotation{Labels: Regexp}},
+					Pattern{Value: "bar", Annotation: Annotation{Labels: Regexp}},
+				}},
+			}}},
+			{`and not`, `foo bar and not content:baz`, &FileContainsContentPredicate{Expression: []Node{
+				Operator{Kind: And, Operands: []Node{
+					Pattern{Value: "foo bar", Annotation: Annotation{Labels: Regexp}},
+					Pattern{Value: "baz", Negated: true, Annotation: Annotation{Labels: Regexp}},
+				}},
+			}}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileContainsContentPredicate{}
+				err := p.ParseParams(tc.params)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(tc.expected, p, cmpopts.IgnoreFields(Annotation{}, "Range")); diff != "" {
+					t.Fatal(diff)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`empty`, ``, nil},
+			{`only negated`, `not foo`, nil},
+			{`negated disjunct`, `foo or not bar`, nil},
+			{`file parameter`, `foo or file:bar`, nil},
+			{`invalid regexp`, `foo or ([)`, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileContainsContentPredicate{}
+				err := p.ParseParams(tc.params)
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		p := &FileContainsContentPredicate{}
+		if err := p.ParseParams(`foo or bar`); err != nil {
+			t.Fatal(err)
+		}
+		plan, err := p.Plan(Basic{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := plan.ToParseTree().String()
+		want := `(or (and "count:99999" "type:file" "foo") (and "count:99999" "type:file" "bar"))`
+		if got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
+
+func TestContainsSymbolPredicate(t *testing.T) {
+	t.Run("ParseParams", func(t *testing.T) {
+		type test struct {
+			name     string
+			params   string
+			expected *RepoContainsSymbolPredicate
+		}
+
+		valid := []test{
+			{`pattern`, `NewClient`, &RepoContainsSymbolPredicate{symbolParams{Pattern: "NewClient"}}},
+			{`pattern regex`, `^New(Client|Server)$`, &RepoContainsSymbolPredicate{symbolParams{Pattern: "^New(Client|Server)$"}}},
+			{`pattern and kind`, `kind:function NewClient`, &RepoContainsSymbolPredicate{symbolParams{Pattern: "NewClient", Kind: "function"}}},
+			{`kind is case insensitive`, `NewClient kind:Struct`, &RepoContainsSymbolPredicate{symbolParams{Pattern: "NewClient", Kind: "struct"}}},
+			{`kind between patterns`, `New kind:function Client`, &RepoContainsSymbolPredicate{symbolParams{Pattern: "New Client", Kind: "function"}}},
+			{`whitespace is preserved`, `foo  bar`, &RepoContainsSymbolPredicate{symbolParams{Pattern: "foo  bar"}}},
+		}
+
+		for _, tc := range valid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &RepoContainsSymbolPredicate{}
+				err := p.ParseParams(tc.params)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if !reflect.DeepEqual(tc.expected, p) {
+					t.Fatalf("expected %#v, got %#v", tc.expected, p)
+				}
+			})
+		}
+
+		invalid := []test{
+			{`empty`, ``, nil},
+			{`only kind`, `kind:function`, nil},
+			{`unknown kind`, `kind:banana NewClient`, nil},
+			{`multiple kinds`, `kind:function kind:method NewClient`, nil},
+			{`invalid regexp`, `([)`, nil},
+		}
+
+		for _, tc := range invalid {
+			t.Run(tc.name, func(t *testing.T) {
+				p := &FileContainsSymbolPredicate{}
+				err := p.ParseParams(tc.params)
+				if err == nil {
+					t.Fatal("expected error but got none")
+				}
+			})
+		}
+	})
+
+	t.Run("Plan", func(t *testing.T) {
+		parent, err := ParseRegexp(`repo:^github\.com/foo/ file:contains.symbol(kind:function NewClient) bar`)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		basic, err := ToBasicQuery(parent)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		test := func(p Predicate) string {
+			plan, err := p.Plan(basic)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return plan.ToParseTree().String()
+		}
+
+		repoPredicate := &RepoContainsSymbolPredicate{symbolParams{Pattern: "NewClient"}}
+		if got, want := test(repoPredicate), `(and "select:repo" "count:99999" "type:symbol" "repo:^github\\.com/foo/" "NewClient")`; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+
+		filePredicate := &FileContainsSymbolPredicate{symbolParams{Pattern: "NewClient"}}
+		if got, want := test(filePredicate), `(and "select:file" "count:99999" "type:symbol" "repo:^github\\.com/foo/" "NewClient")`; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+
+		filePredicate = &FileContainsSymbolPredicate{symbolParams{Pattern: "NewClient", Kind: "function"}}
+		if got, want := test(filePredicate), `(and "select:symbol.function" "count:99999" "type:symbol" "repo:^github\\.com/foo/" "NewClient")`; got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	})
+}
+
 func TestParseAsPredicate(t *testing.T) {
 	tests := []struct {
 		input  string
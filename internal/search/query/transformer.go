@@ -51,6 +51,46 @@ func SubstituteCountAll(nodes []Node) []Node {
 	})
 }
 
+// SubstituteSearchContexts replaces context: parameters that refer to
+// query-defined search contexts with the parsed query of the context. The
+// lookupQueryString callback returns the query of the search context for the
+// given context: value, or the empty string if the context is not defined by a
+// query, in which case the parameter is left untouched.
+func SubstituteSearchContexts(lookupQueryString func(contextValue string) (string, error)) step {
+	return func(nodes []Node) ([]Node, error) {
+		var topErr error
+		nodes = MapParameter(nodes, func(field, value string, negated bool, annotation Annotation) Node {
+			orig := Parameter{Field: field, Value: value, Negated: negated, Annotation: annotation}
+			if field != FieldContext || negated || topErr != nil {
+				return orig
+			}
+
+			queryString, err := lookupQueryString(value)
+			if err != nil {
+				topErr = err
+				return orig
+			}
+			if queryString == "" {
+				return orig
+			}
+
+			contextNodes, err := Run(Init(queryString, SearchTypeRegex))
+			if err != nil {
+				topErr = errors.Wrapf(err, "invalid query for search context %q", value)
+				return orig
+			}
+			if len(contextNodes) == 1 {
+				return contextNodes[0]
+			}
+			return Operator{Kind: And, Operands: contextNodes}
+		})
+		if topErr != nil {
+			return nil, topErr
+		}
+		return nodes, nil
+	}
+}
+
 var ErrBadGlobPattern = errors.New("syntax error in glob pattern")
 
 // translateCharacterClass translates character classes like [a-zA-Z].
@@ -109,6 +109,38 @@ func TestLowercaseFieldNames(t *testing.T) {
 	}
 }
 
+func TestSubstituteSearchContexts(t *testing.T) {
+	lookup := func(contextValue string) (string, error) {
+		switch contextValue {
+		case "@acme/go-services":
+			return `r:^github\.com/acme/ lang:go repo:contains.file(OWNERS)`, nil
+		case "@acme/broken":
+			return `repo:foo)`, nil
+		}
+		return "", nil
+	}
+
+	test := func(input string) string {
+		nodes, err := Run(sequence(InitRegexp(input), SubstituteSearchContexts(lookup)))
+		if err != nil {
+			return "ERROR: " + err.Error()
+		}
+		return toString(nodes)
+	}
+
+	autogold.Want("query-defined context",
+		`(and "repo:^github\\.com/acme/" "lang:go" "repo:contains.file(OWNERS)" "foo")`).
+		Equal(t, test(`context:@acme/go-services foo`))
+
+	autogold.Want("static context is untouched",
+		`(and "context:@acme/static" "foo")`).
+		Equal(t, test(`context:@acme/static foo`))
+
+	autogold.Want("invalid context query",
+		`ERROR: invalid query for search context "@acme/broken": unsupported expression. The combination of parentheses in the query have an unclear meaning. Try using the content: filter to quote patterns that contain parentheses`).
+		Equal(t, test(`context:@acme/broken foo`))
+}
+
 func TestHoist(t *testing.T) {
 	cases := []struct {
 		input      string
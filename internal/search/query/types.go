@@ -302,6 +302,10 @@ func (q Q) Fork() *YesNoOnly {
 	return q.yesNoOnlyValue(FieldFork)
 }
 
+func (q Q) Merge() *YesNoOnly {
+	return q.yesNoOnlyValue(FieldMerge)
+}
+
 func (q Q) yesNoOnlyValue(field string) *YesNoOnly {
 	var res *YesNoOnly
 	VisitField(q, field, func(value string, _ bool, _ Annotation) {
@@ -408,9 +412,16 @@ func (q Q) valueToTypedValue(field, value string, label labels) []*Value {
 	case
 		FieldAuthor,
 		FieldCommitter,
-		FieldMessage, "m", "msg":
+		FieldMessage, "m", "msg",
+		FieldRenamed:
 		return []*Value{{Regexp: parseRegexpOrPanic(field, value)}}
 
+	case
+		FieldAdded,
+		FieldRemoved,
+		FieldMerge:
+		return []*Value{{String: &value}}
+
 	case
 		FieldIndex,
 		FieldCount,
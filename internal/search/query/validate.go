@@ -280,6 +280,11 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 		return err
 	}
 
+	isValidLineCount := func() error {
+		_, _, err := ParseLineCount(value)
+		return err
+	}
+
 	satisfies := func(fns ...func() error) error {
 		for _, fn := range fns {
 			if err := fn(); err != nil {
@@ -329,12 +334,18 @@ func validateField(field, value string, negated bool, seen map[string]struct{})
 	case
 		FieldAuthor,
 		FieldCommitter,
-		FieldMessage:
+		FieldMessage,
+		FieldRenamed:
 		return satisfies(isValidRegexp)
+	case
+		FieldAdded,
+		FieldRemoved:
+		return satisfies(isNotNegated, isValidLineCount)
 	case
 		FieldIndex,
 		FieldFork,
-		FieldArchived:
+		FieldArchived,
+		FieldMerge:
 		return satisfies(isSingular, isNotNegated, isYesNoOnly)
 	case
 		FieldCount:
@@ -398,7 +409,8 @@ func validateCommitParameters(nodes []Node) error {
 	var seenCommitParam string
 	var typeCommitExists bool
 	VisitParameter(nodes, func(field, value string, _ bool, _ Annotation) {
-		if field == FieldAuthor || field == FieldBefore || field == FieldAfter || field == FieldMessage {
+		switch field {
+		case FieldAuthor, FieldBefore, FieldAfter, FieldMessage, FieldRenamed, FieldAdded, FieldRemoved, FieldMerge:
 			seenCommitParam = field
 		}
 		if field == FieldType && (value == "commit" || value == "diff") {
@@ -462,6 +474,60 @@ func validatePredicate(field, value string, negated bool) error {
 	return nil
 }
 
+// validateContainsExpression validates a boolean expression passed as the
+// parameters of a `repo:contains()` or `file:contains()` predicate. The field
+// determines which terms are allowed: repo predicates accept `file:` and
+// `content:` terms, while file predicates accept `content:` terms and patterns.
+// Every disjunct of the expression must contain at least one non-negated term,
+// since negated terms alone cannot be searched.
+func validateContainsExpression(field string, nodes []Node) error {
+	var err error
+	check := func(value string) {
+		if _, e := regexp.Compile(value); e != nil && err == nil {
+			err = errors.Errorf("`contains` predicate has invalid argument %q: %w", value, e)
+		}
+	}
+	VisitParameter(nodes, func(f, value string, _ bool, _ Annotation) {
+		switch strings.ToLower(f) {
+		case FieldFile:
+			if field != FieldRepo && err == nil {
+				err = errors.Errorf("`%s:contains` predicate does not support the %q option", field, f)
+			}
+		case FieldContent:
+		default:
+			if err == nil {
+				err = errors.Errorf("unsupported option %q", f)
+			}
+		}
+		check(value)
+	})
+	VisitPattern(nodes, func(value string, _ bool, _ Annotation) {
+		if field == FieldRepo && err == nil {
+			err = errors.Errorf(`prepend 'file:' or 'content:' to "%s" to search repositories containing files or content respectively.`, value)
+		}
+		check(value)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, disjunct := range Dnf(nodes) {
+		positive := Exists(disjunct, func(node Node) bool {
+			switch n := node.(type) {
+			case Parameter:
+				return !n.Negated
+			case Pattern:
+				return !n.Negated
+			}
+			return false
+		})
+		if !positive {
+			return errors.Errorf("`contains` predicate expression %s must contain at least one term that is not negated", toString(disjunct))
+		}
+	}
+	return nil
+}
+
 // validateRepoHasFile validates that the repohasfile parameter can be executed.
 // A query like `repohasfile:foo type:symbol patter-to-match-symbols` is
 // currently not supported.
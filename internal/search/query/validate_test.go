@@ -33,6 +33,18 @@ func TestValidation(t *testing.T) {
 			input: "lang:c lang:go lang:stephenhas9cats",
 			want:  `unknown language: "stephenhas9cats"`,
 		},
+		{
+			input: "type:diff added:lots",
+			want:  `invalid line count "lots" (examples: ">500", "<=10", "0")`,
+		},
+		{
+			input: "type:commit merge:sometimes",
+			want:  `invalid value "sometimes" for field "merge". Valid values are: yes, only, no`,
+		},
+		{
+			input: "removed:>10",
+			want:  `your query contains the field 'removed', which requires type:commit or type:diff in the query`,
+		},
 		{
 			input: "count:sedonuts",
 			want:  "field count has value sedonuts, sedonuts is not a number",
@@ -99,6 +111,18 @@ func TestValidation(t *testing.T) {
 			input: "type:symbol select:symbol.timelime",
 			want:  `invalid field "timelime" on select path "symbol.timelime"`,
 		},
+		{
+			input: "repo:contains(not file:go.mod)",
+			want:  "invalid predicate value: `contains` predicate expression \"-file:go.mod\" must contain at least one term that is not negated",
+		},
+		{
+			input: "repo:contains(file:a or lang:go)",
+			want:  `invalid predicate value: unsupported option "lang"`,
+		},
+		{
+			input: "file:contains(foo or file:bar)",
+			want:  "invalid predicate value: `file:contains` predicate does not support the \"file\" option",
+		},
 		{
 			input:      "nice try type:repo",
 			want:       "this structural search query specifies `type:` and is not supported. Structural search syntax only applies to searching file contents",
@@ -135,6 +135,11 @@ func (r *Resolver) Resolve(ctx context.Context, op search.RepoOptions) (Resolved
 	if err != nil {
 		return Resolved{}, err
 	}
+	if searchContext.Query != "" {
+		// Query-defined search contexts are substituted into the search query
+		// before repositories are resolved, see query.SubstituteSearchContexts.
+		return Resolved{}, errors.Errorf("search context %q is defined by a query and cannot be resolved to a list of repositories", op.SearchContextSpec)
+	}
 
 	options := database.ReposListOptions{
 		IncludePatterns:       includePatterns,
@@ -419,6 +424,11 @@ func (r *Resolver) Excluded(ctx context.Context, op search.RepoOptions) (ex Excl
 	if err != nil {
 		return ExcludedRepos{}, err
 	}
+	if searchContext.Query != "" {
+		// Query-defined search contexts are substituted into the search query
+		// before repositories are resolved, see query.SubstituteSearchContexts.
+		return ExcludedRepos{}, errors.Errorf("search context %q is defined by a query and cannot be resolved to a list of repositories", op.SearchContextSpec)
+	}
 
 	options := database.ReposListOptions{
 		IncludePatterns: includePatterns,
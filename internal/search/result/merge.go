@@ -39,3 +39,20 @@ func Intersect(left, right []Match) []Match {
 	}
 	return merged
 }
+
+// Subtract returns the matches in left whose key does not occur in right.
+func Subtract(left, right []Match) []Match {
+	rightKeys := make(map[Key]struct{}, len(right))
+	for _, r := range right {
+		rightKeys[r.Key()] = struct{}{}
+	}
+
+	merged := left[:0]
+	for _, l := range left {
+		if _, ok := rightKeys[l.Key()]; ok {
+			continue
+		}
+		merged = append(merged, l)
+	}
+	return merged
+}
@@ -172,3 +172,23 @@ func TestUnionMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestSubtract(t *testing.T) {
+	left := []Match{
+		repoResult("a"),
+		repoResult("b"),
+		repoResult("c"),
+		commitResult("a", "a"),
+	}
+	right := []Match{
+		repoResult("b"),
+		repoResult("d"),
+		commitResult("a", "b"),
+	}
+
+	got := resultsToString(Subtract(left, right))
+	want := "Repo:/a, Repo:/c, Commit:/a/-/commit/a"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/query"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 )
 
@@ -149,6 +150,63 @@ func validateSearchContextRepositoryRevisions(repositoryRevisions []*types.Searc
 	return nil
 }
 
+// allowedSearchContextQueryFields are the fields a search context query may
+// contain. They all restrict the set of repositories, revisions or files that are
+// searched, so the context query never contributes search patterns of its own.
+var allowedSearchContextQueryFields = map[string]struct{}{
+	query.FieldRepo:               {},
+	query.FieldRev:                {},
+	query.FieldFile:               {},
+	query.FieldLang:               {},
+	query.FieldFork:               {},
+	query.FieldArchived:           {},
+	query.FieldVisibility:         {},
+	query.FieldCase:               {},
+	query.FieldRepoHasFile:        {},
+	query.FieldRepoHasCommitAfter: {},
+}
+
+func validateSearchContextQuery(contextQuery string) error {
+	if contextQuery == "" {
+		return nil
+	}
+
+	plan, err := query.Pipeline(query.InitRegexp(contextQuery))
+	if err != nil {
+		return errors.Wrap(err, "invalid search context query")
+	}
+
+	for _, basic := range plan {
+		if basic.Pattern != nil {
+			return errors.New("search context query must not contain search patterns")
+		}
+
+		for _, parameter := range basic.Parameters {
+			if _, ok := allowedSearchContextQueryFields[parameter.Field]; !ok {
+				return errors.Errorf("search context query must not contain the %s: filter", parameter.Field)
+			}
+
+			var revisionSpecs []search.RevisionSpecifier
+			switch parameter.Field {
+			case query.FieldRepo:
+				if parameter.Annotation.Labels.IsSet(query.IsPredicate) {
+					continue
+				}
+				_, revisionSpecs = search.ParseRepositoryRevisions(parameter.Value)
+			case query.FieldRev:
+				_, revisionSpecs = search.ParseRepositoryRevisions("@" + parameter.Value)
+			}
+			for _, revisionSpec := range revisionSpecs {
+				if revision := revisionSpec.String(); len(revision) > maxRevisionLength {
+					return errors.Errorf("revision %q exceeds maximum allowed length (%d)", revision, maxRevisionLength)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func validateSearchContextDoesNotExist(ctx context.Context, db dbutil.DB, searchContext *types.SearchContext) error {
 	_, err := database.SearchContexts(db).GetSearchContext(ctx, database.GetSearchContextOptions{
 		Name:            searchContext.Name,
@@ -190,6 +248,15 @@ func CreateSearchContextWithRepositoryRevisions(ctx context.Context, db database
 		return nil, err
 	}
 
+	err = validateSearchContextQuery(searchContext.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if searchContext.Query != "" && len(repositoryRevisions) > 0 {
+		return nil, errors.New("search context query and repository revisions are mutually exclusive")
+	}
+
 	err = validateSearchContextDoesNotExist(ctx, db, searchContext)
 	if err != nil {
 		return nil, err
@@ -227,6 +294,15 @@ func UpdateSearchContextWithRepositoryRevisions(ctx context.Context, db database
 		return nil, err
 	}
 
+	err = validateSearchContextQuery(searchContext.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	if searchContext.Query != "" && len(repositoryRevisions) > 0 {
+		return nil, errors.New("search context query and repository revisions are mutually exclusive")
+	}
+
 	searchContext, err = db.SearchContexts().UpdateSearchContextWithRepositoryRevisions(ctx, searchContext, repositoryRevisions)
 	if err != nil {
 		return nil, err
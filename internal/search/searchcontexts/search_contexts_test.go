@@ -311,6 +311,38 @@ func TestSearchContextWriteAccessValidation(t *testing.T) {
 	}
 }
 
+func TestValidateSearchContextQuery(t *testing.T) {
+	tooLongRevision := strings.Repeat("x", 256)
+	tests := []struct {
+		name         string
+		contextQuery string
+		wantErr      string
+	}{
+		{name: "empty query", contextQuery: ""},
+		{name: "repo filters", contextQuery: `repo:^github\.com/acme/ -repo:archive fork:yes archived:no visibility:private`},
+		{name: "repo predicates and languages", contextQuery: `repo:^github\.com/acme/ lang:go repo:contains.file(OWNERS)`},
+		{name: "revision patterns", contextQuery: `repo:^github\.com/acme/api$@main:v1.0:*refs/heads/release/*`},
+		{name: "rev filter", contextQuery: `repo:^github\.com/acme/ rev:main`},
+		{name: "search pattern", contextQuery: `repo:^github\.com/acme/ TODO`, wantErr: "search context query must not contain search patterns"},
+		{name: "nested context", contextQuery: `context:@acme/other`, wantErr: "search context query must not contain the context: filter"},
+		{name: "result type", contextQuery: `repo:acme type:symbol`, wantErr: "search context query must not contain the type: filter"},
+		{name: "revision too long", contextQuery: "repo:acme@" + tooLongRevision, wantErr: fmt.Sprintf("revision %q exceeds maximum allowed length (255)", tooLongRevision)},
+		{name: "invalid query", contextQuery: `repo:acme fork:maybe`, wantErr: "invalid search context query"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSearchContextQuery(tt.contextQuery)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
 func TestCreatingSearchContexts(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
@@ -390,6 +422,26 @@ func TestCreatingSearchContexts(t *testing.T) {
 			},
 			wantErr: fmt.Sprintf("revision %q exceeds maximum allowed length (255)", tooLongRevision),
 		},
+		{
+			name:          "can create search context with query",
+			searchContext: &types.SearchContext{Name: "go-services", Query: `repo:^github\.com/acme/ lang:go`},
+			userID:        user1.ID,
+		},
+		{
+			name:          "cannot create search context with query and repository revisions",
+			searchContext: &types.SearchContext{Name: "ctx", Query: `repo:^github\.com/acme/`},
+			userID:        user1.ID,
+			repositoryRevisions: []*types.SearchContextRepositoryRevisions{
+				{Repo: repos[0], Revisions: []string{"HEAD"}},
+			},
+			wantErr: "search context query and repository revisions are mutually exclusive",
+		},
+		{
+			name:          "cannot create search context with search patterns in query",
+			searchContext: &types.SearchContext{Name: "ctx", Query: `repo:^github\.com/acme/ TODO`},
+			userID:        user1.ID,
+			wantErr:       "search context query must not contain search patterns",
+		},
 	}
 
 	for _, tt := range tests {
@@ -479,6 +531,15 @@ func TestUpdatingSearchContexts(t *testing.T) {
 			},
 			wantErr: "exceeds maximum allowed length (255)",
 		},
+		{
+			name:    "cannot update search context to use a query with search patterns",
+			update:  set(scs[4], func(sc *types.SearchContext) { sc.Query = "repo:acme TODO" }),
+			wantErr: "search context query must not contain search patterns",
+		},
+		{
+			name:   "update search context to use a query",
+			update: set(scs[5], func(sc *types.SearchContext) { sc.Query = "repo:acme lang:go" }),
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,211 @@
+package streaming
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// AggregationMode is the property of a match that SearchAggregator groups
+// results by.
+type AggregationMode string
+
+const (
+	// AggregationModeRepo groups matches by repository name.
+	AggregationModeRepo AggregationMode = "repo"
+
+	// AggregationModePath groups file matches by the directory of the file,
+	// qualified by its repository.
+	AggregationModePath AggregationMode = "path"
+
+	// AggregationModeAuthor groups commit and diff matches by commit author.
+	AggregationModeAuthor AggregationMode = "author"
+
+	// AggregationModeCaptureGroup groups matched lines by the first capture
+	// group of a regular expression.
+	AggregationModeCaptureGroup AggregationMode = "capture_group"
+)
+
+// ParseAggregationMode returns the AggregationMode for s.
+func ParseAggregationMode(s string) (AggregationMode, error) {
+	switch m := AggregationMode(strings.ToLower(s)); m {
+	case AggregationModeRepo, AggregationModePath, AggregationModeAuthor, AggregationModeCaptureGroup:
+		return m, nil
+	}
+	return "", errors.Errorf("unknown aggregation mode %q", s)
+}
+
+// Aggregate is the number of matches in a single group.
+type Aggregate struct {
+	// Label is the value all matches in this group share, e.g. a repository
+	// name or the text of a capture group.
+	Label string
+
+	// Count is the number of matches in this group.
+	Count int
+}
+
+// SearchAggregator groups matches flowing through a search stream by an
+// AggregationMode and counts them. Like SearchFilters it is updated with every
+// event and can be computed at any point to report incremental results.
+type SearchAggregator struct {
+	// Mode is the property matches are grouped by.
+	Mode AggregationMode
+
+	// CaptureGroup is the regular expression used to extract labels for
+	// AggregationModeCaptureGroup. Its first capture group is used as the
+	// label, or the whole match if it has no capture groups.
+	CaptureGroup *regexp.Regexp
+
+	// Limit is the maximum number of matches to count, usually the value of
+	// the query's count: parameter. Matches beyond it are not counted and
+	// the aggregation is marked approximate. Zero means no limit.
+	Limit int
+
+	counts      map[string]int
+	matchCount  int
+	approximate bool
+	dirty       bool
+}
+
+// NewSearchAggregator returns a SearchAggregator for mode. pattern is only
+// used for AggregationModeCaptureGroup, where it is required.
+func NewSearchAggregator(mode AggregationMode, pattern string) (*SearchAggregator, error) {
+	a := &SearchAggregator{Mode: mode}
+	if mode != AggregationModeCaptureGroup {
+		return a, nil
+	}
+	if pattern == "" {
+		return nil, errors.New("capture_group aggregation requires a pattern")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.Errorf("invalid aggregation pattern: %w", err)
+	}
+	a.CaptureGroup = re
+	return a, nil
+}
+
+// Update internal state for the results in event.
+func (a *SearchAggregator) Update(event SearchEvent) {
+	if a.counts == nil {
+		a.counts = make(map[string]int)
+	}
+
+	// Results are incomplete if a backend hit a limit or repos timed out, so
+	// any counts we compute can only be a lower bound.
+	if event.Stats.IsLimitHit || event.Stats.Status.Any(search.RepoStatusLimitHit|search.RepoStatusTimedout) {
+		a.approximate = true
+		a.dirty = true
+	}
+
+	for _, match := range event.Results {
+		for _, group := range a.groups(match) {
+			count := group.Count
+			if a.Limit > 0 && a.matchCount+count > a.Limit {
+				// Only count up to the limit. Everything beyond it is
+				// dropped, so the aggregation is no longer exact.
+				count = a.Limit - a.matchCount
+				a.approximate = true
+				a.dirty = true
+			}
+			if count <= 0 {
+				return
+			}
+			a.counts[group.Label] += count
+			a.matchCount += count
+			a.dirty = true
+		}
+	}
+}
+
+// groups returns the groups match belongs to, with the number of matches in
+// each group.
+func (a *SearchAggregator) groups(match result.Match) []Aggregate {
+	switch a.Mode {
+	case AggregationModeRepo:
+		return []Aggregate{{Label: string(match.RepoName().Name), Count: match.ResultCount()}}
+
+	case AggregationModePath:
+		fm, ok := match.(*result.FileMatch)
+		if !ok {
+			return nil
+		}
+		dir := path.Join(string(fm.Repo.Name), path.Dir(fm.Path)) + "/"
+		return []Aggregate{{Label: dir, Count: fm.ResultCount()}}
+
+	case AggregationModeAuthor:
+		cm, ok := match.(*result.CommitMatch)
+		if !ok {
+			return nil
+		}
+		return []Aggregate{{Label: cm.Commit.Author.Name, Count: cm.ResultCount()}}
+
+	case AggregationModeCaptureGroup:
+		// Every submatch counts as one match, so that capture group counts
+		// add up to the number of matches counted against Limit.
+		var groups []Aggregate
+		add := func(s string) {
+			for _, submatch := range a.CaptureGroup.FindAllStringSubmatch(s, -1) {
+				label := submatch[0]
+				if len(submatch) > 1 {
+					label = submatch[1]
+				}
+				groups = append(groups, Aggregate{Label: label, Count: 1})
+			}
+		}
+		switch m := match.(type) {
+		case *result.FileMatch:
+			for _, lm := range m.LineMatches {
+				add(lm.Preview)
+			}
+		case *result.CommitMatch:
+			add(m.Body.Value)
+		}
+		return groups
+	}
+	return nil
+}
+
+// MatchCount returns the number of matches counted so far. It is the sum of
+// the counts of all aggregates.
+func (a *SearchAggregator) MatchCount() int {
+	return a.matchCount
+}
+
+// Dirty returns true if the aggregation changed since the last call to
+// Compute.
+func (a *SearchAggregator) Dirty() bool {
+	return a.dirty
+}
+
+// Approximate returns true if the counts may not reflect all matches of the
+// query, either because a search limit was hit or because matches beyond
+// Limit were not counted.
+func (a *SearchAggregator) Approximate() bool {
+	return a.approximate
+}
+
+// Compute returns the aggregates ordered by descending count. Ties are
+// ordered by label.
+func (a *SearchAggregator) Compute() []Aggregate {
+	a.dirty = false
+
+	aggregates := make([]Aggregate, 0, len(a.counts))
+	for label, count := range a.counts {
+		aggregates = append(aggregates, Aggregate{Label: label, Count: count})
+	}
+	sort.Slice(aggregates, func(i, j int) bool {
+		if aggregates[i].Count != aggregates[j].Count {
+			return aggregates[i].Count > aggregates[j].Count
+		}
+		return aggregates[i].Label < aggregates[j].Label
+	})
+	return aggregates
+}
@@ -0,0 +1,225 @@
+package streaming
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestSearchAggregator(t *testing.T) {
+	repo := func(name string) types.MinimalRepo {
+		return types.MinimalRepo{ID: api.RepoID(len(name)), Name: api.RepoName(name)}
+	}
+	fileMatch := func(repoName, path string, lines ...string) *result.FileMatch {
+		fm := &result.FileMatch{File: result.File{Repo: repo(repoName), Path: path}}
+		for i, line := range lines {
+			fm.LineMatches = append(fm.LineMatches, &result.LineMatch{
+				Preview:          line,
+				LineNumber:       int32(i),
+				OffsetAndLengths: [][2]int32{{0, int32(len(line))}},
+			})
+		}
+		return fm
+	}
+	commitMatch := func(repoName, author, body string) *result.CommitMatch {
+		return &result.CommitMatch{
+			Repo:   repo(repoName),
+			Commit: gitdomain.Commit{Author: gitdomain.Signature{Name: author}},
+			Body:   result.HighlightedString{Value: body},
+		}
+	}
+
+	cases := []struct {
+		name            string
+		mode            AggregationMode
+		pattern         string
+		limit           int
+		events          []SearchEvent
+		want            []Aggregate
+		wantApproximate bool
+	}{{
+		name: "repo",
+		mode: AggregationModeRepo,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "x", "y"),
+				fileMatch("b", "main.go", "x"),
+			},
+		}, {
+			Results: []result.Match{
+				fileMatch("b", "lib.go", "x", "y", "z"),
+				commitMatch("a", "alice", "fix"),
+			},
+		}},
+		want: []Aggregate{{Label: "b", Count: 4}, {Label: "a", Count: 3}},
+	}, {
+		name: "path",
+		mode: AggregationModePath,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "cmd/main.go", "x"),
+				fileMatch("a", "cmd/util.go", "x"),
+				fileMatch("a", "README.md", "x"),
+				commitMatch("a", "alice", "fix"),
+			},
+		}},
+		want: []Aggregate{{Label: "a/cmd/", Count: 2}, {Label: "a/", Count: 1}},
+	}, {
+		name: "author",
+		mode: AggregationModeAuthor,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				commitMatch("a", "alice", "fix"),
+				commitMatch("b", "bob", "fix"),
+				commitMatch("b", "alice", "fix"),
+				fileMatch("a", "main.go", "x"),
+			},
+		}},
+		want: []Aggregate{{Label: "alice", Count: 2}, {Label: "bob", Count: 1}},
+	}, {
+		name:    "capture group",
+		mode:    AggregationModeCaptureGroup,
+		pattern: `func (\w+)`,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "func main() {", "func init() { func main() }"),
+				commitMatch("a", "alice", "+func init() {"),
+			},
+		}},
+		want: []Aggregate{{Label: "init", Count: 2}, {Label: "main", Count: 2}},
+	}, {
+		name:    "capture group without groups uses whole match",
+		mode:    AggregationModeCaptureGroup,
+		pattern: `TODO|FIXME`,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "// TODO", "// FIXME TODO"),
+			},
+		}},
+		want: []Aggregate{{Label: "TODO", Count: 2}, {Label: "FIXME", Count: 1}},
+	}, {
+		name:  "limit clamps multi-line file match",
+		mode:  AggregationModeRepo,
+		limit: 4,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "x", "y"),
+				fileMatch("b", "main.go", "x", "y", "z"),
+			},
+		}, {
+			Results: []result.Match{
+				fileMatch("c", "main.go", "x"),
+			},
+		}},
+		want:            []Aggregate{{Label: "a", Count: 2}, {Label: "b", Count: 2}},
+		wantApproximate: true,
+	}, {
+		name:    "limit clamps capture groups",
+		mode:    AggregationModeCaptureGroup,
+		pattern: `(\w+)`,
+		limit:   3,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "a b", "c d"),
+			},
+		}},
+		want:            []Aggregate{{Label: "a", Count: 1}, {Label: "b", Count: 1}, {Label: "c", Count: 1}},
+		wantApproximate: true,
+	}, {
+		name:  "limit reached exactly is not approximate",
+		mode:  AggregationModeRepo,
+		limit: 2,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "x", "y"),
+			},
+		}},
+		want: []Aggregate{{Label: "a", Count: 2}},
+	}, {
+		name: "limit hit in stats is approximate",
+		mode: AggregationModeRepo,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "x"),
+			},
+			Stats: Stats{IsLimitHit: true},
+		}},
+		want:            []Aggregate{{Label: "a", Count: 1}},
+		wantApproximate: true,
+	}, {
+		name: "timed out repo is approximate",
+		mode: AggregationModeRepo,
+		events: []SearchEvent{{
+			Results: []result.Match{
+				fileMatch("a", "main.go", "x"),
+			},
+			Stats: Stats{Status: func() search.RepoStatusMap {
+				var m search.RepoStatusMap
+				m.Update(2, search.RepoStatusTimedout)
+				return m
+			}()},
+		}},
+		want:            []Aggregate{{Label: "a", Count: 1}},
+		wantApproximate: true,
+	}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := NewSearchAggregator(tc.mode, tc.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			a.Limit = tc.limit
+
+			for _, event := range tc.events {
+				a.Update(event)
+			}
+
+			if !a.Dirty() {
+				t.Fatal("expected aggregator to be dirty after update")
+			}
+			got := a.Compute()
+			if d := cmp.Diff(tc.want, got); d != "" {
+				t.Fatalf("mismatch (-want +got):\n%s", d)
+			}
+			if a.Dirty() {
+				t.Fatal("expected aggregator to be clean after compute")
+			}
+			if got := a.Approximate(); got != tc.wantApproximate {
+				t.Fatalf("got approximate %t, want %t", got, tc.wantApproximate)
+			}
+
+			sum := 0
+			for _, g := range got {
+				sum += g.Count
+			}
+			if sum != a.MatchCount() {
+				t.Fatalf("aggregate counts sum to %d, but match count is %d", sum, a.MatchCount())
+			}
+			if tc.limit > 0 && a.MatchCount() > tc.limit {
+				t.Fatalf("match count %d exceeds limit %d", a.MatchCount(), tc.limit)
+			}
+		})
+	}
+}
+
+func TestNewSearchAggregator(t *testing.T) {
+	if _, err := NewSearchAggregator(AggregationModeCaptureGroup, ""); err == nil {
+		t.Fatal("expected error for capture_group without pattern")
+	}
+	if _, err := NewSearchAggregator(AggregationModeCaptureGroup, "("); err == nil {
+		t.Fatal("expected error for invalid pattern")
+	}
+	if _, err := ParseAggregationMode("nope"); err == nil {
+		t.Fatal("expected error for unknown mode")
+	}
+	if m, err := ParseAggregationMode("Repo"); err != nil || m != AggregationModeRepo {
+		t.Fatalf("got %q, %v", m, err)
+	}
+}
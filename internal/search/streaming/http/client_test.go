@@ -56,6 +56,15 @@ func TestFrontendClient(t *testing.T) {
 		}, {
 			Value: "filter-2",
 		}},
+	}, {
+		Name: "aggregate",
+		Value: &EventAggregate{
+			Mode: "repo",
+			Groups: []EventAggregateGroup{{
+				Label: "test",
+				Count: 2,
+			}},
+		},
 	}, {
 		Name: "alert",
 		Value: &EventAlert{
@@ -102,6 +111,9 @@ func TestFrontendClient(t *testing.T) {
 		OnFilters: func(d []*EventFilter) {
 			got = append(got, Event{Name: "filters", Value: d})
 		},
+		OnAggregate: func(d *EventAggregate) {
+			got = append(got, Event{Name: "aggregate", Value: d})
+		},
 		OnAlert: func(d *EventAlert) {
 			got = append(got, Event{Name: "alert", Value: d})
 		},
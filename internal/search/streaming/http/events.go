@@ -156,6 +156,23 @@ type EventFilter struct {
 	Kind     string `json:"kind"`
 }
 
+// EventAggregate is the number of matches grouped by the aggregation mode
+// requested with the aggregate URL parameter. It replaces when sent.
+type EventAggregate struct {
+	Mode   string                `json:"mode"`
+	Groups []EventAggregateGroup `json:"groups"`
+
+	// Approximate is true if the counts do not cover all matches, e.g. because
+	// the match limit was hit.
+	Approximate bool `json:"approximate"`
+}
+
+// EventAggregateGroup is the number of matches sharing Label.
+type EventAggregateGroup struct {
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
 // EventAlert is GQL.SearchAlert. It replaces when sent to match existing
 // behaviour.
 type EventAlert struct {
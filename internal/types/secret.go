@@ -103,13 +103,26 @@ func redactionInfo(cfg interface{}) ([]jsonStringField, error) {
 		return []jsonStringField{}, nil
 	case *schema.JVMPackagesConnection:
 		return []jsonStringField{{[]string{"maven", "credentials"}, &cfg.Maven.Credentials}}, nil
+	case *schema.NpmPackagesConnection:
+		if cfg.Credentials != "" {
+			return []jsonStringField{{[]string{"credentials"}, &cfg.Credentials}}, nil
+		}
+		return []jsonStringField{}, nil
+	case *schema.GoModulesConnection:
+		return []jsonStringField{}, nil
 	case *schema.PagureConnection:
 		if cfg.Token != "" {
 			return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
 		}
 		return []jsonStringField{}, nil
+	case *schema.GiteaConnection:
+		return []jsonStringField{{[]string{"token"}, &cfg.Token}}, nil
 	case *schema.OtherExternalServiceConnection:
 		return []jsonStringField{{[]string{"url"}, &cfg.Url}}, nil
+	case *schema.MercurialConnection:
+		return []jsonStringField{{[]string{"url"}, &cfg.Url}}, nil
+	case *schema.SubversionConnection:
+		return []jsonStringField{{[]string{"url"}, &cfg.Url}}, nil
 	default:
 		// return an error; it's safer to fail than to incorrectly return unsafe data.
 		return nil, errors.Errorf("Unrecognized ExternalServiceConfig for redaction: kind %+v not implemented", reflect.TypeOf(cfg))
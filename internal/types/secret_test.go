@@ -67,13 +67,29 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 			Dependencies: []string{"placeholder"},
 		},
 	}
+	npmPackagesConfig := schema.NpmPackagesConnection{
+		Registry:    "https://registry.npmjs.org",
+		Credentials: someSecret,
+	}
 	pagureConfig := schema.PagureConnection{
 		Url: "https://src.fedoraproject.org",
 	}
+	giteaConfig := schema.GiteaConnection{
+		Url:   "https://gitea.example.com",
+		Token: someSecret,
+	}
 	otherConfig := schema.OtherExternalServiceConnection{
 		Url:                   someSecret,
 		RepositoryPathPattern: "foo",
 	}
+	mercurialConfig := schema.MercurialConnection{
+		Url:                   someSecret,
+		RepositoryPathPattern: "foo",
+	}
+	subversionConfig := schema.SubversionConnection{
+		Url:                   someSecret,
+		RepositoryPathPattern: "foo",
+	}
 	var tc = []struct {
 		kind      string
 		config    interface{}               // the config for the service kind
@@ -130,12 +146,22 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 			config:    &jvmPackagesConfig,
 			editField: func(cfg interface{}) *string { return &cfg.(*schema.JVMPackagesConnection).Maven.Dependencies[0] },
 		},
+		{
+			kind:      extsvc.KindNpmPackages,
+			config:    &npmPackagesConfig,
+			editField: func(cfg interface{}) *string { return &cfg.(*schema.NpmPackagesConnection).Registry },
+		},
 		{
 			// Unlike the other test cases, this test covers skipping redaction of missing optional fields.
 			kind:      extsvc.KindPagure,
 			config:    &pagureConfig,
 			editField: func(cfg interface{}) *string { return &cfg.(*schema.PagureConnection).Pattern },
 		},
+		{
+			kind:      extsvc.KindGitea,
+			config:    &giteaConfig,
+			editField: func(cfg interface{}) *string { return &cfg.(*schema.GiteaConnection).Url },
+		},
 		{
 			kind:   extsvc.KindOther,
 			config: &otherConfig,
@@ -143,6 +169,20 @@ func TestRoundTripRedactExternalServiceConfig(t *testing.T) {
 				return &cfg.(*schema.OtherExternalServiceConnection).RepositoryPathPattern
 			},
 		},
+		{
+			kind:   extsvc.KindMercurial,
+			config: &mercurialConfig,
+			editField: func(cfg interface{}) *string {
+				return &cfg.(*schema.MercurialConnection).RepositoryPathPattern
+			},
+		},
+		{
+			kind:   extsvc.KindSubversion,
+			config: &subversionConfig,
+			editField: func(cfg interface{}) *string {
+				return &cfg.(*schema.SubversionConnection).RepositoryPathPattern
+			},
+		},
 	}
 	for _, c := range tc {
 		t.Run(c.kind, func(t *testing.T) {
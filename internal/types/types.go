@@ -1227,6 +1227,11 @@ type SearchContext struct {
 	NamespaceUserID int32 // if non-zero, the owner is this user. NamespaceUserID/NamespaceOrgID are mutually exclusive.
 	NamespaceOrgID  int32 // if non-zero, the owner is this organization. NamespaceUserID/NamespaceOrgID are mutually exclusive.
 	UpdatedAt       time.Time
+	// Query, if non-empty, is a search query that defines the repositories (and
+	// optionally revisions) of the search context. It is substituted into search
+	// queries that refer to the context. Query-defined search contexts have no
+	// static repository revisions.
+	Query string
 
 	// We cache namespace names to avoid separate database lookups when constructing the search context spec
 
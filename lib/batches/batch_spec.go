@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/gobwas/glob"
 	"github.com/hashicorp/go-multierror"
 
 	"github.com/sourcegraph/sourcegraph/lib/batches/env"
@@ -32,11 +33,13 @@ import (
 type BatchSpec struct {
 	Name              string                   `json:"name,omitempty" yaml:"name"`
 	Description       string                   `json:"description,omitempty" yaml:"description"`
+	AutoRebase        bool                     `json:"autoRebase,omitempty" yaml:"autoRebase,omitempty"`
 	On                []OnQueryOrRepository    `json:"on,omitempty" yaml:"on"`
 	Workspaces        []WorkspaceConfiguration `json:"workspaces,omitempty"  yaml:"workspaces"`
 	Steps             []Step                   `json:"steps,omitempty" yaml:"steps"`
 	TransformChanges  *TransformChanges        `json:"transformChanges,omitempty" yaml:"transformChanges,omitempty"`
 	ImportChangesets  []ImportChangeset        `json:"importChangesets,omitempty" yaml:"importChangesets"`
+	Stages            []Stage                  `json:"stages,omitempty" yaml:"stages"`
 	ChangesetTemplate *ChangesetTemplate       `json:"changesetTemplate,omitempty" yaml:"changesetTemplate"`
 }
 
@@ -46,6 +49,12 @@ type ChangesetTemplate struct {
 	Branch    string                       `json:"branch,omitempty" yaml:"branch"`
 	Commit    ExpandedGitCommitDescription `json:"commit,omitempty" yaml:"commit"`
 	Published *overridable.BoolOrString    `json:"published" yaml:"published"`
+
+	Labels     *overridable.StringList `json:"labels,omitempty" yaml:"labels"`
+	Reviewers  *overridable.StringList `json:"reviewers,omitempty" yaml:"reviewers"`
+	Assignees  *overridable.StringList `json:"assignees,omitempty" yaml:"assignees"`
+	Milestone  *overridable.String     `json:"milestone,omitempty" yaml:"milestone"`
+	BaseBranch *overridable.String     `json:"baseBranch,omitempty" yaml:"baseBranch"`
 }
 
 type GitCommitAuthor struct {
@@ -206,6 +215,17 @@ func parseBatchSpec(schema string, data []byte, opts ParseBatchSpecOptions) (*Ba
 		}
 	}
 
+	for i, stage := range spec.Stages {
+		for _, pattern := range stage.Repositories {
+			if _, err := glob.Compile(pattern); err != nil {
+				errs = multierror.Append(errs, NewValidationError(errors.Errorf(
+					"stage %d in batch spec includes an invalid repository pattern %q: %s",
+					i+1, pattern, err,
+				)))
+			}
+		}
+	}
+
 	return &spec, errs.ErrorOrNil()
 }
 
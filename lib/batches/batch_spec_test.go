@@ -2,6 +2,7 @@ package batches
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -243,6 +244,39 @@ changesetTemplate:
 			t.Fatalf("wrong error. want=%q, have=%q", wantErr, haveErr)
 		}
 	})
+
+	t.Run("uses invalid stage pattern", func(t *testing.T) {
+		const spec = `
+name: hello-world
+description: Add Hello World to READMEs
+on:
+  - repositoriesMatchingQuery: file:README.md
+steps:
+  - run: echo Hello World | tee -a $(find -name README.md)
+    container: alpine:3
+stages:
+  - repositories: [github.com/sourcegraph/go-diff]
+  - repositories: ["github.com/sourcegraph/["]
+
+changesetTemplate:
+  title: Hello World
+  body: My first batch change!
+  branch: hello-world
+  commit:
+    message: Append Hello World to all README.md files
+  published: false
+`
+
+		_, err := ParseBatchSpec([]byte(spec), ParseBatchSpecOptions{})
+		if err == nil {
+			t.Fatal("no error returned")
+		}
+
+		wantErr := "stage 2 in batch spec includes an invalid repository pattern \"github.com/sourcegraph/[\""
+		if haveErr := err.Error(); !strings.Contains(haveErr, wantErr) {
+			t.Fatalf("wrong error. want=%q, have=%q", wantErr, haveErr)
+		}
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
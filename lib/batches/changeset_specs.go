@@ -117,7 +117,19 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 			return nil, errOptionalPublishedUnsupported
 		}
 
-		return &ChangesetSpec{
+		// The steps ran on the base branch of the workspace, so the diff can
+		// only be applied to that branch.
+		if input.Template.BaseBranch != nil {
+			baseBranch := input.Template.BaseBranch.ValueWithSuffix(input.Repository.Name, branch)
+			if baseBranch != "" && git.EnsureRefPrefix(baseBranch) != git.EnsureRefPrefix(input.Repository.BaseRef) {
+				return nil, NewValidationError(errors.Errorf(
+					"base branch %q of repository %s doesn't match the branch %q the steps ran on",
+					baseBranch, input.Repository.Name, strings.TrimPrefix(input.Repository.BaseRef, "refs/heads/"),
+				))
+			}
+		}
+
+		spec := &ChangesetSpec{
 			BaseRepository: input.Repository.ID,
 			HeadRepository: input.Repository.ID,
 			BaseRef:        input.Repository.BaseRef,
@@ -135,7 +147,22 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, features ChangesetSpecFeatur
 				},
 			},
 			Published: PublishedValue{Val: published},
-		}, nil
+		}
+
+		if input.Template.Labels != nil {
+			spec.Labels = input.Template.Labels.ValueWithSuffix(input.Repository.Name, branch)
+		}
+		if input.Template.Reviewers != nil {
+			spec.Reviewers = input.Template.Reviewers.ValueWithSuffix(input.Repository.Name, branch)
+		}
+		if input.Template.Assignees != nil {
+			spec.Assignees = input.Template.Assignees.ValueWithSuffix(input.Repository.Name, branch)
+		}
+		if input.Template.Milestone != nil {
+			spec.Milestone = input.Template.Milestone.ValueWithSuffix(input.Repository.Name, branch)
+		}
+
+		return spec, nil
 	}
 
 	var specs []*ChangesetSpec
@@ -172,6 +172,35 @@ func TestCreateChangesetSpecs(t *testing.T) {
 			},
 			wantErr: "",
 		},
+		{
+			name: "code host metadata",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.Published = parsePublishedFieldString(t, "false")
+				input.Template.Labels = parseStringListFieldString(t, `[{"*": ["batch-change"]}, {"github.com/sourcegraph/*": ["batch-change", "sourcegraph"]}]`)
+				input.Template.Reviewers = parseStringListFieldString(t, `["alice", "sourcegraph/batchers"]`)
+				input.Template.Assignees = parseStringListFieldString(t, `[{"github.com/other/*": ["bob"]}]`)
+				input.Template.Milestone = parseStringFieldString(t, `"v1.0"`)
+				input.Template.BaseBranch = parseStringFieldString(t, `[{"github.com/sourcegraph/*@my-branch": "my-cool-base-ref"}]`)
+			}),
+			features: featuresAllEnabled,
+			want: []*ChangesetSpec{
+				specWith(defaultChangesetSpec, func(s *ChangesetSpec) {
+					s.Labels = []string{"batch-change", "sourcegraph"}
+					s.Reviewers = []string{"alice", "sourcegraph/batchers"}
+					s.Milestone = "v1.0"
+				}),
+			},
+			wantErr: "",
+		},
+		{
+			name: "base branch the steps didn't run on",
+			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
+				input.Template.BaseBranch = parseStringFieldString(t, `[{"github.com/sourcegraph/*@my-branch": "develop"}]`)
+			}),
+			features: featuresAllEnabled,
+			want:     nil,
+			wantErr:  `base branch "develop" of repository github.com/sourcegraph/src-cli doesn't match the branch "my-cool-base-ref" the steps ran on`,
+		},
 		{
 			name: "publish in UI on an unsupported version",
 			input: inputWith(defaultInput, func(input *ChangesetSpecInput) {
@@ -373,3 +402,23 @@ func parsePublishedFieldString(t *testing.T, input string) *overridable.BoolOrSt
 	}
 	return &result
 }
+
+func parseStringListFieldString(t *testing.T, input string) *overridable.StringList {
+	t.Helper()
+
+	var result overridable.StringList
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("failed to parse %q as overridable.StringList: %s", input, err)
+	}
+	return &result
+}
+
+func parseStringFieldString(t *testing.T, input string) *overridable.String {
+	t.Helper()
+
+	var result overridable.String
+	if err := json.Unmarshal([]byte(input), &result); err != nil {
+		t.Fatalf("failed to parse %q as overridable.String: %s", input, err)
+	}
+	return &result
+}
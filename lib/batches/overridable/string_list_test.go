@@ -0,0 +1,119 @@
+package overridable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestStringListValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		def   StringList
+		input string
+		want  []string
+	}{
+		"wildcard": {
+			def:   StringList{rules: rules{{pattern: allPattern, value: []string{"a", "b"}}}},
+			input: "foo",
+			want:  []string{"a", "b"},
+		},
+		"list exhausted": {
+			def:   StringList{rules: rules{{pattern: "bar*", value: []string{"a"}}}},
+			input: "foo",
+			want:  nil,
+		},
+		"multiple matches": {
+			def: StringList{rules: rules{
+				{pattern: allPattern, value: []string{"a"}},
+				{pattern: "bar*", value: []string{}},
+			}},
+			input: "bar",
+			want:  []string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := initRules(tc.def.rules); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.want, tc.def.Value(tc.input)); diff != "" {
+				t.Errorf("unexpected value (-want +have):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStringListMarshalJSON(t *testing.T) {
+	sl := StringList{rules: rules{
+		{pattern: allPattern, value: []string{"a"}},
+		{pattern: "bar*", value: []string{"b", "c"}},
+	}}
+	data, err := json.Marshal(&sl)
+	if err != nil {
+		t.Errorf("unexpected non-nil error: %v", err)
+	}
+	if have, want := string(data), `[{"*":["a"]},{"bar*":["b","c"]}]`; have != want {
+		t.Errorf("unexpected JSON: have=%q want=%q", have, want)
+	}
+}
+
+func TestStringListUnmarshal(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			json string
+			yaml string
+			want StringList
+		}{
+			"single": {
+				json: `["a","b"]`,
+				yaml: `[a, b]`,
+				want: StringList{rules: rules{{pattern: allPattern, value: []string{"a", "b"}}}},
+			},
+			"list": {
+				json: `[{"*":["a"]},{"bar*@feature":["b","c"]}]`,
+				yaml: "- \"*\": [a]\n- bar*@feature: [b, c]",
+				want: StringList{rules: rules{
+					{pattern: allPattern, value: []string{"a"}},
+					{pattern: "bar*", patternSuffix: "feature", value: []string{"b", "c"}},
+				}},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var have StringList
+				if err := json.Unmarshal([]byte(tc.json), &have); err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+				if diff := cmp.Diff(&have, &tc.want); diff != "" {
+					t.Errorf("unexpected StringList from JSON: %s", diff)
+				}
+
+				have = StringList{}
+				if err := yaml.Unmarshal([]byte(tc.yaml), &have); err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+				if diff := cmp.Diff(&have, &tc.want); diff != "" {
+					t.Errorf("unexpected StringList from YAML: %s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, in := range map[string]string{
+			"empty object":      `[{}]`,
+			"too many fields":   `[{"foo": ["a"],"bar": ["b"]}]`,
+			"invalid glob":      `[{"[": ["a"]}]`,
+			"not a list":        `[{"foo": "a"}]`,
+			"not a string list": `[{"foo": [1]}]`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				var have StringList
+				if err := json.Unmarshal([]byte(in), &have); err == nil {
+					t.Error("unexpected nil error")
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,151 @@
+package overridable
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gopkg.in/yaml.v2"
+)
+
+func TestStringValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		def   String
+		input string
+		want  string
+	}{
+		"wildcard": {
+			def:   String{rules: rules{{pattern: allPattern, value: "main"}}},
+			input: "foo",
+			want:  "main",
+		},
+		"list exhausted": {
+			def:   String{rules: rules{{pattern: "bar*", value: "main"}}},
+			input: "foo",
+			want:  "",
+		},
+		"multiple matches": {
+			def: String{rules: rules{
+				{pattern: allPattern, value: "main"},
+				{pattern: "bar*", value: "develop"},
+			}},
+			input: "bar",
+			want:  "develop",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := initRules(tc.def.rules); err != nil {
+				t.Fatal(err)
+			}
+
+			if have := tc.def.Value(tc.input); have != tc.want {
+				t.Errorf("unexpected value: have=%q want=%q", have, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringValueWithSuffix(t *testing.T) {
+	def := String{rules: rules{
+		{pattern: allPattern, value: "main"},
+		{pattern: "bar*", value: "develop", patternSuffix: "feature"},
+	}}
+	if err := initRules(def.rules); err != nil {
+		t.Fatal(err)
+	}
+
+	if have, want := def.ValueWithSuffix("bar", "feature"), "develop"; have != want {
+		t.Errorf("unexpected value: have=%q want=%q", have, want)
+	}
+	if have, want := def.ValueWithSuffix("bar", "other"), "main"; have != want {
+		t.Errorf("unexpected value: have=%q want=%q", have, want)
+	}
+}
+
+func TestStringMarshalJSON(t *testing.T) {
+	s := String{rules: rules{
+		{pattern: allPattern, value: "main"},
+		{pattern: "bar*", value: "develop"},
+	}}
+	data, err := json.Marshal(&s)
+	if err != nil {
+		t.Errorf("unexpected non-nil error: %v", err)
+	}
+	if have, want := string(data), `[{"*":"main"},{"bar*":"develop"}]`; have != want {
+		t.Errorf("unexpected JSON: have=%q want=%q", have, want)
+	}
+}
+
+func TestStringUnmarshal(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			json string
+			yaml string
+			want String
+		}{
+			"single": {
+				json: `"main"`,
+				yaml: `main`,
+				want: String{rules: rules{{pattern: allPattern, value: "main"}}},
+			},
+			"list": {
+				json: `[{"*":"main"},{"bar*":"develop"}]`,
+				yaml: "- \"*\": main\n- bar*: develop",
+				want: String{rules: rules{
+					{pattern: allPattern, value: "main"},
+					{pattern: "bar*", value: "develop"},
+				}},
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				var have String
+				if err := json.Unmarshal([]byte(tc.json), &have); err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+				if diff := cmp.Diff(&have, &tc.want); diff != "" {
+					t.Errorf("unexpected String from JSON: %s", diff)
+				}
+
+				have = String{}
+				if err := yaml.Unmarshal([]byte(tc.yaml), &have); err != nil {
+					t.Errorf("unexpected non-nil error: %v", err)
+				}
+				if diff := cmp.Diff(&have, &tc.want); diff != "" {
+					t.Errorf("unexpected String from YAML: %s", diff)
+				}
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, in := range map[string]string{
+			"empty object":    `[{}]`,
+			"too many fields": `[{"foo": "a","bar": "b"}]`,
+			"invalid glob":    `[{"[": "a"}]`,
+			"not a string":    `[{"foo": true}]`,
+		} {
+			t.Run(name, func(t *testing.T) {
+				var have String
+				if err := json.Unmarshal([]byte(in), &have); err == nil {
+					t.Error("unexpected nil error")
+				}
+			})
+		}
+	})
+}
+
+// initRules ensures all rules are compiled.
+func initRules(r rules) (err error) {
+	for i, rule := range r {
+		if rule.compiled == nil {
+			suffix := rule.patternSuffix
+			r[i], err = newRule(rule.pattern, rule.value)
+			if err != nil {
+				return err
+			}
+			r[i].patternSuffix = suffix
+		}
+	}
+
+	return nil
+}
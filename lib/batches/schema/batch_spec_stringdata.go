@@ -21,6 +21,11 @@ const BatchSpecJSON = `{
       "type": "string",
       "description": "The description of the batch change."
     },
+    "autoRebase": {
+      "type": "boolean",
+      "description": "Whether to automatically re-execute the workspace of a changeset against the latest revision of its base branch and force-push the result when the code host reports the changeset as conflicting or behind. Only supported for batch changes executed server-side.",
+      "default": false
+    },
     "on": {
       "type": ["array", "null"],
       "description": "The set of repositories (and branches) to run the batch change on, specified as a list of search queries (that match repositories) and/or specific repositories.",
@@ -285,6 +290,28 @@ const BatchSpecJSON = `{
         }
       }
     },
+    "stages": {
+      "type": ["array", "null"],
+      "description": "Ordered stages of repositories, used to roll out changes that depend on each other. Changesets in repositories of a stage are only published once all changesets in repositories of the previous stages are merged. Changesets in repositories that don't belong to any stage are published without waiting.",
+      "items": {
+        "title": "Stage",
+        "description": "A stage of repositories whose changesets are published together.",
+        "type": "object",
+        "additionalProperties": false,
+        "required": ["repositories"],
+        "properties": {
+          "repositories": {
+            "type": "array",
+            "description": "Glob patterns matching the names of the repositories in this stage. A repository belongs to the first stage it matches.",
+            "minItems": 1,
+            "items": {
+              "type": "string"
+            },
+            "examples": [["github.com/sourcegraph/go-diff"], ["github.com/sourcegraph/*"]]
+          }
+        }
+      }
+    },
     "changesetTemplate": {
       "type": "object",
       "description": "A template describing how to create (and update) changesets with the file changes produced by the command steps.",
@@ -374,6 +401,122 @@ const BatchSpecJSON = `{
               }
             }
           ]
+        },
+        "labels": {
+          "description": "The labels to apply to the changeset on the code host. Labels that don't exist yet are created on GitLab, but must already exist on GitHub. Not supported on Bitbucket Server.",
+          "oneOf": [
+            {
+              "type": "array",
+              "description": "A single list used for all repositories in the batch change.",
+              "items": { "type": "string" }
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value will be used for matching repositories.",
+                "additionalProperties": {
+                  "type": "array",
+                  "items": { "type": "string" }
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "reviewers": {
+          "description": "The usernames of the users requested to review the changeset. On GitHub, teams can be requested with \"org/team\".",
+          "oneOf": [
+            {
+              "type": "array",
+              "description": "A single list used for all repositories in the batch change.",
+              "items": { "type": "string" }
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value will be used for matching repositories.",
+                "additionalProperties": {
+                  "type": "array",
+                  "items": { "type": "string" }
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "assignees": {
+          "description": "The usernames of the users to assign the changeset to. Not supported on Bitbucket Server.",
+          "oneOf": [
+            {
+              "type": "array",
+              "description": "A single list used for all repositories in the batch change.",
+              "items": { "type": "string" }
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value will be used for matching repositories.",
+                "additionalProperties": {
+                  "type": "array",
+                  "items": { "type": "string" }
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "milestone": {
+          "description": "The title of an open milestone to add the changeset to. Not supported on Bitbucket Server.",
+          "oneOf": [
+            {
+              "type": "string",
+              "description": "A single value used for all repositories in the batch change."
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value will be used for matching repositories.",
+                "additionalProperties": {
+                  "type": "string"
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
+        },
+        "baseBranch": {
+          "description": "The name of the branch the changeset should be merged into, instead of the default branch of the repository.",
+          "oneOf": [
+            {
+              "type": "string",
+              "description": "A single value used for all repositories in the batch change."
+            },
+            {
+              "type": "array",
+              "description": "A list of glob patterns to match repository names. In the event multiple patterns match, the last matching pattern in the list will be used.",
+              "items": {
+                "type": "object",
+                "description": "An object with one field: the key is the glob pattern to match against repository names; the value will be used for matching repositories.",
+                "additionalProperties": {
+                  "type": "string"
+                },
+                "minProperties": 1,
+                "maxProperties": 1
+              }
+            }
+          ]
         }
       }
     }
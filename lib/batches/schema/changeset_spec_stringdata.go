@@ -95,6 +95,25 @@ const ChangesetSpecJSON = `{
         "published": {
           "oneOf": [{ "type": "boolean" }, { "type": "string", "pattern": "^draft$" }, { "type": "null" }],
           "description": "Whether to publish the changeset. An unpublished changeset can be previewed on Sourcegraph by any person who can view the batch change, but its commit, branch, and pull request aren't created on the code host. A published changeset results in a commit, branch, and pull request being created on the code host."
+        },
+        "labels": {
+          "type": "array",
+          "description": "The labels to apply to the changeset on the code host.",
+          "items": { "type": "string" }
+        },
+        "reviewers": {
+          "type": "array",
+          "description": "The usernames (or, on GitHub, \"org/team\" slugs) of the users requested to review the changeset on the code host.",
+          "items": { "type": "string" }
+        },
+        "assignees": {
+          "type": "array",
+          "description": "The usernames of the users the changeset is assigned to on the code host.",
+          "items": { "type": "string" }
+        },
+        "milestone": {
+          "type": "string",
+          "description": "The title of the milestone to add the changeset to on the code host."
         }
       },
       "required": ["baseRepository", "baseRef", "baseRev", "headRepository", "headRef", "title", "body", "commits"],
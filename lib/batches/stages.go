@@ -0,0 +1,35 @@
+package batches
+
+import "github.com/gobwas/glob"
+
+// Stage is a set of repositories whose changesets are only published once
+// all changesets in the repositories of the previous stages are merged.
+type Stage struct {
+	Repositories []string `json:"repositories,omitempty" yaml:"repositories"`
+}
+
+// Matches returns true if any of the repository patterns of the stage match
+// the given repository name. Invalid patterns never match.
+func (s *Stage) Matches(repoName string) bool {
+	for _, pattern := range s.Repositories {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			continue
+		}
+		if g.Match(repoName) {
+			return true
+		}
+	}
+	return false
+}
+
+// StageForRepository returns the index of the first stage matching the given
+// repository name, or -1 if the repository doesn't belong to any stage.
+func (s *BatchSpec) StageForRepository(repoName string) int {
+	for i := range s.Stages {
+		if s.Stages[i].Matches(repoName) {
+			return i
+		}
+	}
+	return -1
+}
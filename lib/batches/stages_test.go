@@ -0,0 +1,25 @@
+package batches
+
+import "testing"
+
+func TestStageForRepository(t *testing.T) {
+	spec := &BatchSpec{Stages: []Stage{
+		{Repositories: []string{"github.com/sourcegraph/go-diff"}},
+		{Repositories: []string{"github.com/sourcegraph/*", "gitlab.com/sourcegraph/*"}},
+	}}
+
+	for repo, want := range map[string]int{
+		"github.com/sourcegraph/go-diff":     0,
+		"github.com/sourcegraph/sourcegraph": 1,
+		"gitlab.com/sourcegraph/src-cli":     1,
+		"github.com/golang/go":               -1,
+	} {
+		if have := spec.StageForRepository(repo); have != want {
+			t.Errorf("unexpected stage for %q: have=%d want=%d", repo, have, want)
+		}
+	}
+
+	if have := (&BatchSpec{}).StageForRepository("github.com/sourcegraph/go-diff"); have != -1 {
+		t.Errorf("unexpected stage without stages: %d", have)
+	}
+}
@@ -0,0 +1,68 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func DotNetPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		extensionPattern(rawPattern("sln")),
+		extensionPattern(rawPattern("csproj")),
+	}
+}
+
+const scipDotNetImage = "sourcegraph/scip-dotnet:autoindex"
+
+// InferDotNetIndexJobs schedules an index job for each directory containing a solution
+// file. Projects which are not part of a solution in an ancestor directory are indexed
+// on their own.
+func InferDotNetIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	solutionRoots := projectRoots(paths, isDotNetSolutionPath, segmentBlockList)
+
+	var projectPaths []string
+outer:
+	for _, path := range paths {
+		if !isDotNetProjectPath(path) {
+			continue
+		}
+		for _, dir := range ancestorDirs(path) {
+			if contains(solutionRoots, dir) {
+				continue outer
+			}
+		}
+
+		projectPaths = append(projectPaths, path)
+	}
+	standaloneRoots := projectRoots(projectPaths, isDotNetProjectPath, segmentBlockList)
+
+	for _, roots := range [][]string{solutionRoots, standaloneRoots} {
+		for _, root := range roots {
+			indexes = append(indexes, config.IndexJob{
+				Steps: []config.DockerStep{
+					{
+						Root:     root,
+						Image:    scipDotNetImage,
+						Commands: []string{"dotnet restore"},
+					},
+				},
+				Root:        root,
+				Indexer:     scipDotNetImage,
+				IndexerArgs: []string{"scip-dotnet", "index"},
+				Outfile:     "index.scip",
+			})
+		}
+	}
+
+	return indexes
+}
+
+func isDotNetSolutionPath(path string) bool {
+	return filepath.Ext(path) == ".sln"
+}
+
+func isDotNetProjectPath(path string) bool {
+	return filepath.Ext(path) == ".csproj"
+}
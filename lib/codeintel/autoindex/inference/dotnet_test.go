@@ -0,0 +1,87 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestDotNetPatterns(t *testing.T) {
+	testLangPatterns(t, DotNetPatterns(), []PathTestCase{
+		{"App.sln", true},
+		{"src/App/App.csproj", true},
+		{"src/App/Program.cs", false},
+		{"App.sln.DotSettings", false},
+	})
+}
+
+func TestInferDotNetIndexJobs(t *testing.T) {
+	paths := []string{
+		"App.sln",
+		"src/App/App.csproj",
+		"src/App.Core/App.Core.csproj",
+		"tests/App.Tests/App.Tests.csproj",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    scipDotNetImage,
+					Commands: []string{"dotnet restore"},
+				},
+			},
+			Root:        "",
+			Indexer:     scipDotNetImage,
+			IndexerArgs: []string{"scip-dotnet", "index"},
+			Outfile:     "index.scip",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferDotNetIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferDotNetIndexJobsStandaloneProjects(t *testing.T) {
+	paths := []string{
+		"server/Server.sln",
+		"server/Api/Api.csproj",
+		"tools/Migrate/Migrate.csproj",
+		"tests/Server.Tests/Server.Tests.csproj",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "server",
+					Image:    scipDotNetImage,
+					Commands: []string{"dotnet restore"},
+				},
+			},
+			Root:        "server",
+			Indexer:     scipDotNetImage,
+			IndexerArgs: []string{"scip-dotnet", "index"},
+			Outfile:     "index.scip",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "tools/Migrate",
+					Image:    scipDotNetImage,
+					Commands: []string{"dotnet restore"},
+				},
+			},
+			Root:        "tools/Migrate",
+			Indexer:     scipDotNetImage,
+			IndexerArgs: []string{"scip-dotnet", "index"},
+			Outfile:     "index.scip",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferDotNetIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
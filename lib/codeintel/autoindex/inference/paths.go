@@ -1,6 +1,9 @@
 package inference
 
-import "path/filepath"
+import (
+	"path/filepath"
+	"sort"
+)
 
 // dirWithoutDot returns the directory name of the given path. Unlike filepath.Dir,
 // this function will return an empty string (instead of a `.`) to indicate an empty
@@ -25,6 +28,36 @@ func ancestorDirs(path string) (ancestors []string) {
 	return ancestors
 }
 
+// projectRoots returns the directories containing a path matched by isProjectFile. Paths
+// containing one of the given blocked segments are ignored, as are directories nested
+// within another project root, as the indexer invoked on the outer root covers them.
+// The returned directories are sorted.
+func projectRoots(paths []string, isProjectFile func(path string) bool, blockList []string) []string {
+	dirs := map[string]struct{}{}
+	for _, path := range paths {
+		if isProjectFile(path) && containsNoSegments(path, blockList...) {
+			dirs[dirWithoutDot(path)] = struct{}{}
+		}
+	}
+
+	roots := make([]string, 0, len(dirs))
+outer:
+	for dir := range dirs {
+		if dir != "" {
+			for _, ancestor := range ancestorDirs(dir) {
+				if _, ok := dirs[ancestor]; ok {
+					continue outer
+				}
+			}
+		}
+
+		roots = append(roots, dir)
+	}
+	sort.Strings(roots)
+
+	return roots
+}
+
 // containsSegment returns true if the given path contains the given segment.
 func containsSegment(path, segment string) bool {
 	if path == "" {
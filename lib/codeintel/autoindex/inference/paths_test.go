@@ -61,3 +61,19 @@ func TestContainsSegment(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectRoots(t *testing.T) {
+	paths := []string{
+		"a/setup.py",
+		"a/b/setup.py",
+		"c/d/setup.py",
+		"c/d/requirements.txt",
+		"test/setup.py",
+		"e/setup.cfg",
+	}
+
+	expectedRoots := []string{"a", "c/d"}
+	if diff := cmp.Diff(expectedRoots, projectRoots(paths, isPythonProjectPath, segmentBlockList)); diff != "" {
+		t.Errorf("unexpected project roots (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,56 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func PythonPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("setup.py")),
+		pathPattern(rawPattern("pyproject.toml")),
+		pathPattern(rawPattern("requirements.txt")),
+	}
+}
+
+const scipPythonImage = "sourcegraph/scip-python:autoindex"
+
+func InferPythonIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	for _, root := range projectRoots(paths, isPythonProjectPath, pythonSegmentBlockList) {
+		var commands []string
+		if contains(paths, filepath.Join(root, "requirements.txt")) {
+			commands = append(commands, "pip install -r requirements.txt")
+		}
+		if contains(paths, filepath.Join(root, "setup.py")) || contains(paths, filepath.Join(root, "pyproject.toml")) {
+			commands = append(commands, "pip install .")
+		}
+
+		indexes = append(indexes, config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    scipPythonImage,
+					Commands: commands,
+				},
+			},
+			Root:        root,
+			Indexer:     scipPythonImage,
+			IndexerArgs: []string{"scip-python", "index", "."},
+			Outfile:     "index.scip",
+		})
+	}
+
+	return indexes
+}
+
+var pythonSegmentBlockList = append([]string{"venv", ".venv", "site-packages"}, segmentBlockList...)
+
+func isPythonProjectPath(path string) bool {
+	switch filepath.Base(path) {
+	case "setup.py", "pyproject.toml", "requirements.txt":
+		return true
+	}
+	return false
+}
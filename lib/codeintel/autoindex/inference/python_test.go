@@ -0,0 +1,91 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestPythonPatterns(t *testing.T) {
+	testLangPatterns(t, PythonPatterns(), []PathTestCase{
+		{"setup.py", true},
+		{"pyproject.toml", true},
+		{"requirements.txt", true},
+		{"subdir/requirements.txt", true},
+		{"dev-requirements.txt", false},
+		{"main.py", false},
+	})
+}
+
+func TestInferPythonIndexJobs(t *testing.T) {
+	paths := []string{
+		"requirements.txt",
+		"setup.py",
+		"lib/pyproject.toml",
+		"services/api/requirements.txt",
+		"services/api/nested/setup.py",
+		"tests/requirements.txt",
+		"venv/lib/python3.9/site-packages/six/setup.py",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    scipPythonImage,
+					Commands: []string{"pip install -r requirements.txt", "pip install ."},
+				},
+			},
+			Root:        "",
+			Indexer:     scipPythonImage,
+			IndexerArgs: []string{"scip-python", "index", "."},
+			Outfile:     "index.scip",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferPythonIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferPythonIndexJobsSubdirs(t *testing.T) {
+	paths := []string{
+		"lib/pyproject.toml",
+		"services/api/requirements.txt",
+		"services/api/nested/setup.py",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "lib",
+					Image:    scipPythonImage,
+					Commands: []string{"pip install ."},
+				},
+			},
+			Root:        "lib",
+			Indexer:     scipPythonImage,
+			IndexerArgs: []string{"scip-python", "index", "."},
+			Outfile:     "index.scip",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "services/api",
+					Image:    scipPythonImage,
+					Commands: []string{"pip install -r requirements.txt"},
+				},
+			},
+			Root:        "services/api",
+			Indexer:     scipPythonImage,
+			IndexerArgs: []string{"scip-python", "index", "."},
+			Outfile:     "index.scip",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferPythonIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
@@ -21,10 +21,14 @@ type IndexJobRecognizer interface {
 
 // Recognizers is a list of registered index job recognizers.
 var Recognizers = map[string]IndexJobRecognizer{
-	"go":   recognizer{GoPatterns, InferGoIndexJobs},
-	"tsc":  recognizer{TypeScriptPatterns, InferTypeScriptIndexJobs},
-	"java": recognizer{JavaPatterns, InferJavaIndexJobs},
-	"rust": recognizer{RustPatterns, InferRustIndexJobs},
+	"go":     recognizer{GoPatterns, InferGoIndexJobs},
+	"tsc":    recognizer{TypeScriptPatterns, InferTypeScriptIndexJobs},
+	"java":   recognizer{JavaPatterns, InferJavaIndexJobs},
+	"rust":   recognizer{RustPatterns, InferRustIndexJobs},
+	"python": recognizer{PythonPatterns, InferPythonIndexJobs},
+	"ruby":   recognizer{RubyPatterns, InferRubyIndexJobs},
+	"dotnet": recognizer{DotNetPatterns, InferDotNetIndexJobs},
+	"scala":  recognizer{ScalaPatterns, InferScalaIndexJobs},
 }
 
 type recognizer struct {
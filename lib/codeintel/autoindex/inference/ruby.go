@@ -0,0 +1,42 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func RubyPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("Gemfile")),
+	}
+}
+
+const scipRubyImage = "sourcegraph/scip-ruby:autoindex"
+
+func InferRubyIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	for _, root := range projectRoots(paths, isRubyProjectPath, rubySegmentBlockList) {
+		indexes = append(indexes, config.IndexJob{
+			Steps: []config.DockerStep{
+				{
+					Root:     root,
+					Image:    scipRubyImage,
+					Commands: []string{"bundle install"},
+				},
+			},
+			Root:        root,
+			Indexer:     scipRubyImage,
+			IndexerArgs: []string{"scip-ruby", "--index-file", "index.scip", "."},
+			Outfile:     "index.scip",
+		})
+	}
+
+	return indexes
+}
+
+var rubySegmentBlockList = append([]string{"vendor"}, segmentBlockList...)
+
+func isRubyProjectPath(path string) bool {
+	return filepath.Base(path) == "Gemfile"
+}
@@ -0,0 +1,85 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestRubyPatterns(t *testing.T) {
+	testLangPatterns(t, RubyPatterns(), []PathTestCase{
+		{"Gemfile", true},
+		{"subdir/Gemfile", true},
+		{"Gemfile.lock", false},
+		{"app.rb", false},
+	})
+}
+
+func TestInferRubyIndexJobs(t *testing.T) {
+	paths := []string{
+		"Gemfile",
+		"engines/billing/Gemfile",
+		"tools/Gemfile",
+		"vendor/bundle/ruby/gems/rake/Gemfile",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "",
+					Image:    scipRubyImage,
+					Commands: []string{"bundle install"},
+				},
+			},
+			Root:        "",
+			Indexer:     scipRubyImage,
+			IndexerArgs: []string{"scip-ruby", "--index-file", "index.scip", "."},
+			Outfile:     "index.scip",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferRubyIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferRubyIndexJobsSubdirs(t *testing.T) {
+	paths := []string{
+		"api/Gemfile",
+		"web/Gemfile",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "api",
+					Image:    scipRubyImage,
+					Commands: []string{"bundle install"},
+				},
+			},
+			Root:        "api",
+			Indexer:     scipRubyImage,
+			IndexerArgs: []string{"scip-ruby", "--index-file", "index.scip", "."},
+			Outfile:     "index.scip",
+		},
+		{
+			Steps: []config.DockerStep{
+				{
+					Root:     "web",
+					Image:    scipRubyImage,
+					Commands: []string{"bundle install"},
+				},
+			},
+			Root:        "web",
+			Indexer:     scipRubyImage,
+			IndexerArgs: []string{"scip-ruby", "--index-file", "index.scip", "."},
+			Outfile:     "index.scip",
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferRubyIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,39 @@
+package inference
+
+import (
+	"path/filepath"
+	"regexp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func ScalaPatterns() []*regexp.Regexp {
+	return []*regexp.Regexp{
+		pathPattern(rawPattern("build.sbt")),
+	}
+}
+
+func InferScalaIndexJobs(gitclient GitClient, paths []string) (indexes []config.IndexJob) {
+	// Package repositories are indexed by the Java recognizer
+	if contains(paths, "lsif-java.json") {
+		return nil
+	}
+
+	for _, root := range projectRoots(paths, isSbtBuildPath, segmentBlockList) {
+		indexes = append(indexes, config.IndexJob{
+			Indexer: "sourcegraph/lsif-java",
+			IndexerArgs: []string{
+				"lsif-java index --build-tool=sbt",
+			},
+			Outfile: "dump.lsif",
+			Root:    root,
+			Steps:   []config.DockerStep{},
+		})
+	}
+
+	return indexes
+}
+
+func isSbtBuildPath(path string) bool {
+	return filepath.Base(path) == "build.sbt"
+}
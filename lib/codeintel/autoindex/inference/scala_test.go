@@ -0,0 +1,52 @@
+package inference
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/autoindex/config"
+)
+
+func TestScalaPatterns(t *testing.T) {
+	testLangPatterns(t, ScalaPatterns(), []PathTestCase{
+		{"build.sbt", true},
+		{"subdir/build.sbt", true},
+		{"project/build.properties", false},
+	})
+}
+
+func TestInferScalaIndexJobs(t *testing.T) {
+	paths := []string{
+		"build.sbt",
+		"core/build.sbt",
+		"src/main/scala/Main.scala",
+	}
+
+	expectedIndexJobs := []config.IndexJob{
+		{
+			Indexer: "sourcegraph/lsif-java",
+			IndexerArgs: []string{
+				"lsif-java index --build-tool=sbt",
+			},
+			Outfile: "dump.lsif",
+			Root:    "",
+			Steps:   []config.DockerStep{},
+		},
+	}
+	if diff := cmp.Diff(expectedIndexJobs, InferScalaIndexJobs(NewMockGitClient(), paths)); diff != "" {
+		t.Errorf("unexpected index jobs (-want +got):\n%s", diff)
+	}
+}
+
+func TestInferScalaIndexJobsPackageRepository(t *testing.T) {
+	paths := []string{
+		"lsif-java.json",
+		"build.sbt",
+		"A.scala",
+	}
+
+	if indexJobs := InferScalaIndexJobs(NewMockGitClient(), paths); len(indexJobs) != 0 {
+		t.Errorf("unexpected index jobs: %v", indexJobs)
+	}
+}
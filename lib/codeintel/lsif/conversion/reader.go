@@ -1,10 +1,12 @@
 package conversion
 
 import (
+	"bufio"
 	"context"
 	"io"
 
 	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
 )
 
 type Pair struct {
@@ -12,15 +14,24 @@ type Pair struct {
 	Err     error
 }
 
+// formatDetectionPrefixSize is the number of bytes inspected to determine the format of an upload.
+const formatDetectionPrefixSize = 64 * 1024
+
 // Read reads the given content as line-separated JSON objects and returns a channel of Pair values
-// for each non-empty line.
+// for each non-empty line. Protobuf-encoded SCIP indexes are detected by their content and are
+// translated into the equivalent stream of LSIF elements.
 func Read(ctx context.Context, r io.Reader) <-chan Pair {
+	br := bufio.NewReaderSize(r, formatDetectionPrefixSize)
+	if prefix, _ := br.Peek(formatDetectionPrefixSize); scip.LooksLikeIndex(prefix) {
+		return readSCIP(ctx, br)
+	}
+
 	elements := make(chan Pair)
 
 	go func() {
 		defer close(elements)
 
-		for pair := range reader.Read(ctx, r) {
+		for pair := range reader.Read(ctx, br) {
 			element := Element{
 				ID:      pair.Element.ID,
 				Type:    pair.Element.Type,
@@ -0,0 +1,450 @@
+package conversion
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+)
+
+// scipLSIFVersion is reported as the LSIF version of converted SCIP indexes. The
+// synthesized element stream follows the semantics of this protocol version.
+const scipLSIFVersion = "0.4.3"
+
+// readSCIP reads a protobuf-encoded SCIP index and returns a channel of Pair values
+// for the equivalent stream of LSIF elements. Documents are converted one at a time,
+// so the index is never materialized in memory.
+func readSCIP(ctx context.Context, r io.Reader) <-chan Pair {
+	elements := make(chan Pair, reader.ChannelBufferSize)
+
+	go func() {
+		defer close(elements)
+
+		if err := newSCIPConverter(ctx, elements).convert(r); err != nil {
+			select {
+			case elements <- Pair{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return elements
+}
+
+type scipConverter struct {
+	ctx         context.Context
+	elements    chan<- Pair
+	id          int
+	projectRoot string
+
+	// symbols holds the result set and result vertices of each global symbol. The
+	// order of symbols is kept so that monikers are emitted deterministically.
+	symbols        map[string]*scipSymbol
+	orderedSymbols []*scipSymbol
+
+	// externalDocumentation holds the documentation of external symbols that were
+	// read before any occurrence referenced them.
+	externalDocumentation map[string][]string
+
+	// packageInformationIDs holds the identifier of the packageInformation vertex
+	// emitted for each package.
+	packageInformationIDs map[scip.Package]int
+}
+
+type scipSymbol struct {
+	symbol                 string
+	resultSetID            int
+	definitionResultID     int
+	referenceResultID      int
+	implementationResultID int
+	hasHover               bool
+	defined                bool
+	implements             []string
+}
+
+// scipDocument holds the per-document state of the conversion.
+type scipDocument struct {
+	id          int
+	locals      map[string]*scipSymbol
+	rangeIDs    []int
+	items       map[int][]int // result vertex identifier -> range identifiers
+	itemOrder   []int
+	diagnostics []Diagnostic
+}
+
+func newSCIPConverter(ctx context.Context, elements chan<- Pair) *scipConverter {
+	return &scipConverter{
+		ctx:                   ctx,
+		elements:              elements,
+		symbols:               map[string]*scipSymbol{},
+		externalDocumentation: map[string][]string{},
+		packageInformationIDs: map[scip.Package]int{},
+	}
+}
+
+func (c *scipConverter) convert(r io.Reader) error {
+	if err := scip.Read(r, scip.Visitor{
+		VisitMetadata:       c.convertMetadata,
+		VisitDocument:       c.convertDocument,
+		VisitExternalSymbol: c.convertExternalSymbol,
+	}); err != nil {
+		return err
+	}
+
+	return c.convertMonikers()
+}
+
+func (c *scipConverter) convertMetadata(metadata *scip.Metadata) error {
+	c.projectRoot = metadata.ProjectRoot
+	if !strings.HasSuffix(c.projectRoot, "/") {
+		c.projectRoot += "/"
+	}
+
+	_, err := c.emitVertex("metaData", MetaData{Version: scipLSIFVersion, ProjectRoot: metadata.ProjectRoot})
+	return err
+}
+
+func (c *scipConverter) convertDocument(document *scip.Document) error {
+	if c.projectRoot == "" {
+		return ErrMissingMetaData
+	}
+
+	documentID, err := c.emitVertex("document", c.projectRoot+document.RelativePath)
+	if err != nil {
+		return err
+	}
+
+	d := &scipDocument{
+		id:     documentID,
+		locals: map[string]*scipSymbol{},
+		items:  map[int][]int{},
+	}
+
+	for _, info := range document.Symbols {
+		symbol, err := c.lookupSymbol(d, info.Symbol)
+		if err != nil {
+			return err
+		}
+		symbol.defined = true
+
+		if err := c.setDocumentation(symbol, info.Documentation); err != nil {
+			return err
+		}
+
+		for _, relationship := range info.Relationships {
+			if relationship.IsImplementation {
+				symbol.implements = append(symbol.implements, relationship.Symbol)
+			}
+		}
+	}
+
+	for _, occurrence := range document.Occurrences {
+		if err := c.convertOccurrence(d, occurrence); err != nil {
+			return err
+		}
+	}
+
+	if len(d.rangeIDs) > 0 {
+		if _, err := c.emitEdge("contains", Edge{OutV: documentID, InVs: d.rangeIDs}); err != nil {
+			return err
+		}
+	}
+
+	for _, resultID := range d.itemOrder {
+		if _, err := c.emitEdge("item", Edge{OutV: resultID, InVs: d.items[resultID], Document: documentID}); err != nil {
+			return err
+		}
+	}
+
+	if len(d.diagnostics) > 0 {
+		diagnosticResultID, err := c.emitVertex("diagnosticResult", d.diagnostics)
+		if err != nil {
+			return err
+		}
+		if _, err := c.emitEdge("textDocument/diagnostic", Edge{OutV: documentID, InV: diagnosticResultID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *scipConverter) convertOccurrence(d *scipDocument, occurrence *scip.Occurrence) error {
+	if occurrence.Symbol == "" {
+		// Syntax highlighting only
+		return nil
+	}
+
+	rangeData, err := scipRange(occurrence.Range)
+	if err != nil {
+		return err
+	}
+
+	rangeID, err := c.emitVertex("range", Range{Range: reader.Range{RangeData: rangeData}})
+	if err != nil {
+		return err
+	}
+	d.rangeIDs = append(d.rangeIDs, rangeID)
+
+	symbol, err := c.lookupSymbol(d, occurrence.Symbol)
+	if err != nil {
+		return err
+	}
+	if _, err := c.emitEdge("next", Edge{OutV: rangeID, InV: symbol.resultSetID}); err != nil {
+		return err
+	}
+
+	if occurrence.HasRole(scip.SymbolRoleDefinition) {
+		symbol.defined = true
+		d.addItem(symbol.definitionResultID, rangeID)
+
+		for _, name := range symbol.implements {
+			implemented, err := c.lookupSymbol(d, name)
+			if err != nil {
+				return err
+			}
+			implementationResultID, err := c.implementationResult(implemented)
+			if err != nil {
+				return err
+			}
+			d.addItem(implementationResultID, rangeID)
+		}
+	}
+
+	// LSIF reference results include the definitions of a symbol
+	d.addItem(symbol.referenceResultID, rangeID)
+
+	if len(occurrence.OverrideDocumentation) > 0 {
+		if err := c.emitHover(rangeID, occurrence.OverrideDocumentation); err != nil {
+			return err
+		}
+	}
+
+	for _, diagnostic := range occurrence.Diagnostics {
+		d.diagnostics = append(d.diagnostics, Diagnostic{
+			Severity:       int(diagnostic.Severity),
+			Code:           diagnostic.Code,
+			Message:        diagnostic.Message,
+			Source:         diagnostic.Source,
+			StartLine:      rangeData.Start.Line,
+			StartCharacter: rangeData.Start.Character,
+			EndLine:        rangeData.End.Line,
+			EndCharacter:   rangeData.End.Character,
+		})
+	}
+
+	return nil
+}
+
+func (c *scipConverter) convertExternalSymbol(info *scip.SymbolInformation) error {
+	if scip.IsLocalSymbol(info.Symbol) || len(info.Documentation) == 0 {
+		return nil
+	}
+
+	if symbol, ok := c.symbols[info.Symbol]; ok {
+		return c.setDocumentation(symbol, info.Documentation)
+	}
+
+	// Emit the hover result once the symbol is referenced. Symbols that are never
+	// referenced do not get a result set.
+	c.externalDocumentation[info.Symbol] = info.Documentation
+	return nil
+}
+
+// convertMonikers emits a moniker for each global symbol. Symbols defined in the
+// index are exported, all others are imported.
+func (c *scipConverter) convertMonikers() error {
+	for _, symbol := range c.orderedSymbols {
+		parsed, err := scip.ParseSymbol(symbol.symbol)
+		if err != nil {
+			// Malformed symbols still resolve within the index
+			continue
+		}
+
+		kind := "import"
+		if symbol.defined {
+			kind = "export"
+		}
+		scheme := parsed.Package.Manager
+		if scheme == "" {
+			scheme = parsed.Scheme
+		}
+
+		monikerID, err := c.emitVertex("moniker", Moniker{Moniker: reader.Moniker{
+			Kind:       kind,
+			Scheme:     scheme,
+			Identifier: parsed.Descriptors,
+		}})
+		if err != nil {
+			return err
+		}
+		if _, err := c.emitEdge("moniker", Edge{OutV: symbol.resultSetID, InV: monikerID}); err != nil {
+			return err
+		}
+
+		if parsed.Package.Name == "" {
+			continue
+		}
+
+		packageInformationID, ok := c.packageInformationIDs[parsed.Package]
+		if !ok {
+			packageInformationID, err = c.emitVertex("packageInformation", PackageInformation{
+				Name:    parsed.Package.Name,
+				Version: parsed.Package.Version,
+			})
+			if err != nil {
+				return err
+			}
+			c.packageInformationIDs[parsed.Package] = packageInformationID
+		}
+		if _, err := c.emitEdge("packageInformation", Edge{OutV: monikerID, InV: packageInformationID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lookupSymbol returns the state of the given symbol, emitting its result set and
+// result vertices on first use. Local symbols are scoped to the given document.
+func (c *scipConverter) lookupSymbol(d *scipDocument, name string) (*scipSymbol, error) {
+	symbols := c.symbols
+	if scip.IsLocalSymbol(name) {
+		symbols = d.locals
+	}
+	if symbol, ok := symbols[name]; ok {
+		return symbol, nil
+	}
+
+	resultSetID, err := c.emitVertex("resultSet", ResultSet{})
+	if err != nil {
+		return nil, err
+	}
+	definitionResultID, err := c.emitVertex("definitionResult", nil)
+	if err != nil {
+		return nil, err
+	}
+	referenceResultID, err := c.emitVertex("referenceResult", nil)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := c.emitEdge("textDocument/definition", Edge{OutV: resultSetID, InV: definitionResultID}); err != nil {
+		return nil, err
+	}
+	if _, err := c.emitEdge("textDocument/references", Edge{OutV: resultSetID, InV: referenceResultID}); err != nil {
+		return nil, err
+	}
+
+	symbol := &scipSymbol{
+		symbol:             name,
+		resultSetID:        resultSetID,
+		definitionResultID: definitionResultID,
+		referenceResultID:  referenceResultID,
+	}
+	symbols[name] = symbol
+
+	if !scip.IsLocalSymbol(name) {
+		c.orderedSymbols = append(c.orderedSymbols, symbol)
+
+		if documentation, ok := c.externalDocumentation[name]; ok {
+			delete(c.externalDocumentation, name)
+
+			if err := c.setDocumentation(symbol, documentation); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return symbol, nil
+}
+
+// implementationResult returns the identifier of the implementation result of the
+// given symbol, emitting it on first use.
+func (c *scipConverter) implementationResult(symbol *scipSymbol) (int, error) {
+	if symbol.implementationResultID != 0 {
+		return symbol.implementationResultID, nil
+	}
+
+	implementationResultID, err := c.emitVertex("implementationResult", nil)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := c.emitEdge("textDocument/implementation", Edge{OutV: symbol.resultSetID, InV: implementationResultID}); err != nil {
+		return 0, err
+	}
+
+	symbol.implementationResultID = implementationResultID
+	return implementationResultID, nil
+}
+
+// setDocumentation attaches a hover result to the symbol's result set unless the
+// symbol already has one.
+func (c *scipConverter) setDocumentation(symbol *scipSymbol, documentation []string) error {
+	if symbol.hasHover || len(documentation) == 0 {
+		return nil
+	}
+	symbol.hasHover = true
+
+	return c.emitHover(symbol.resultSetID, documentation)
+}
+
+func (c *scipConverter) emitHover(outV int, documentation []string) error {
+	hoverResultID, err := c.emitVertex("hoverResult", strings.Join(documentation, reader.HoverPartSeparator))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.emitEdge("textDocument/hover", Edge{OutV: outV, InV: hoverResultID})
+	return err
+}
+
+func (c *scipConverter) emitVertex(label string, payload interface{}) (int, error) {
+	return c.emit("vertex", label, payload)
+}
+
+func (c *scipConverter) emitEdge(label string, edge Edge) (int, error) {
+	return c.emit("edge", label, edge)
+}
+
+func (c *scipConverter) emit(elementType, label string, payload interface{}) (int, error) {
+	c.id++
+	element := Element{ID: c.id, Type: elementType, Label: label, Payload: payload}
+
+	select {
+	case c.elements <- Pair{Element: element}:
+		return c.id, nil
+	case <-c.ctx.Done():
+		return 0, c.ctx.Err()
+	}
+}
+
+func (d *scipDocument) addItem(resultID, rangeID int) {
+	if _, ok := d.items[resultID]; !ok {
+		d.itemOrder = append(d.itemOrder, resultID)
+	}
+	d.items[resultID] = append(d.items[resultID], rangeID)
+}
+
+// scipRange converts an occurrence range, which is either [startLine, startCharacter,
+// endCharacter] or [startLine, startCharacter, endLine, endCharacter].
+func scipRange(r []int32) (protocol.RangeData, error) {
+	switch len(r) {
+	case 3:
+		return protocol.RangeData{
+			Start: protocol.Pos{Line: int(r[0]), Character: int(r[1])},
+			End:   protocol.Pos{Line: int(r[0]), Character: int(r[2])},
+		}, nil
+	case 4:
+		return protocol.RangeData{
+			Start: protocol.Pos{Line: int(r[0]), Character: int(r[1])},
+			End:   protocol.Pos{Line: int(r[2]), Character: int(r[3])},
+		}, nil
+	}
+
+	return protocol.RangeData{}, errors.Errorf("malformed occurrence range %v", r)
+}
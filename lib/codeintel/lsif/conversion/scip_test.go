@@ -0,0 +1,196 @@
+package conversion
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/conversion/datastructures"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/lsif/protocol/reader"
+	"github.com/sourcegraph/sourcegraph/lib/codeintel/scip"
+)
+
+const (
+	testSCIPFoo = "scip-go gomod github.com/test/pkg v1.0.0 `github.com/test/pkg`/Foo()."
+	testSCIPBar = "scip-go gomod github.com/test/dep v2.0.0 `github.com/test/dep`/Bar()."
+)
+
+var testSCIPIndex = &scip.Index{
+	Metadata: &scip.Metadata{ProjectRoot: "file:///test/root"},
+	Documents: []*scip.Document{
+		{
+			RelativePath: "foo.go",
+			Symbols: []*scip.SymbolInformation{
+				{Symbol: testSCIPFoo, Documentation: []string{"```go\nfunc Foo()\n```", "Foo does foo."}},
+			},
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{1, 5, 8}, Symbol: testSCIPFoo, SymbolRoles: int32(scip.SymbolRoleDefinition)},
+				{Range: []int32{2, 1, 2}, Symbol: "local 0", SymbolRoles: int32(scip.SymbolRoleDefinition)},
+				{Range: []int32{2, 4, 6}}, // syntax highlighting only
+			},
+		},
+		{
+			RelativePath: "bar.go",
+			Occurrences: []*scip.Occurrence{
+				{Range: []int32{4, 2, 5}, Symbol: testSCIPFoo},
+				{
+					Range:       []int32{5, 0, 6, 3},
+					Symbol:      testSCIPBar,
+					Diagnostics: []*scip.Diagnostic{{Severity: 2, Code: "SA1019", Message: "Bar is deprecated", Source: "staticcheck"}},
+				},
+			},
+		},
+	},
+	ExternalSymbols: []*scip.SymbolInformation{
+		{Symbol: testSCIPBar, Documentation: []string{"```go\nfunc Bar()\n```"}},
+	},
+}
+
+func TestCorrelateSCIP(t *testing.T) {
+	state, err := correlateFromReader(context.Background(), bytes.NewReader(scip.Marshal(testSCIPIndex)), "")
+	if err != nil {
+		t.Fatalf("unexpected error correlating input: %s", err)
+	}
+
+	expectedState := &State{
+		LSIFVersion: "0.4.3",
+		ProjectRoot: "file:///test/root/",
+		DocumentData: map[int]string{
+			2:  "foo.go",
+			24: "bar.go",
+		},
+		RangeData: map[int]Range{
+			10: {Range: reader.Range{RangeData: protocol.RangeData{Start: protocol.Pos{Line: 1, Character: 5}, End: protocol.Pos{Line: 1, Character: 8}}}},
+			12: {Range: reader.Range{RangeData: protocol.RangeData{Start: protocol.Pos{Line: 2, Character: 1}, End: protocol.Pos{Line: 2, Character: 2}}}},
+			25: {Range: reader.Range{RangeData: protocol.RangeData{Start: protocol.Pos{Line: 4, Character: 2}, End: protocol.Pos{Line: 4, Character: 5}}}},
+			27: {Range: reader.Range{RangeData: protocol.RangeData{Start: protocol.Pos{Line: 5, Character: 0}, End: protocol.Pos{Line: 6, Character: 3}}}},
+		},
+		ResultSetData: map[int]ResultSet{
+			3:  {DefinitionResultID: 4, ReferenceResultID: 5, HoverResultID: 8},
+			13: {DefinitionResultID: 14, ReferenceResultID: 15},
+			28: {DefinitionResultID: 29, ReferenceResultID: 30, HoverResultID: 39},
+		},
+		DefinitionData: map[int]*datastructures.DefaultIDSetMap{
+			4:  datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{2: datastructures.IDSetWith(10)}),
+			14: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{2: datastructures.IDSetWith(12)}),
+			29: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{}),
+		},
+		ReferenceData: map[int]*datastructures.DefaultIDSetMap{
+			5: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{
+				2:  datastructures.IDSetWith(10),
+				24: datastructures.IDSetWith(25),
+			}),
+			15: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{2: datastructures.IDSetWith(12)}),
+			30: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{24: datastructures.IDSetWith(27)}),
+		},
+		ImplementationData: map[int]*datastructures.DefaultIDSetMap{},
+		HoverData: map[int]string{
+			8:  "```go\nfunc Foo()\n```\n\n---\n\nFoo does foo.",
+			39: "```go\nfunc Bar()\n```",
+		},
+		MonikerData: map[int]Moniker{
+			41: {
+				Moniker: reader.Moniker{
+					Kind:       "export",
+					Scheme:     "gomod",
+					Identifier: "`github.com/test/pkg`/Foo().",
+				},
+				PackageInformationID: 43,
+			},
+			45: {
+				Moniker: reader.Moniker{
+					Kind:       "import",
+					Scheme:     "gomod",
+					Identifier: "`github.com/test/dep`/Bar().",
+				},
+				PackageInformationID: 47,
+			},
+		},
+		PackageInformationData: map[int]PackageInformation{
+			43: {Name: "github.com/test/pkg", Version: "v1.0.0"},
+			47: {Name: "github.com/test/dep", Version: "v2.0.0"},
+		},
+		DiagnosticResults: map[int][]Diagnostic{
+			37: {
+				{
+					Severity:       2,
+					Code:           "SA1019",
+					Message:        "Bar is deprecated",
+					Source:         "staticcheck",
+					StartLine:      5,
+					StartCharacter: 0,
+					EndLine:        6,
+					EndCharacter:   3,
+				},
+			},
+		},
+		NextData: map[int]int{
+			10: 3,
+			12: 13,
+			25: 3,
+			27: 28,
+		},
+		ImportedMonikers:       datastructures.IDSetWith(45),
+		ExportedMonikers:       datastructures.IDSetWith(41),
+		ImplementedMonikers:    datastructures.NewIDSet(),
+		LinkedMonikers:         datastructures.NewDisjointIDSet(),
+		LinkedReferenceResults: map[int][]int{},
+		Contains: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{
+			2:  datastructures.IDSetWith(10, 12),
+			24: datastructures.IDSetWith(25, 27),
+		}),
+		Monikers: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{
+			3:  datastructures.IDSetWith(41),
+			28: datastructures.IDSetWith(45),
+		}),
+		Diagnostics: datastructures.DefaultIDSetMapWith(map[int]*datastructures.IDSet{
+			24: datastructures.IDSetWith(37),
+		}),
+		DocumentationResultsData:  map[int]protocol.Documentation{},
+		DocumentationStringsData:  map[int]protocol.MarkupContent{},
+		DocumentationResultRoot:   -1,
+		DocumentationChildren:     map[int][]int{},
+		DocumentationStringLabel:  map[int]int{},
+		DocumentationStringDetail: map[int]int{},
+	}
+
+	if diff := cmp.Diff(expectedState, state, datastructures.Comparers...); diff != "" {
+		t.Errorf("unexpected state (-want +got):\n%s", diff)
+	}
+}
+
+func TestCorrelateSCIPMissingMetaData(t *testing.T) {
+	index := &scip.Index{Documents: testSCIPIndex.Documents}
+
+	if _, err := correlateFromReader(context.Background(), bytes.NewReader(scip.Marshal(index)), ""); err == nil {
+		t.Fatalf("expected error correlating index without metadata")
+	}
+}
+
+func TestReadDetectsFormat(t *testing.T) {
+	for _, testCase := range []struct {
+		name  string
+		input []byte
+		label string
+	}{
+		{name: "lsif", input: []byte(`{"id": 1, "type": "vertex", "label": "metaData", "version": "0.4.3", "projectRoot": "file:///test"}` + "\n"), label: "metaData"},
+		{name: "lsif with leading newline", input: []byte("\n" + `{"id": 1, "type": "vertex", "label": "metaData", "version": "0.4.3", "projectRoot": "file:///test"}` + "\n"), label: "metaData"},
+		{name: "scip", input: scip.Marshal(testSCIPIndex), label: "metaData"},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			pair := <-Read(ctx, bytes.NewReader(testCase.input))
+			if pair.Err != nil {
+				t.Fatalf("unexpected error reading input: %s", pair.Err)
+			}
+			if pair.Element.Label != testCase.label {
+				t.Errorf("unexpected label. want=%q have=%q", testCase.label, pair.Element.Label)
+			}
+		})
+	}
+}
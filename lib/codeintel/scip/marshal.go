@@ -0,0 +1,135 @@
+package scip
+
+import "google.golang.org/protobuf/encoding/protowire"
+
+// Marshal encodes the given index in the protobuf wire format. The metadata is
+// written first so that readers can detect the format from a prefix of the output.
+func Marshal(index *Index) []byte {
+	var b []byte
+	if index.Metadata != nil {
+		b = appendMessage(b, 1, marshalMetadata(index.Metadata))
+	}
+	for _, document := range index.Documents {
+		b = appendMessage(b, 2, marshalDocument(document))
+	}
+	for _, symbol := range index.ExternalSymbols {
+		b = appendMessage(b, 3, marshalSymbolInformation(symbol))
+	}
+
+	return b
+}
+
+func appendMessage(b []byte, num protowire.Number, message []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, message)
+}
+
+func appendString(b []byte, num protowire.Number, value string) []byte {
+	if value == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, value)
+}
+
+func appendStrings(b []byte, num protowire.Number, values []string) []byte {
+	for _, value := range values {
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendString(b, value)
+	}
+	return b
+}
+
+func appendInt32(b []byte, num protowire.Number, value int32) []byte {
+	if value == 0 {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(value))
+}
+
+func appendBool(b []byte, num protowire.Number, value bool) []byte {
+	if !value {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, protowire.EncodeBool(value))
+}
+
+func marshalMetadata(metadata *Metadata) []byte {
+	var b []byte
+	b = appendInt32(b, 1, metadata.Version)
+	if metadata.ToolInfo != nil {
+		b = appendMessage(b, 2, marshalToolInfo(metadata.ToolInfo))
+	}
+	b = appendString(b, 3, metadata.ProjectRoot)
+	b = appendInt32(b, 4, metadata.TextDocumentEncoding)
+	return b
+}
+
+func marshalToolInfo(toolInfo *ToolInfo) []byte {
+	var b []byte
+	b = appendString(b, 1, toolInfo.Name)
+	b = appendString(b, 2, toolInfo.Version)
+	b = appendStrings(b, 3, toolInfo.Arguments)
+	return b
+}
+
+func marshalDocument(document *Document) []byte {
+	var b []byte
+	b = appendString(b, 1, document.RelativePath)
+	for _, occurrence := range document.Occurrences {
+		b = appendMessage(b, 2, marshalOccurrence(occurrence))
+	}
+	for _, symbol := range document.Symbols {
+		b = appendMessage(b, 3, marshalSymbolInformation(symbol))
+	}
+	b = appendString(b, 4, document.Language)
+	return b
+}
+
+func marshalOccurrence(occurrence *Occurrence) []byte {
+	var packed []byte
+	for _, v := range occurrence.Range {
+		packed = protowire.AppendVarint(packed, uint64(v))
+	}
+
+	var b []byte
+	b = appendMessage(b, 1, packed)
+	b = appendString(b, 2, occurrence.Symbol)
+	b = appendInt32(b, 3, occurrence.SymbolRoles)
+	b = appendStrings(b, 4, occurrence.OverrideDocumentation)
+	for _, diagnostic := range occurrence.Diagnostics {
+		b = appendMessage(b, 6, marshalDiagnostic(diagnostic))
+	}
+	return b
+}
+
+func marshalSymbolInformation(symbol *SymbolInformation) []byte {
+	var b []byte
+	b = appendString(b, 1, symbol.Symbol)
+	b = appendStrings(b, 3, symbol.Documentation)
+	for _, relationship := range symbol.Relationships {
+		b = appendMessage(b, 4, marshalRelationship(relationship))
+	}
+	return b
+}
+
+func marshalRelationship(relationship *Relationship) []byte {
+	var b []byte
+	b = appendString(b, 1, relationship.Symbol)
+	b = appendBool(b, 2, relationship.IsReference)
+	b = appendBool(b, 3, relationship.IsImplementation)
+	b = appendBool(b, 4, relationship.IsTypeDefinition)
+	b = appendBool(b, 5, relationship.IsDefinition)
+	return b
+}
+
+func marshalDiagnostic(diagnostic *Diagnostic) []byte {
+	var b []byte
+	b = appendInt32(b, 1, diagnostic.Severity)
+	b = appendString(b, 2, diagnostic.Code)
+	b = appendString(b, 3, diagnostic.Message)
+	b = appendString(b, 4, diagnostic.Source)
+	return b
+}
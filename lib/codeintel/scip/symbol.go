@@ -0,0 +1,86 @@
+package scip
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Symbol is a parsed symbol string of the form
+//
+//	<scheme> ' ' <manager> ' ' <package-name> ' ' <version> ' ' <descriptors>
+//
+// or 'local <id>' for symbols which are not visible outside of their document.
+// Spaces within the scheme and package fields are escaped by doubling them, and
+// empty package fields are written as a single '.'.
+type Symbol struct {
+	Scheme      string
+	Package     Package
+	Descriptors string
+}
+
+type Package struct {
+	Manager string
+	Name    string
+	Version string
+}
+
+// IsLocalSymbol returns true if the given symbol is document-local.
+func IsLocalSymbol(symbol string) bool {
+	return strings.HasPrefix(symbol, "local ")
+}
+
+// ParseSymbol parses the given global symbol string.
+func ParseSymbol(symbol string) (Symbol, error) {
+	if IsLocalSymbol(symbol) {
+		return Symbol{}, errors.Errorf("cannot parse local symbol %q", symbol)
+	}
+
+	fields := make([]string, 0, 4)
+	rest := symbol
+	for len(fields) < 4 {
+		field, remainder, ok := nextSymbolField(rest)
+		if !ok {
+			return Symbol{}, errors.Errorf("malformed symbol %q", symbol)
+		}
+		if field == "." {
+			field = ""
+		}
+		fields = append(fields, field)
+		rest = remainder
+	}
+	if rest == "" {
+		return Symbol{}, errors.Errorf("malformed symbol %q: missing descriptors", symbol)
+	}
+
+	return Symbol{
+		Scheme: fields[0],
+		Package: Package{
+			Manager: fields[1],
+			Name:    fields[2],
+			Version: fields[3],
+		},
+		Descriptors: rest,
+	}, nil
+}
+
+// nextSymbolField returns the unescaped space-terminated field at the start of
+// the given string and the remainder of the string after the terminating space.
+func nextSymbolField(s string) (field, rest string, ok bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != ' ' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 < len(s) && s[i+1] == ' ' {
+			b.WriteByte(' ')
+			i++
+			continue
+		}
+
+		return b.String(), s[i+1:], b.Len() > 0
+	}
+
+	return "", "", false
+}
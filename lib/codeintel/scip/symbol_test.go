@@ -0,0 +1,50 @@
+package scip
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSymbol(t *testing.T) {
+	testCases := map[string]Symbol{
+		"scip-go gomod github.com/test/pkg v1.0.0 `github.com/test/pkg`/Foo().": {
+			Scheme:      "scip-go",
+			Package:     Package{Manager: "gomod", Name: "github.com/test/pkg", Version: "v1.0.0"},
+			Descriptors: "`github.com/test/pkg`/Foo().",
+		},
+		"scip-typescript npm . . src/`index.ts`/foo.": {
+			Scheme:      "scip-typescript",
+			Package:     Package{Manager: "npm"},
+			Descriptors: "src/`index.ts`/foo.",
+		},
+		"my  scheme maven com.example  lib 1.0 Foo#bar().": {
+			Scheme:      "my scheme",
+			Package:     Package{Manager: "maven", Name: "com.example lib", Version: "1.0"},
+			Descriptors: "Foo#bar().",
+		},
+	}
+
+	for input, expected := range testCases {
+		actual, err := ParseSymbol(input)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", input, err)
+		}
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Errorf("unexpected symbol for %q (-want +got):\n%s", input, diff)
+		}
+	}
+}
+
+func TestParseSymbolErrors(t *testing.T) {
+	for _, input := range []string{
+		"local 12",
+		"scip-go gomod github.com/test/pkg",
+		"scip-go gomod github.com/test/pkg v1.0.0",
+		"scip-go gomod github.com/test/pkg v1.0.0 ",
+	} {
+		if _, err := ParseSymbol(input); err == nil {
+			t.Errorf("expected error parsing %q", input)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package scip
+
+// Index is the top-level message of a SCIP index. See scip.proto for the wire format.
+//
+// Indexes are usually too large to hold in memory at once. Use Read to stream the
+// documents of an index instead of materializing this value.
+type Index struct {
+	Metadata        *Metadata
+	Documents       []*Document
+	ExternalSymbols []*SymbolInformation
+}
+
+// Metadata describes the indexer that produced the index and where the indexed
+// project is located.
+type Metadata struct {
+	Version              int32
+	ToolInfo             *ToolInfo
+	ProjectRoot          string // URI of the directory that document paths are relative to
+	TextDocumentEncoding int32
+}
+
+type ToolInfo struct {
+	Name      string
+	Version   string
+	Arguments []string
+}
+
+// Document contains all occurrences and symbol definitions of a single source file.
+type Document struct {
+	RelativePath string
+	Language     string
+	Occurrences  []*Occurrence
+	Symbols      []*SymbolInformation
+}
+
+// SymbolInformation describes a symbol defined in a document, or an external symbol
+// referenced by the index.
+type SymbolInformation struct {
+	Symbol        string
+	Documentation []string // markdown
+	Relationships []*Relationship
+}
+
+type Relationship struct {
+	Symbol           string
+	IsReference      bool
+	IsImplementation bool
+	IsTypeDefinition bool
+	IsDefinition     bool
+}
+
+// Occurrence associates a source range with a symbol.
+type Occurrence struct {
+	// Range is either [startLine, startCharacter, endCharacter] for single-line
+	// occurrences or [startLine, startCharacter, endLine, endCharacter].
+	Range                 []int32
+	Symbol                string
+	SymbolRoles           int32
+	OverrideDocumentation []string
+	Diagnostics           []*Diagnostic
+}
+
+type Diagnostic struct {
+	Severity int32
+	Code     string
+	Message  string
+	Source   string
+}
+
+// SymbolRole is a bitmask of the roles a symbol plays at an occurrence.
+type SymbolRole int32
+
+const (
+	SymbolRoleDefinition  SymbolRole = 0x1
+	SymbolRoleImport      SymbolRole = 0x2
+	SymbolRoleWriteAccess SymbolRole = 0x4
+	SymbolRoleReadAccess  SymbolRole = 0x8
+	SymbolRoleGenerated   SymbolRole = 0x10
+	SymbolRoleTest        SymbolRole = 0x20
+)
+
+// HasRole returns true if the occurrence has the given role.
+func (o *Occurrence) HasRole(role SymbolRole) bool {
+	return SymbolRole(o.SymbolRoles)&role != 0
+}
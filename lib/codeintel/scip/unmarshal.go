@@ -0,0 +1,369 @@
+package scip
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// MaxMessageSize is the maximum size of a single top-level message (the metadata,
+// a document, or an external symbol) of an index.
+const MaxMessageSize = 1 << 30
+
+// Visitor receives the top-level messages of an index in the order they are read.
+// Nil callbacks are skipped.
+type Visitor struct {
+	VisitMetadata       func(metadata *Metadata) error
+	VisitDocument       func(document *Document) error
+	VisitExternalSymbol func(symbol *SymbolInformation) error
+}
+
+// Read reads a protobuf-encoded index from the given reader and invokes the
+// visitor's callbacks for each top-level message. Only one document is held in
+// memory at a time.
+func Read(r io.Reader, visitor Visitor) error {
+	br := bufio.NewReader(r)
+
+	var buf []byte
+	for {
+		tag, err := binary.ReadUvarint(br)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "failed to read field tag")
+		}
+
+		num, typ := protowire.DecodeTag(tag)
+		if typ != protowire.BytesType {
+			return errors.Errorf("unexpected wire type %d for index field %d", typ, num)
+		}
+
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			return errors.Wrap(err, "failed to read field length")
+		}
+		if size > MaxMessageSize {
+			return errors.Errorf("index field %d exceeds maximum message size (%d > %d)", num, size, MaxMessageSize)
+		}
+
+		if uint64(cap(buf)) < size {
+			buf = make([]byte, size)
+		}
+		buf = buf[:size]
+		if _, err := io.ReadFull(br, buf); err != nil {
+			return errors.Wrapf(err, "failed to read index field %d", num)
+		}
+
+		switch num {
+		case 1:
+			metadata, err := unmarshalMetadata(buf)
+			if err != nil {
+				return errors.Wrap(err, "malformed metadata")
+			}
+			if visitor.VisitMetadata != nil {
+				if err := visitor.VisitMetadata(metadata); err != nil {
+					return err
+				}
+			}
+
+		case 2:
+			document, err := unmarshalDocument(buf)
+			if err != nil {
+				return errors.Wrap(err, "malformed document")
+			}
+			if visitor.VisitDocument != nil {
+				if err := visitor.VisitDocument(document); err != nil {
+					return err
+				}
+			}
+
+		case 3:
+			symbol, err := unmarshalSymbolInformation(buf)
+			if err != nil {
+				return errors.Wrap(err, "malformed external symbol")
+			}
+			if visitor.VisitExternalSymbol != nil {
+				if err := visitor.VisitExternalSymbol(symbol); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Unmarshal decodes an entire protobuf-encoded index held in memory.
+func Unmarshal(b []byte) (*Index, error) {
+	index := &Index{}
+	if err := Read(bytes.NewReader(b), Visitor{
+		VisitMetadata: func(metadata *Metadata) error {
+			index.Metadata = metadata
+			return nil
+		},
+		VisitDocument: func(document *Document) error {
+			index.Documents = append(index.Documents, document)
+			return nil
+		},
+		VisitExternalSymbol: func(symbol *SymbolInformation) error {
+			index.ExternalSymbols = append(index.ExternalSymbols, symbol)
+			return nil
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// LooksLikeIndex returns true if the given prefix of a file is the start of a
+// protobuf-encoded index rather than of an LSIF JSON dump. The prefix should be
+// large enough to hold the index metadata, which indexers write first.
+func LooksLikeIndex(prefix []byte) bool {
+	num, typ, n := protowire.ConsumeTag(prefix)
+	if n < 0 || typ != protowire.BytesType {
+		return false
+	}
+
+	switch num {
+	case 2, 3:
+		// Neither '\x12' nor '\x1a' can start a JSON document
+		return true
+
+	case 1:
+		// The tag of the metadata field is a newline, so we have to make sure
+		// this isn't JSON with leading whitespace. JSON objects never decode
+		// as valid metadata.
+		b, n := protowire.ConsumeBytes(prefix[n:])
+		if n < 0 {
+			return false
+		}
+
+		err := unmarshalFields(b, func(f field) error {
+			switch {
+			case f.num == 1 && f.typ == protowire.VarintType:
+			case f.num == 2 && f.typ == protowire.BytesType:
+			case f.num == 3 && f.typ == protowire.BytesType:
+			case f.num == 4 && f.typ == protowire.VarintType:
+			default:
+				return errors.Errorf("unexpected metadata field %d", f.num)
+			}
+			return nil
+		})
+		return err == nil
+	}
+
+	return false
+}
+
+type field struct {
+	num   protowire.Number
+	typ   protowire.Type
+	value uint64 // set for varint fields
+	bytes []byte // set for length-delimited fields
+}
+
+// unmarshalFields invokes the given function for each field of the given encoded
+// message. Only varint and length-delimited values are decoded; values of other wire
+// types are skipped.
+func unmarshalFields(b []byte, fn func(f field) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		f := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.value, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unmarshalMetadata(b []byte) (*Metadata, error) {
+	metadata := &Metadata{}
+	err := unmarshalFields(b, func(f field) (err error) {
+		switch {
+		case f.num == 1 && f.typ == protowire.VarintType:
+			metadata.Version = int32(f.value)
+		case f.num == 2 && f.typ == protowire.BytesType:
+			metadata.ToolInfo, err = unmarshalToolInfo(f.bytes)
+		case f.num == 3 && f.typ == protowire.BytesType:
+			metadata.ProjectRoot = string(f.bytes)
+		case f.num == 4 && f.typ == protowire.VarintType:
+			metadata.TextDocumentEncoding = int32(f.value)
+		}
+		return err
+	})
+
+	return metadata, err
+}
+
+func unmarshalToolInfo(b []byte) (*ToolInfo, error) {
+	toolInfo := &ToolInfo{}
+	err := unmarshalFields(b, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			toolInfo.Name = string(f.bytes)
+		case f.num == 2 && f.typ == protowire.BytesType:
+			toolInfo.Version = string(f.bytes)
+		case f.num == 3 && f.typ == protowire.BytesType:
+			toolInfo.Arguments = append(toolInfo.Arguments, string(f.bytes))
+		}
+		return nil
+	})
+
+	return toolInfo, err
+}
+
+func unmarshalDocument(b []byte) (*Document, error) {
+	document := &Document{}
+	err := unmarshalFields(b, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			document.RelativePath = string(f.bytes)
+
+		case f.num == 2 && f.typ == protowire.BytesType:
+			occurrence, err := unmarshalOccurrence(f.bytes)
+			if err != nil {
+				return err
+			}
+			document.Occurrences = append(document.Occurrences, occurrence)
+
+		case f.num == 3 && f.typ == protowire.BytesType:
+			symbol, err := unmarshalSymbolInformation(f.bytes)
+			if err != nil {
+				return err
+			}
+			document.Symbols = append(document.Symbols, symbol)
+
+		case f.num == 4 && f.typ == protowire.BytesType:
+			document.Language = string(f.bytes)
+		}
+		return nil
+	})
+
+	return document, err
+}
+
+func unmarshalOccurrence(b []byte) (*Occurrence, error) {
+	occurrence := &Occurrence{}
+	err := unmarshalFields(b, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.VarintType:
+			occurrence.Range = append(occurrence.Range, int32(f.value))
+
+		case f.num == 1 && f.typ == protowire.BytesType:
+			// Packed encoding
+			for b := f.bytes; len(b) > 0; {
+				v, n := protowire.ConsumeVarint(b)
+				if n < 0 {
+					return protowire.ParseError(n)
+				}
+				occurrence.Range = append(occurrence.Range, int32(v))
+				b = b[n:]
+			}
+
+		case f.num == 2 && f.typ == protowire.BytesType:
+			occurrence.Symbol = string(f.bytes)
+
+		case f.num == 3 && f.typ == protowire.VarintType:
+			occurrence.SymbolRoles = int32(f.value)
+
+		case f.num == 4 && f.typ == protowire.BytesType:
+			occurrence.OverrideDocumentation = append(occurrence.OverrideDocumentation, string(f.bytes))
+
+		case f.num == 6 && f.typ == protowire.BytesType:
+			diagnostic, err := unmarshalDiagnostic(f.bytes)
+			if err != nil {
+				return err
+			}
+			occurrence.Diagnostics = append(occurrence.Diagnostics, diagnostic)
+		}
+		return nil
+	})
+
+	return occurrence, err
+}
+
+func unmarshalSymbolInformation(b []byte) (*SymbolInformation, error) {
+	symbol := &SymbolInformation{}
+	err := unmarshalFields(b, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			symbol.Symbol = string(f.bytes)
+
+		case f.num == 3 && f.typ == protowire.BytesType:
+			symbol.Documentation = append(symbol.Documentation, string(f.bytes))
+
+		case f.num == 4 && f.typ == protowire.BytesType:
+			relationship, err := unmarshalRelationship(f.bytes)
+			if err != nil {
+				return err
+			}
+			symbol.Relationships = append(symbol.Relationships, relationship)
+		}
+		return nil
+	})
+
+	return symbol, err
+}
+
+func unmarshalRelationship(b []byte) (*Relationship, error) {
+	relationship := &Relationship{}
+	err := unmarshalFields(b, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			relationship.Symbol = string(f.bytes)
+		case f.num == 2 && f.typ == protowire.VarintType:
+			relationship.IsReference = f.value != 0
+		case f.num == 3 && f.typ == protowire.VarintType:
+			relationship.IsImplementation = f.value != 0
+		case f.num == 4 && f.typ == protowire.VarintType:
+			relationship.IsTypeDefinition = f.value != 0
+		case f.num == 5 && f.typ == protowire.VarintType:
+			relationship.IsDefinition = f.value != 0
+		}
+		return nil
+	})
+
+	return relationship, err
+}
+
+func unmarshalDiagnostic(b []byte) (*Diagnostic, error) {
+	diagnostic := &Diagnostic{}
+	err := unmarshalFields(b, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.VarintType:
+			diagnostic.Severity = int32(f.value)
+		case f.num == 2 && f.typ == protowire.BytesType:
+			diagnostic.Code = string(f.bytes)
+		case f.num == 3 && f.typ == protowire.BytesType:
+			diagnostic.Message = string(f.bytes)
+		case f.num == 4 && f.typ == protowire.BytesType:
+			diagnostic.Source = string(f.bytes)
+		}
+		return nil
+	})
+
+	return diagnostic, err
+}
@@ -0,0 +1,130 @@
+package scip
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+var testIndex = &Index{
+	Metadata: &Metadata{
+		Version:     1,
+		ToolInfo:    &ToolInfo{Name: "scip-go", Version: "0.1.0", Arguments: []string{"--quiet"}},
+		ProjectRoot: "file:///test/root",
+	},
+	Documents: []*Document{
+		{
+			RelativePath: "main.go",
+			Language:     "go",
+			Occurrences: []*Occurrence{
+				{Range: []int32{1, 5, 8}, Symbol: "scip-go gomod test v1 `test`/main().", SymbolRoles: int32(SymbolRoleDefinition)},
+				{
+					Range:                 []int32{2, 1, 3, 4},
+					Symbol:                "local 0",
+					OverrideDocumentation: []string{"override"},
+					Diagnostics:           []*Diagnostic{{Severity: 1, Code: "E1", Message: "oops", Source: "vet"}},
+				},
+			},
+			Symbols: []*SymbolInformation{
+				{
+					Symbol:        "scip-go gomod test v1 `test`/main().",
+					Documentation: []string{"```go\nfunc main()\n```"},
+					Relationships: []*Relationship{{Symbol: "scip-go gomod test v1 `test`/Runner#Run().", IsImplementation: true}},
+				},
+			},
+		},
+	},
+	ExternalSymbols: []*SymbolInformation{
+		{Symbol: "scip-go gomod fmt . `fmt`/Println().", Documentation: []string{"Println formats..."}},
+	},
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	index, err := Unmarshal(Marshal(testIndex))
+	if err != nil {
+		t.Fatalf("unexpected error unmarshalling index: %s", err)
+	}
+
+	if diff := cmp.Diff(testIndex, index); diff != "" {
+		t.Errorf("unexpected index (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadSkipsUnknownFields(t *testing.T) {
+	var occurrence []byte
+	// Unpacked range values
+	for _, v := range []uint64{3, 4, 5} {
+		occurrence = protowire.AppendTag(occurrence, 1, protowire.VarintType)
+		occurrence = protowire.AppendVarint(occurrence, v)
+	}
+	// Unknown syntax_kind field
+	occurrence = protowire.AppendTag(occurrence, 5, protowire.VarintType)
+	occurrence = protowire.AppendVarint(occurrence, 7)
+	occurrence = appendString(occurrence, 2, "local 1")
+
+	var document []byte
+	document = appendString(document, 1, "lib.go")
+	document = appendMessage(document, 2, occurrence)
+	// Unknown fixed-width field
+	document = protowire.AppendTag(document, 15, protowire.Fixed32Type)
+	document = protowire.AppendFixed32(document, 42)
+
+	var b []byte
+	b = appendMessage(b, 1, marshalMetadata(&Metadata{ProjectRoot: "file:///"}))
+	b = appendMessage(b, 2, document)
+	// Unknown top-level field
+	b = appendMessage(b, 9, []byte("ignored"))
+
+	var documents []*Document
+	if err := Read(bytes.NewReader(b), Visitor{
+		VisitDocument: func(document *Document) error {
+			documents = append(documents, document)
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("unexpected error reading index: %s", err)
+	}
+
+	expected := []*Document{
+		{
+			RelativePath: "lib.go",
+			Occurrences:  []*Occurrence{{Range: []int32{3, 4, 5}, Symbol: "local 1"}},
+		},
+	}
+	if diff := cmp.Diff(expected, documents); diff != "" {
+		t.Errorf("unexpected documents (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadTruncated(t *testing.T) {
+	b := Marshal(testIndex)
+
+	if _, err := Unmarshal(b[:len(b)-3]); err == nil {
+		t.Fatalf("expected error reading truncated index")
+	}
+}
+
+func TestLooksLikeIndex(t *testing.T) {
+	testCases := map[string]struct {
+		input    []byte
+		expected bool
+	}{
+		"index":                     {Marshal(testIndex), true},
+		"index without metadata":    {Marshal(&Index{Documents: testIndex.Documents}), true},
+		"lsif":                      {[]byte(`{"id":1,"type":"vertex","label":"metaData"}`), false},
+		"lsif with leading newline": {[]byte("\n" + `{"id":1,"type":"vertex","label":"metaData"}` + strings.Repeat(" ", 200)), false},
+		"lsif with leading space":   {[]byte("\n" + `{ "id":1,"type":"vertex","label":"metaData"}` + strings.Repeat(" ", 200)), false},
+		"empty":                     {nil, false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if actual := LooksLikeIndex(testCase.input); actual != testCase.expected {
+				t.Errorf("unexpected result. want=%v have=%v", testCase.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cm_result_snapshots;
+ALTER TABLE cm_trigger_jobs DROP COLUMN IF EXISTS num_removed;
+ALTER TABLE cm_queries DROP COLUMN IF EXISTS trigger_type;
+
+COMMIT;
@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE cm_queries ADD COLUMN IF NOT EXISTS trigger_type TEXT NOT NULL DEFAULT 'COMMITS';
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS num_removed INTEGER;
+
+CREATE TABLE IF NOT EXISTS cm_result_snapshots
+(
+    query BIGINT NOT NULL
+        REFERENCES cm_queries(id) ON DELETE CASCADE
+            PRIMARY KEY,
+    result_keys TEXT[] NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON COLUMN cm_queries.trigger_type IS 'COMMITS triggers on results newer than the last run, CONTENT_DELTA triggers on results added since the last snapshot.';
+COMMENT ON COLUMN cm_trigger_jobs.num_removed IS 'The number of results of a CONTENT_DELTA trigger which disappeared since the last run.';
+COMMENT ON TABLE cm_result_snapshots IS 'The result set of the last run of a CONTENT_DELTA code monitor trigger.';
+COMMENT ON COLUMN cm_result_snapshots.result_keys IS 'Keys identifying each result, see codemonitors/background.resultKeys.';
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE search_contexts DROP COLUMN IF EXISTS query;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE search_contexts ADD COLUMN IF NOT EXISTS query TEXT;
+
+COMMENT ON COLUMN search_contexts.query IS 'Search query that defines the repositories of the search context. Query-defined search contexts have no rows in search_context_repos.';
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE batch_spec_workspaces DROP COLUMN IF EXISTS rebase_changeset_id;
+
+ALTER TABLE batch_changes DROP COLUMN IF EXISTS auto_rebase;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE batch_changes ADD COLUMN IF NOT EXISTS auto_rebase boolean NOT NULL DEFAULT false;
+
+ALTER TABLE batch_spec_workspaces ADD COLUMN IF NOT EXISTS rebase_changeset_id bigint REFERENCES changesets(id) ON DELETE SET NULL DEFERRABLE;
+
+COMMENT ON COLUMN batch_spec_workspaces.rebase_changeset_id IS 'The changeset this workspace is re-executed for against the latest revision of its base branch. NULL for workspaces created when resolving a batch spec.';
+
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS notebook_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    notebook_id BIGINT NOT NULL REFERENCES notebooks(id) ON DELETE CASCADE DEFERRABLE,
+    title CITEXT NOT NULL,
+    blocks JSONB DEFAULT '[]'::JSONB NOT NULL,
+    author_user_id INTEGER REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT blocks_is_array CHECK (jsonb_typeof(blocks) = 'array')
+);
+
+CREATE INDEX IF NOT EXISTS notebook_revisions_notebook_id ON notebook_revisions(notebook_id);
+
+COMMENT ON TABLE notebook_revisions IS 'Every version of a notebook, recorded whenever the notebook is created, updated or restored.';
+
+INSERT INTO notebook_revisions (notebook_id, title, blocks, author_user_id, created_at)
+SELECT id, title, blocks, creator_user_id, updated_at FROM notebooks;
+
+COMMIT;
@@ -0,0 +1,69 @@
+BEGIN;
+
+-- Begin cm_teams_webhooks
+CREATE TABLE IF NOT EXISTS cm_teams_webhooks (
+	id BIGSERIAL PRIMARY KEY,
+	monitor BIGINT NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+	url TEXT NOT NULL,
+	enabled BOOLEAN NOT NULL,
+	created_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	changed_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS cm_teams_webhooks_monitor ON cm_teams_webhooks (monitor);
+
+COMMENT ON TABLE cm_teams_webhooks IS 'Microsoft Teams webhook actions configured on code monitors';
+COMMENT ON COLUMN cm_teams_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_teams_webhooks.url IS 'The Microsoft Teams incoming webhook URL we send the code monitor event to';
+COMMENT ON COLUMN cm_teams_webhooks.enabled IS 'Whether this Teams webhook action is enabled. When not enabled, the action will not be run when its code monitor generates events';
+-- End cm_teams_webhooks
+
+-- Begin cm_templated_webhooks
+CREATE TABLE IF NOT EXISTS cm_templated_webhooks (
+	id BIGSERIAL PRIMARY KEY,
+	monitor BIGINT NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+	url TEXT NOT NULL,
+	content_type TEXT NOT NULL,
+	payload_template TEXT NOT NULL,
+	enabled BOOLEAN NOT NULL,
+	created_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+	changed_by INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS cm_templated_webhooks_monitor ON cm_templated_webhooks (monitor);
+
+COMMENT ON TABLE cm_templated_webhooks IS 'Webhook actions with a templated payload configured on code monitors';
+COMMENT ON COLUMN cm_templated_webhooks.monitor IS 'The code monitor that the action is defined on';
+COMMENT ON COLUMN cm_templated_webhooks.url IS 'The webhook URL we send the code monitor event to';
+COMMENT ON COLUMN cm_templated_webhooks.content_type IS 'The Content-Type header of the request';
+COMMENT ON COLUMN cm_templated_webhooks.payload_template IS 'A Go text/template that is executed over the monitor, query and results to produce the request body';
+COMMENT ON COLUMN cm_templated_webhooks.enabled IS 'Whether this templated webhook action is enabled. When not enabled, the action will not be run when its code monitor generates events';
+-- End cm_templated_webhooks
+
+-- Begin add Teams and templated webhook actions to cm_action_jobs
+ALTER TABLE cm_action_jobs
+	ADD COLUMN IF NOT EXISTS teams_webhook BIGINT
+		REFERENCES cm_teams_webhooks(id) ON DELETE CASCADE,
+	ADD COLUMN IF NOT EXISTS templated_webhook BIGINT
+		REFERENCES cm_templated_webhooks(id) ON DELETE CASCADE,
+	DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type,
+	ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK (
+		(
+			CASE WHEN email IS NULL THEN 0 ELSE 1 END
+			+ CASE WHEN webhook IS NULL THEN 0 ELSE 1 END
+			+ CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END
+			+ CASE WHEN teams_webhook IS NULL THEN 0 ELSE 1 END
+			+ CASE WHEN templated_webhook IS NULL THEN 0 ELSE 1 END
+		) = 1
+	);
+
+COMMENT ON COLUMN cm_action_jobs.teams_webhook IS 'The ID of the cm_teams_webhooks action to execute if this is a Teams webhook job. Mutually exclusive with the other action types';
+COMMENT ON COLUMN cm_action_jobs.templated_webhook IS 'The ID of the cm_templated_webhooks action to execute if this is a templated webhook job. Mutually exclusive with the other action types';
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
+-- End add Teams and templated webhook actions to cm_action_jobs
+
+COMMIT;